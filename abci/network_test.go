@@ -0,0 +1,311 @@
+package abci_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	linkedpacketsabci "github.com/srdtrk/linkedpackets/abci"
+	"github.com/srdtrk/linkedpackets/simapp"
+)
+
+const (
+	numValidators = 4
+	numRelayers   = 4
+)
+
+// relayer is a genesis account signing the MsgRecvPacket transactions injected in the mempools.
+type relayer struct {
+	priv   *secp256k1.PrivKey
+	accNum uint64
+	seq    uint64
+}
+
+func (r *relayer) address() sdk.AccAddress {
+	return sdk.AccAddress(r.priv.PubKey().Address())
+}
+
+// proposalTestNetwork is an in-process network of validators whose mempools hold the same linked and unlinked
+// MsgRecvPacket transactions.
+type proposalTestNetwork struct {
+	cfg     network.Config
+	network *network.Network
+
+	// apps are the applications of the validators
+	apps []*simapp.SimApp
+	// proposals are the proposals prepared twice by every validator before it started
+	proposals [][][]byte
+
+	// completeLinks are the encoded transactions of the links whose every packet is in the mempools, by link id
+	completeLinks map[string][][]byte
+	// partialLink are the encoded transactions of a link whose last packet is not in the mempools
+	partialLink [][]byte
+	// unlinked are the encoded transactions of packets which are not linked
+	unlinked [][]byte
+
+	packetSequence uint64
+}
+
+// newProposalTestNetwork starts an in-process network of validators. The transactions are injected in the mempool
+// of every validator before it starts, in a different order for each validator, as CheckTx cannot verify their
+// packet proofs without a counterparty chain.
+func newProposalTestNetwork(t *testing.T) *proposalTestNetwork {
+	t.Helper()
+
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = numValidators
+	cfg.TimeoutCommit = 500 * time.Millisecond
+
+	n := &proposalTestNetwork{cfg: cfg, completeLinks: make(map[string][][]byte)}
+
+	relayers := make([]*relayer, numRelayers)
+	for i := range relayers {
+		// the account numbers are set after the ones of the validators, which are assigned at genesis
+		relayers[i] = &relayer{priv: secp256k1.GenPrivKey(), accNum: uint64(100 + i)}
+	}
+	n.addGenesisRelayers(t, relayers)
+
+	txs := []sdk.Tx{
+		n.recvPacketTx(t, relayers[0], "not a linked packet"),
+		n.recvPacketTx(t, relayers[0], `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`),
+	}
+	n.unlinked = n.encodeTxs(t, txs...)
+
+	for _, link := range []struct {
+		linkID   string
+		relayer  *relayer
+		numTxs   int
+		complete bool
+	}{
+		{"link-b", relayers[1], 3, true},
+		{"link-a", relayers[2], 2, true},
+		{"partial-link", relayers[3], 2, false},
+	} {
+		var linkTxs []sdk.Tx
+		for i := 0; i < link.numTxs; i++ {
+			linkTxs = append(linkTxs, n.recvPacketTx(t, link.relayer, linkMemo(link.linkID, i, link.complete && i == link.numTxs-1)))
+		}
+
+		if link.complete {
+			n.completeLinks[link.linkID] = n.encodeTxs(t, linkTxs...)
+		} else {
+			n.partialLink = n.encodeTxs(t, linkTxs...)
+		}
+		txs = append(txs, linkTxs...)
+	}
+
+	appConstructor := n.cfg.AppConstructor
+	n.cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		app := appConstructor(val).(*simapp.SimApp)
+
+		for _, i := range rand.Perm(len(txs)) {
+			require.NoError(t, app.Mempool().Insert(context.Background(), txs[i]))
+		}
+
+		// the app cannot prepare a proposal before the chain is initialized, so the handler of the app is run on its
+		// mempool and state instead
+		nonceMempool, ok := app.Mempool().(*mempool.SenderNonceMempool)
+		require.True(t, ok)
+		prepareProposal := linkedpacketsabci.NewPrepareProposalHandler(
+			log.NewNopLogger(), app.TxConfig(), app.AppCodec(), app.IBCKeeper.ChannelKeeper, nonceMempool,
+		).PrepareProposalHandler()
+
+		ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1, ChainID: n.cfg.ChainID})
+		for i := 0; i < 2; i++ {
+			res, err := prepareProposal(ctx, &abci.RequestPrepareProposal{Height: 1, MaxTxBytes: 1 << 20})
+			require.NoError(t, err)
+			n.proposals = append(n.proposals, res.Txs)
+		}
+		n.apps = append(n.apps, app)
+
+		return app
+	}
+
+	var err error
+	n.network, err = network.New(t, t.TempDir(), n.cfg)
+	require.NoError(t, err)
+
+	return n
+}
+
+// addGenesisRelayers funds the relayers and sets their account numbers in the genesis state of the network.
+func (n *proposalTestNetwork) addGenesisRelayers(t *testing.T, relayers []*relayer) {
+	t.Helper()
+
+	var authGenState authtypes.GenesisState
+	n.cfg.Codec.MustUnmarshalJSON(n.cfg.GenesisState[authtypes.ModuleName], &authGenState)
+
+	var bankGenState banktypes.GenesisState
+	n.cfg.Codec.MustUnmarshalJSON(n.cfg.GenesisState[banktypes.ModuleName], &bankGenState)
+
+	var genAccounts authtypes.GenesisAccounts
+	for _, r := range relayers {
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(r.address(), r.priv.PubKey(), r.accNum, 0))
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+			Address: r.address().String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(n.cfg.BondDenom, sdkmath.NewInt(1_000_000_000))),
+		})
+	}
+
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	require.NoError(t, err)
+	authGenState.Accounts = append(authGenState.Accounts, accounts...)
+
+	n.cfg.GenesisState[authtypes.ModuleName] = n.cfg.Codec.MustMarshalJSON(&authGenState)
+	n.cfg.GenesisState[banktypes.ModuleName] = n.cfg.Codec.MustMarshalJSON(&bankGenState)
+}
+
+// recvPacketTx returns a transaction signed by the relayer, receiving a transfer packet with the given memo.
+func (n *proposalTestNetwork) recvPacketTx(t *testing.T, r *relayer, memo string) sdk.Tx {
+	t.Helper()
+
+	n.packetSequence++
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		"transfer/channel-0/uatom", "100", "cosmos1sender", r.address().String(), memo,
+	)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), n.packetSequence,
+		transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-0",
+		clienttypes.NewHeight(1, 100), 0,
+	)
+	msg := channeltypes.NewMsgRecvPacket(packet, []byte("proof"), clienttypes.NewHeight(1, 1), r.address().String())
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(int64(n.packetSequence))),
+		n.cfg.TxConfig,
+		[]sdk.Msg{msg},
+		sdk.NewCoins(sdk.NewCoin(n.cfg.BondDenom, sdkmath.NewInt(1000))),
+		500_000,
+		n.cfg.ChainID,
+		[]uint64{r.accNum},
+		[]uint64{r.seq},
+		r.priv,
+	)
+	require.NoError(t, err)
+	r.seq++
+
+	return tx
+}
+
+// encodeTxs encodes the transactions as they are included in the proposals.
+func (n *proposalTestNetwork) encodeTxs(t *testing.T, txs ...sdk.Tx) [][]byte {
+	t.Helper()
+
+	txsBytes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := n.cfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txsBytes[i] = bz
+	}
+
+	return txsBytes
+}
+
+// requireProposal checks that the proposal includes every complete link atomically, in the order of its packets,
+// that it includes the unlinked packets, and that it holds back the partial link.
+func (n *proposalTestNetwork) requireProposal(t *testing.T, proposal [][]byte) {
+	t.Helper()
+
+	for linkID, linkTxs := range n.completeLinks {
+		start := indexOfTx(proposal, linkTxs[0])
+		require.NotEqual(t, -1, start, "link %s is not included", linkID)
+		require.LessOrEqual(t, start+len(linkTxs), len(proposal), "link %s is not included atomically", linkID)
+		require.Equal(t, linkTxs, proposal[start:start+len(linkTxs)], "link %s is not included atomically", linkID)
+	}
+
+	for i, tx := range n.partialLink {
+		require.Equal(t, -1, indexOfTx(proposal, tx), "packet %d of the partial link is included", i)
+	}
+
+	for i, tx := range n.unlinked {
+		require.NotEqual(t, -1, indexOfTx(proposal, tx), "unlinked packet %d is not included", i)
+	}
+}
+
+func indexOfTx(txs [][]byte, tx []byte) int {
+	for i := range txs {
+		if bytes.Equal(txs[i], tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func TestPrepareProposalNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	n := newProposalTestNetwork(t)
+
+	t.Run("proposals are deterministic", func(t *testing.T) {
+		require.Len(t, n.proposals, 2*numValidators)
+		for i, proposal := range n.proposals {
+			require.Equal(t, n.proposals[0], proposal, "proposal %d differs", i)
+		}
+	})
+
+	t.Run("proposals include links atomically", func(t *testing.T) {
+		n.requireProposal(t, n.proposals[0])
+		require.Len(t, n.proposals[0], len(n.unlinked)+len(n.completeLinks["link-a"])+len(n.completeLinks["link-b"]))
+	})
+
+	t.Run("blocks include links atomically", func(t *testing.T) {
+		height, err := n.network.WaitForHeight(3)
+		require.NoError(t, err)
+
+		var blockTxs [][]byte
+		for h := int64(1); h <= height; h++ {
+			block, err := n.network.Validators[0].RPCClient.Block(context.Background(), &h)
+			require.NoError(t, err)
+
+			if len(block.Block.Txs) == 0 {
+				continue
+			}
+			// the transactions are all included in the first block proposed with a non empty mempool
+			require.Empty(t, blockTxs, "transactions are included in block %d", h)
+			for _, tx := range block.Block.Txs {
+				blockTxs = append(blockTxs, tx)
+			}
+		}
+
+		require.Equal(t, n.proposals[0], blockTxs)
+	})
+
+	t.Run("partial links are held back in the mempools", func(t *testing.T) {
+		n.network.Cleanup()
+
+		require.Len(t, n.apps, numValidators)
+		for i, app := range n.apps {
+			require.Equal(t, len(n.partialLink), app.Mempool().CountTx(), fmt.Sprintf("mempool of validator %d", i))
+
+			res, err := app.PrepareProposal(&abci.RequestPrepareProposal{Height: app.LastBlockHeight() + 1, MaxTxBytes: 1 << 20})
+			require.NoError(t, err)
+			require.Empty(t, res.Txs)
+		}
+	})
+}
@@ -3,10 +3,11 @@ package abci
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"sort"
 	"strconv"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/srdtrk/linkedpackets"
 
@@ -17,13 +18,26 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
+// linkKey identifies a link received from a counterparty chain. The packets of a link may be received on several
+// channels from its sending chain, while links received from different chains may share the same identifier, as
+// link identifiers are only unique on their sending chain.
+type linkKey struct {
+	// counterparty is the client of the channels the link is received on, or the channel itself if its client is
+	// not found.
+	counterparty string
+	linkID       string
+}
+
 func (h *PrepareProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
-		h.logger.Info(fmt.Sprintf("🛠️ :: Prepare Proposal"))
 		var proposalTxs [][]byte
+		var proposalSize int64
 
-		linkedPacketTxs := make(map[string]map[uint64]sdk.Tx)
-		linkedPacketTotalLen := make(map[string]uint64)
+		linkedPacketTxs := make(map[linkKey]map[uint64]sdk.Tx)
+		linkedPacketTotalLen := make(map[linkKey]uint64)
+		// links are the links in the mempool, they are sorted so that the proposal is deterministic regardless of
+		// the order in which the mempool selects its senders
+		var links []linkKey
 
 		var txs []sdk.Tx
 		itr := h.mempool.Select(context.Background(), nil)
@@ -33,14 +47,17 @@ func (h *PrepareProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 
 			var isLinkedTx bool
 			for _, sdkMsg := range sdkMsgs {
-				isLinkedPacket, linkID, linkIndex, isLastPacket := handleSdkMsg(sdkMsg)
+				isLinkedPacket, key, linkIndex, isLastPacket := h.handleSdkMsg(ctx, sdkMsg)
 				if isLinkedPacket {
-					linkedPacketTxs[linkID][linkIndex] = tmptx
-					h.logger.Info(fmt.Sprintf("🛠️ :: LinkID: %v, LinkIndex: %v", linkID, linkIndex))
+					if _, ok := linkedPacketTxs[key]; !ok {
+						linkedPacketTxs[key] = make(map[uint64]sdk.Tx)
+						links = append(links, key)
+					}
+					linkedPacketTxs[key][linkIndex] = tmptx
 					isLinkedTx = true
 				}
 				if isLastPacket {
-					linkedPacketTotalLen[linkID] = linkIndex + 1
+					linkedPacketTotalLen[key] = linkIndex + 1
 				}
 			}
 
@@ -49,37 +66,125 @@ func (h *PrepareProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			}
 			itr = itr.Next()
 		}
-		h.logger.Info(fmt.Sprintf("🛠️ :: Number of Transactions available from mempool: %v", len(txs)))
 
-		for _, sdkTxs := range txs {
-			txBytes, err := h.txConfig.TxEncoder()(sdkTxs)
+		for _, sdkTx := range txs {
+			txBytes, err := h.txConfig.TxEncoder()(sdkTx)
 			if err != nil {
-				h.logger.Info(fmt.Sprintf("❌~Error encoding transaction: %v", err.Error()))
+				h.logger.Error("failed to encode transaction", "err", err)
+				continue
+			}
+
+			if proposalSize+int64(len(txBytes)) > req.MaxTxBytes {
+				continue
 			}
 			proposalTxs = append(proposalTxs, txBytes)
+			proposalSize += int64(len(txBytes))
 		}
 
-		for linkID, linkTxs := range linkedPacketTxs {
-			if len(linkTxs) == int(linkedPacketTotalLen[linkID]) {
-				for i := uint64(0); i < linkedPacketTotalLen[linkID]; i++ {
-					txBytes, err := h.txConfig.TxEncoder()(linkTxs[i])
-					if err != nil {
-						h.logger.Info(fmt.Sprintf("❌~Error encoding transaction: %v", err.Error()))
-					}
-					proposalTxs = append(proposalTxs, txBytes)
-				}
-			} else {
-				h.logger.Info(fmt.Sprintf("🛠️ :: LinkID: %v, LinkIndex: %v, TotalLen: %v", linkID, len(linkTxs), linkedPacketTotalLen[linkID]))
+		sort.Slice(links, func(i, j int) bool {
+			if links[i].counterparty != links[j].counterparty {
+				return links[i].counterparty < links[j].counterparty
+			}
+			return links[i].linkID < links[j].linkID
+		})
+		// includedLinkTxs are the transactions of the links included in the proposal, a transaction receiving
+		// several linked packets is only included once
+		includedLinkTxs := make(map[sdk.Tx]bool)
+		for _, key := range links {
+			linkTxs := linkedPacketTxs[key]
+			if !isLinkComplete(linkTxs, linkedPacketTotalLen[key]) {
+				h.logger.Debug(
+					"skipping partial link", "counterparty", key.counterparty, "link_id", key.linkID,
+					"packets", len(linkTxs), "total_packets", linkedPacketTotalLen[key],
+				)
+				telemetry.IncrCounter(1, linkedpackets.ModuleName, linkedpackets.MetricKeyProposal, linkedpackets.MetricKeyPartialLinks)
+				continue
+			}
+
+			uniqueTxs := uniqueLinkTxs(linkTxs, linkedPacketTotalLen[key], includedLinkTxs)
+			linkTxsBytes, linkSize, err := h.encodeLinkTxs(uniqueTxs)
+			if err != nil {
+				h.logger.Error(
+					"failed to encode link transaction", "counterparty", key.counterparty, "link_id", key.linkID,
+					"err", err,
+				)
+				continue
+			}
+
+			// the link is included atomically, it is left in the mempool for a later block if it does not fit
+			if proposalSize+linkSize > req.MaxTxBytes {
+				h.logger.Debug(
+					"skipping link exceeding the max tx bytes", "counterparty", key.counterparty, "link_id", key.linkID,
+					"size", linkSize,
+				)
+				continue
+			}
+			proposalTxs = append(proposalTxs, linkTxsBytes...)
+			proposalSize += linkSize
+			for _, tx := range uniqueTxs {
+				includedLinkTxs[tx] = true
 			}
 		}
 
-		h.logger.Info(fmt.Sprintf("🛠️ :: Number of Transactions in proposal: %v", len(proposalTxs)))
+		h.logger.Debug("prepared proposal", "txs", len(proposalTxs), "size", proposalSize)
 
 		return &abci.ResponsePrepareProposal{Txs: proposalTxs}, nil
 	}
 }
 
-func handleSdkMsg(msg sdk.Msg) (bool, string, uint64, bool) {
+// uniqueLinkTxs returns the transactions of a complete link in the order of its packets. A transaction receiving
+// several packets is only returned once, at the index of its first packet, and the transactions already included
+// in the proposal are skipped.
+func uniqueLinkTxs(linkTxs map[uint64]sdk.Tx, totalLen uint64, included map[sdk.Tx]bool) []sdk.Tx {
+	txs := make([]sdk.Tx, 0, totalLen)
+	seen := make(map[sdk.Tx]bool)
+	for i := uint64(0); i < totalLen; i++ {
+		tx := linkTxs[i]
+		if included[tx] || seen[tx] {
+			continue
+		}
+
+		seen[tx] = true
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+// encodeLinkTxs encodes the transactions of a complete link. It returns the encoded transactions and their total
+// size.
+func (h *PrepareProposalHandler) encodeLinkTxs(txs []sdk.Tx) ([][]byte, int64, error) {
+	txsBytes := make([][]byte, 0, len(txs))
+	var size int64
+	for _, tx := range txs {
+		txBytes, err := h.txConfig.TxEncoder()(tx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		txsBytes = append(txsBytes, txBytes)
+		size += int64(len(txBytes))
+	}
+
+	return txsBytes, size, nil
+}
+
+// isLinkComplete returns true if the mempool holds a transaction for every packet of a link of the given length.
+func isLinkComplete(linkTxs map[uint64]sdk.Tx, totalLen uint64) bool {
+	if len(linkTxs) != int(totalLen) {
+		return false
+	}
+
+	for i := uint64(0); i < totalLen; i++ {
+		if _, ok := linkTxs[i]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h *PrepareProposalHandler) handleSdkMsg(ctx sdk.Context, msg sdk.Msg) (bool, linkKey, uint64, bool) {
 	switch msg := msg.(type) {
 	case *channeltypes.MsgRecvPacket:
 		packetDataBytes := msg.Packet.GetData()
@@ -91,7 +196,7 @@ func handleSdkMsg(msg sdk.Msg) (bool, string, uint64, bool) {
 		if err != nil {
 			icaPacket, err := icacontroller.IBCMiddleware{}.UnmarshalPacketData(packetDataBytes)
 			if err != nil {
-				return false, "", 0, false
+				return false, linkKey{}, 0, false
 			}
 			memo = icaPacket.(icatypes.InterchainAccountPacketData).Memo
 		} else {
@@ -99,29 +204,43 @@ func handleSdkMsg(msg sdk.Msg) (bool, string, uint64, bool) {
 		}
 
 		err = json.Unmarshal([]byte(memo), &linkData)
-		if err != nil {
-			return false, "", 0, false
+		if err != nil || linkData == nil || linkData.LinkID == "" {
+			// the memo is not linked, e.g. it only holds the keys of other middlewares
+			return false, linkKey{}, 0, false
 		}
 
 		linkIndex, err := strconv.ParseUint(linkData.LinkIndex, 10, 64)
 		if err != nil {
-			return false, "", 0, false
+			return false, linkKey{}, 0, false
 		}
 
-		return true, linkData.LinkID, linkIndex, linkData.IsLastPacket
+		key := linkKey{
+			counterparty: h.counterparty(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel),
+			linkID:       linkData.LinkID,
+		}
+		return true, key, linkIndex, linkData.IsLastPacket
 	default:
-		return false, "", 0, false
+		return false, linkKey{}, 0, false
+	}
+
+}
+
+// counterparty returns the client of the given channel, which identifies the chain the packets received on the
+// channel are sent from. The channel itself is returned if its client is not found, e.g. if the channel is not
+// open yet.
+func (h *PrepareProposalHandler) counterparty(ctx sdk.Context, portID, channelID string) string {
+	if h.channelKeeper != nil {
+		if clientID, _, err := h.channelKeeper.GetChannelClientState(ctx, portID, channelID); err == nil {
+			return clientID
+		}
 	}
 
+	return portID + "/" + channelID
 }
 
 func (h *ProcessProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (resp *abci.ResponseProcessProposal, err error) {
-		h.Logger.Info(fmt.Sprintf("⚙️ :: Process Proposal"))
-
-		for i, tx := range req.Txs {
-			h.Logger.Info(fmt.Sprintf("⚙️:: Transaction No %v :: %v", i, tx))
-		}
+		h.Logger.Debug("processing proposal", "txs", len(req.Txs))
 
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
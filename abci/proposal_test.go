@@ -0,0 +1,276 @@
+package abci_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/srdtrk/linkedpackets"
+	linkedpacketsabci "github.com/srdtrk/linkedpackets/abci"
+)
+
+type proposalFixture struct {
+	encCfg moduletestutil.TestEncodingConfig
+
+	packetSequence uint64
+}
+
+func initProposalFixture() *proposalFixture {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	channeltypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	return &proposalFixture{encCfg: encCfg}
+}
+
+// recvPacketTx returns a transaction of a new relayer receiving a transfer packet with each of the given memos on
+// the given channel.
+func (f *proposalFixture) recvPacketTx(t *testing.T, channelID string, memos ...string) sdk.Tx {
+	t.Helper()
+
+	priv := secp256k1.GenPrivKey()
+	relayer := sdk.AccAddress(priv.PubKey().Address()).String()
+
+	msgs := make([]sdk.Msg, len(memos))
+	for i, memo := range memos {
+		f.packetSequence++
+		packetData := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", relayer, memo)
+		packet := channeltypes.NewPacket(
+			packetData.GetBytes(), f.packetSequence,
+			transfertypes.PortID, "channel-0", transfertypes.PortID, channelID,
+			clienttypes.NewHeight(1, 100), 0,
+		)
+		msgs[i] = channeltypes.NewMsgRecvPacket(packet, []byte("proof"), clienttypes.NewHeight(1, 1), relayer)
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(int64(f.packetSequence))),
+		f.encCfg.TxConfig,
+		msgs,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))),
+		simtestutil.DefaultGenTxGas,
+		"chain-id",
+		[]uint64{0},
+		[]uint64{0},
+		priv,
+	)
+	require.NoError(t, err)
+
+	return tx
+}
+
+// linkTxs returns the transactions receiving the packets of a link on the given channel. The last packet is only
+// marked as such if the link is complete.
+func (f *proposalFixture) linkTxs(t *testing.T, channelID, linkID string, numPackets int, complete bool) []sdk.Tx {
+	t.Helper()
+
+	txs := make([]sdk.Tx, numPackets)
+	for i := range txs {
+		txs[i] = f.recvPacketTx(t, channelID, linkMemo(linkID, i, complete && i == numPackets-1))
+	}
+
+	return txs
+}
+
+// linkMemo returns the memo of the packet of a link at the given index.
+func linkMemo(linkID string, linkIndex int, isLastPacket bool) string {
+	linkData := linkedpackets.LinkData{
+		LinkID:         linkID,
+		LinkIndex:      strconv.Itoa(linkIndex),
+		IsInitalPacket: linkIndex == 0,
+		IsLastPacket:   isLastPacket,
+		Atomic:         true,
+	}
+
+	return linkData.String()
+}
+
+// encodeTxs encodes the transactions as they are included in the proposals.
+func (f *proposalFixture) encodeTxs(t *testing.T, txs ...sdk.Tx) [][]byte {
+	t.Helper()
+
+	txsBytes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := f.encCfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txsBytes[i] = bz
+	}
+
+	return txsBytes
+}
+
+// mockChannelKeeper maps the channels of the transfer port to the clients of their counterparty chains.
+type mockChannelKeeper map[string]string
+
+func (k mockChannelKeeper) GetChannelClientState(_ sdk.Context, _, channelID string) (string, ibcexported.ClientState, error) {
+	clientID, ok := k[channelID]
+	if !ok {
+		return "", nil, channeltypes.ErrChannelNotFound
+	}
+
+	return clientID, nil, nil
+}
+
+// prepareProposal inserts the transactions in a mempool in a random order and prepares a proposal from it.
+func prepareProposal(
+	t *testing.T, txConfig client.TxConfig, channelKeeper linkedpacketsabci.ChannelKeeper, r *rand.Rand, txs []sdk.Tx, maxTxBytes int64,
+) [][]byte {
+	t.Helper()
+
+	nonceMempool := mempool.NewSenderNonceMempool(mempool.SenderNonceSeedOpt(r.Int63()))
+	for _, i := range r.Perm(len(txs)) {
+		require.NoError(t, nonceMempool.Insert(context.Background(), txs[i]))
+	}
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	handler := linkedpacketsabci.NewPrepareProposalHandler(log.NewNopLogger(), txConfig, nil, channelKeeper, nonceMempool)
+	res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+
+	return res.Txs
+}
+
+func TestPrepareProposal(t *testing.T) {
+	f := initProposalFixture()
+	require := require.New(t)
+
+	unlinked := []sdk.Tx{
+		f.recvPacketTx(t, "channel-0", "not a linked packet"),
+		f.recvPacketTx(t, "channel-0", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`),
+	}
+	linkB := f.linkTxs(t, "channel-0", "link-b", 3, true)
+	linkA := f.linkTxs(t, "channel-0", "link-a", 2, true)
+	partialLink := f.linkTxs(t, "channel-0", "partial-link", 2, false)
+	// links received on different channels may share the same identifier
+	sameIDLink := f.linkTxs(t, "channel-1", "link-a", 2, true)
+	sameIDPartialLink := f.linkTxs(t, "channel-2", "link-a", 3, false)
+
+	var txs []sdk.Tx
+	for _, linkTxs := range [][]sdk.Tx{unlinked, linkB, linkA, partialLink, sameIDLink, sameIDPartialLink} {
+		txs = append(txs, linkTxs...)
+	}
+
+	// the unlinked transactions come first, then the complete links sorted by channel and identifier, each in the
+	// order of its packets
+	var expLinkTxs [][]byte
+	for _, linkTxs := range [][]sdk.Tx{linkA, linkB, sameIDLink} {
+		expLinkTxs = append(expLinkTxs, f.encodeTxs(t, linkTxs...)...)
+	}
+
+	// the links in the proposal do not depend on the order in which the mempool selects the transactions
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		proposal := prepareProposal(t, f.encCfg.TxConfig, nil, r, txs, 1<<20)
+		require.Len(proposal, len(unlinked)+len(expLinkTxs))
+		require.ElementsMatch(f.encodeTxs(t, unlinked...), proposal[:len(unlinked)])
+		require.Equal(expLinkTxs, proposal[len(unlinked):])
+	}
+}
+
+func TestPrepareProposalMaxTxBytes(t *testing.T) {
+	f := initProposalFixture()
+	require := require.New(t)
+
+	unlinked := f.recvPacketTx(t, "channel-0", "not a linked packet")
+	linkA := f.linkTxs(t, "channel-0", "link-a", 2, true)
+	linkB := f.linkTxs(t, "channel-0", "link-b", 2, true)
+	txs := append([]sdk.Tx{unlinked}, append(linkA, linkB...)...)
+
+	var size int64
+	for _, bz := range f.encodeTxs(t, txs...) {
+		size += int64(len(bz))
+	}
+
+	r := rand.New(rand.NewSource(1))
+	require.Equal(f.encodeTxs(t, txs...), prepareProposal(t, f.encCfg.TxConfig, nil, r, txs, size))
+
+	// a link which does not fit in the proposal is held back as a whole, even if some of its packets would fit
+	proposal := prepareProposal(t, f.encCfg.TxConfig, nil, r, txs, size-1)
+	require.Equal(f.encodeTxs(t, append([]sdk.Tx{unlinked}, linkA...)...), proposal)
+
+	require.Empty(prepareProposal(t, f.encCfg.TxConfig, nil, r, txs, 0))
+}
+
+func TestPrepareProposalMultiChannelLink(t *testing.T) {
+	f := initProposalFixture()
+	require := require.New(t)
+
+	// channel-0 and channel-1 are opened with the same chain, channel-2 with another chain
+	channelKeeper := mockChannelKeeper{
+		"channel-0": "07-tendermint-0",
+		"channel-1": "07-tendermint-0",
+		"channel-2": "07-tendermint-1",
+	}
+
+	// the packets of the link are sent on both channels with the same chain
+	link := []sdk.Tx{
+		f.recvPacketTx(t, "channel-0", linkMemo("link-a", 0, false)),
+		f.recvPacketTx(t, "channel-1", linkMemo("link-a", 1, false)),
+		f.recvPacketTx(t, "channel-0", linkMemo("link-a", 2, true)),
+	}
+	// a link with the same identifier is received from the other chain
+	otherChainLink := f.linkTxs(t, "channel-2", "link-a", 2, true)
+	// the last packet of a link is received on a channel whose client is not found
+	unknownChannelLink := []sdk.Tx{
+		f.recvPacketTx(t, "channel-0", linkMemo("link-b", 0, false)),
+		f.recvPacketTx(t, "channel-3", linkMemo("link-b", 1, true)),
+	}
+
+	var txs []sdk.Tx
+	for _, linkTxs := range [][]sdk.Tx{link, otherChainLink, unknownChannelLink} {
+		txs = append(txs, linkTxs...)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		proposal := prepareProposal(t, f.encCfg.TxConfig, channelKeeper, r, txs, 1<<20)
+		require.Equal(f.encodeTxs(t, append(link, otherChainLink...)...), proposal)
+	}
+
+	// without a channel keeper, the packets of the link received on different channels are not grouped
+	proposal := prepareProposal(t, f.encCfg.TxConfig, nil, r, txs, 1<<20)
+	require.Equal(f.encodeTxs(t, otherChainLink...), proposal)
+}
+
+func TestPrepareProposalBatchedPackets(t *testing.T) {
+	f := initProposalFixture()
+	require := require.New(t)
+
+	// relayers may receive several packets of a link in a single transaction
+	batchedTx := f.recvPacketTx(t, "channel-0", linkMemo("link-a", 0, false), linkMemo("link-a", 1, false))
+	lastTx := f.recvPacketTx(t, "channel-0", linkMemo("link-a", 2, true))
+	// or the packets of different links
+	sharedTx := f.recvPacketTx(t, "channel-0", linkMemo("link-b", 0, true), linkMemo("link-c", 0, true))
+	txs := []sdk.Tx{batchedTx, lastTx, sharedTx}
+
+	var size int64
+	for _, bz := range f.encodeTxs(t, txs...) {
+		size += int64(len(bz))
+	}
+
+	// every transaction is included once, and the size of the links only counts their transactions once
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		require.Equal(f.encodeTxs(t, txs...), prepareProposal(t, f.encCfg.TxConfig, nil, r, txs, size))
+	}
+}
@@ -4,14 +4,23 @@ import (
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// ChannelKeeper defines the expected IBC channel keeper used to find the chain a linked packet is received from
+type ChannelKeeper interface {
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
 type PrepareProposalHandler struct {
-	logger      log.Logger
-	txConfig    client.TxConfig
-	cdc         codec.Codec
-	mempool     *mempool.SenderNonceMempool
+	logger        log.Logger
+	txConfig      client.TxConfig
+	cdc           codec.Codec
+	channelKeeper ChannelKeeper
+	mempool       *mempool.SenderNonceMempool
 }
 
 type ProcessProposalHandler struct {
@@ -20,13 +29,17 @@ type ProcessProposalHandler struct {
 	Logger   log.Logger
 }
 
+// NewPrepareProposalHandler returns a handler preparing proposals from the given mempool. The channel keeper is used
+// to group the packets of a link received on several channels from the same chain. If it is nil, the packets are
+// grouped by the channel they are received on.
 func NewPrepareProposalHandler(
-	logger log.Logger, txConfig client.TxConfig, cdc codec.Codec, mempool *mempool.SenderNonceMempool,
+	logger log.Logger, txConfig client.TxConfig, cdc codec.Codec, channelKeeper ChannelKeeper, mempool *mempool.SenderNonceMempool,
 ) *PrepareProposalHandler {
 	return &PrepareProposalHandler{
-		logger:      logger,
-		txConfig:    txConfig,
-		cdc:         cdc,
-		mempool:     mempool,
+		logger:        logger,
+		txConfig:      txConfig,
+		cdc:           cdc,
+		channelKeeper: channelKeeper,
+		mempool:       mempool,
 	}
 }
@@ -0,0 +1,53 @@
+package linkedpackets
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ ibcexported.Acknowledgement = LinkAcknowledgement{}
+
+// NewLinkAcknowledgement wraps the acknowledgement of the underlying application in a link acknowledgement
+// for a packet accepted by the link rules.
+func NewLinkAcknowledgement(appAck ibcexported.Acknowledgement) LinkAcknowledgement {
+	return LinkAcknowledgement{
+		AppAcknowledgement: appAck.Acknowledgement(),
+		AppSuccess:         appAck.Success(),
+		Code:               LinkAckCodeOK,
+	}
+}
+
+// NewLinkErrorAcknowledgement returns a link acknowledgement for a packet rejected by the link rules with the given
+// code. The application acknowledgement must be an error acknowledgement in the format expected by the sending
+// application.
+func NewLinkErrorAcknowledgement(code LinkAckCode, err error, appAck ibcexported.Acknowledgement) LinkAcknowledgement {
+	return LinkAcknowledgement{
+		AppAcknowledgement: appAck.Acknowledgement(),
+		AppSuccess:         false,
+		Code:               code,
+		Reason:             err.Error(),
+	}
+}
+
+// LinkAcknowledgementFromBytes parses the given bytes into a link acknowledgement. An error is returned if the
+// bytes are not a link acknowledgement.
+func LinkAcknowledgementFromBytes(bz []byte) (LinkAcknowledgement, error) {
+	var ack LinkAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return LinkAcknowledgement{}, err
+	}
+
+	return ack, nil
+}
+
+// Success implements the Acknowledgement interface. The acknowledgement is successful if the packet was accepted
+// by the link rules and the underlying application.
+func (ack LinkAcknowledgement) Success() bool {
+	return ack.Code == LinkAckCodeOK && ack.AppSuccess
+}
+
+// Acknowledgement implements the Acknowledgement interface. It returns the acknowledgement serialized using JSON.
+func (ack LinkAcknowledgement) Acknowledgement() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ack))
+}
@@ -0,0 +1,55 @@
+package linkedpackets_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestLinkAcknowledgement(t *testing.T) {
+	testCases := []struct {
+		name       string
+		ack        linkedpackets.LinkAcknowledgement
+		expSuccess bool
+	}{
+		{
+			"success: app success acknowledgement",
+			linkedpackets.NewLinkAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{1})),
+			true,
+		},
+		{
+			"failure: app error acknowledgement",
+			linkedpackets.NewLinkAcknowledgement(channeltypes.NewErrorAcknowledgement(errors.New("app error"))),
+			false,
+		},
+		{
+			"failure: link error acknowledgement",
+			linkedpackets.NewLinkErrorAcknowledgement(
+				linkedpackets.LinkAckCodeBrokenChain, linkedpackets.ErrBrokenLink,
+				channeltypes.NewErrorAcknowledgement(linkedpackets.ErrBrokenLink),
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expSuccess, tc.ack.Success())
+
+			ack, err := linkedpackets.LinkAcknowledgementFromBytes(tc.ack.Acknowledgement())
+			require.NoError(t, err)
+			require.Equal(t, tc.ack, ack)
+		})
+	}
+}
+
+func TestLinkAcknowledgementFromBytesAppAcknowledgement(t *testing.T) {
+	_, err := linkedpackets.LinkAcknowledgementFromBytes(channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement())
+	require.Error(t, err)
+}
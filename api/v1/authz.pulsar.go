@@ -0,0 +1,929 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package linkedpacketsv1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_LinkAuthorization_2_list)(nil)
+
+type _LinkAuthorization_2_list struct {
+	list *[]*ChannelIdentifier
+}
+
+func (x *_LinkAuthorization_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LinkAuthorization_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LinkAuthorization_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LinkAuthorization_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LinkAuthorization_2_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LinkAuthorization_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LinkAuthorization_2_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LinkAuthorization_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LinkAuthorization                    protoreflect.MessageDescriptor
+	fd_LinkAuthorization_authorization_type protoreflect.FieldDescriptor
+	fd_LinkAuthorization_allowed_channels   protoreflect.FieldDescriptor
+	fd_LinkAuthorization_max_link_packets   protoreflect.FieldDescriptor
+	fd_LinkAuthorization_remaining_uses     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_authz_proto_init()
+	md_LinkAuthorization = File_srdtrk_linkedpackets_v1_authz_proto.Messages().ByName("LinkAuthorization")
+	fd_LinkAuthorization_authorization_type = md_LinkAuthorization.Fields().ByName("authorization_type")
+	fd_LinkAuthorization_allowed_channels = md_LinkAuthorization.Fields().ByName("allowed_channels")
+	fd_LinkAuthorization_max_link_packets = md_LinkAuthorization.Fields().ByName("max_link_packets")
+	fd_LinkAuthorization_remaining_uses = md_LinkAuthorization.Fields().ByName("remaining_uses")
+}
+
+var _ protoreflect.Message = (*fastReflection_LinkAuthorization)(nil)
+
+type fastReflection_LinkAuthorization LinkAuthorization
+
+func (x *LinkAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinkAuthorization)(x)
+}
+
+func (x *LinkAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LinkAuthorization_messageType fastReflection_LinkAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_LinkAuthorization_messageType{}
+
+type fastReflection_LinkAuthorization_messageType struct{}
+
+func (x fastReflection_LinkAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinkAuthorization)(nil)
+}
+func (x fastReflection_LinkAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinkAuthorization)
+}
+func (x fastReflection_LinkAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkAuthorization
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LinkAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkAuthorization
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LinkAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_LinkAuthorization_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LinkAuthorization) New() protoreflect.Message {
+	return new(fastReflection_LinkAuthorization)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LinkAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*LinkAuthorization)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LinkAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuthorizationType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AuthorizationType))
+		if !f(fd_LinkAuthorization_authorization_type, value) {
+			return
+		}
+	}
+	if len(x.AllowedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_LinkAuthorization_2_list{list: &x.AllowedChannels})
+		if !f(fd_LinkAuthorization_allowed_channels, value) {
+			return
+		}
+	}
+	if x.MaxLinkPackets != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLinkPackets)
+		if !f(fd_LinkAuthorization_max_link_packets, value) {
+			return
+		}
+	}
+	if x.RemainingUses != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemainingUses)
+		if !f(fd_LinkAuthorization_remaining_uses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LinkAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type":
+		return x.AuthorizationType != 0
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels":
+		return len(x.AllowedChannels) != 0
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.max_link_packets":
+		return x.MaxLinkPackets != uint64(0)
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.remaining_uses":
+		return x.RemainingUses != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAuthorization"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type":
+		x.AuthorizationType = 0
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels":
+		x.AllowedChannels = nil
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.max_link_packets":
+		x.MaxLinkPackets = uint64(0)
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.remaining_uses":
+		x.RemainingUses = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAuthorization"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LinkAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type":
+		value := x.AuthorizationType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels":
+		if len(x.AllowedChannels) == 0 {
+			return protoreflect.ValueOfList(&_LinkAuthorization_2_list{})
+		}
+		listValue := &_LinkAuthorization_2_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.max_link_packets":
+		value := x.MaxLinkPackets
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.remaining_uses":
+		value := x.RemainingUses
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAuthorization"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAuthorization does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type":
+		x.AuthorizationType = (LinkAuthorizationType)(value.Enum())
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels":
+		lv := value.List()
+		clv := lv.(*_LinkAuthorization_2_list)
+		x.AllowedChannels = *clv.list
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.max_link_packets":
+		x.MaxLinkPackets = value.Uint()
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.remaining_uses":
+		x.RemainingUses = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAuthorization"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels":
+		if x.AllowedChannels == nil {
+			x.AllowedChannels = []*ChannelIdentifier{}
+		}
+		value := &_LinkAuthorization_2_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type":
+		panic(fmt.Errorf("field authorization_type of message srdtrk.linkedpackets.v1.LinkAuthorization is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.max_link_packets":
+		panic(fmt.Errorf("field max_link_packets of message srdtrk.linkedpackets.v1.LinkAuthorization is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.remaining_uses":
+		panic(fmt.Errorf("field remaining_uses of message srdtrk.linkedpackets.v1.LinkAuthorization is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAuthorization"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LinkAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_LinkAuthorization_2_list{list: &list})
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.max_link_packets":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.LinkAuthorization.remaining_uses":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAuthorization"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LinkAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.LinkAuthorization", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LinkAuthorization) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAuthorization) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LinkAuthorization) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LinkAuthorization) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LinkAuthorization)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuthorizationType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuthorizationType))
+		}
+		if len(x.AllowedChannels) > 0 {
+			for _, e := range x.AllowedChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxLinkPackets != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLinkPackets))
+		}
+		if x.RemainingUses != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingUses))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LinkAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RemainingUses != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingUses))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxLinkPackets != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLinkPackets))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.AllowedChannels) > 0 {
+			for iNdEx := len(x.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.AuthorizationType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuthorizationType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LinkAuthorization)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkAuthorization: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+				}
+				x.AuthorizationType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuthorizationType |= LinkAuthorizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedChannels = append(x.AllowedChannels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedChannels[len(x.AllowedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLinkPackets", wireType)
+				}
+				x.MaxLinkPackets = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLinkPackets |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+				}
+				x.RemainingUses = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingUses |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: srdtrk/linkedpackets/v1/authz.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LinkAuthorizationType defines the message type a LinkAuthorization allows.
+type LinkAuthorizationType int32
+
+const (
+	// LINK_AUTHORIZATION_TYPE_UNSPECIFIED defines an invalid authorization type.
+	LinkAuthorizationType_LINK_AUTHORIZATION_TYPE_UNSPECIFIED LinkAuthorizationType = 0
+	// LINK_AUTHORIZATION_TYPE_INIT_LINK allows the grantee to send MsgInitLink.
+	LinkAuthorizationType_LINK_AUTHORIZATION_TYPE_INIT_LINK LinkAuthorizationType = 1
+	// LINK_AUTHORIZATION_TYPE_STOP_LINK allows the grantee to send MsgStopLink.
+	LinkAuthorizationType_LINK_AUTHORIZATION_TYPE_STOP_LINK LinkAuthorizationType = 2
+)
+
+// Enum value maps for LinkAuthorizationType.
+var (
+	LinkAuthorizationType_name = map[int32]string{
+		0: "LINK_AUTHORIZATION_TYPE_UNSPECIFIED",
+		1: "LINK_AUTHORIZATION_TYPE_INIT_LINK",
+		2: "LINK_AUTHORIZATION_TYPE_STOP_LINK",
+	}
+	LinkAuthorizationType_value = map[string]int32{
+		"LINK_AUTHORIZATION_TYPE_UNSPECIFIED": 0,
+		"LINK_AUTHORIZATION_TYPE_INIT_LINK":   1,
+		"LINK_AUTHORIZATION_TYPE_STOP_LINK":   2,
+	}
+)
+
+func (x LinkAuthorizationType) Enum() *LinkAuthorizationType {
+	p := new(LinkAuthorizationType)
+	*p = x
+	return p
+}
+
+func (x LinkAuthorizationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkAuthorizationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_srdtrk_linkedpackets_v1_authz_proto_enumTypes[0].Descriptor()
+}
+
+func (LinkAuthorizationType) Type() protoreflect.EnumType {
+	return &file_srdtrk_linkedpackets_v1_authz_proto_enumTypes[0]
+}
+
+func (x LinkAuthorizationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkAuthorizationType.Descriptor instead.
+func (LinkAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+// LinkAuthorization allows the grantee to open or stop the links of the granter. The links opened by the
+// grantee are owned by the granter.
+type LinkAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authorization_type is the message type the grantee is allowed to send.
+	AuthorizationType LinkAuthorizationType `protobuf:"varint,1,opt,name=authorization_type,json=authorizationType,proto3,enum=srdtrk.linkedpackets.v1.LinkAuthorizationType" json:"authorization_type,omitempty"`
+	// allowed_channels are the channels on which the packets of the links opened by the grantee can be sent.
+	// Any channel is allowed if empty. Only applies to MsgInitLink.
+	AllowedChannels []*ChannelIdentifier `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// max_link_packets is the maximum number of packets of the links opened by the grantee. Zero means no limit.
+	// Only applies to MsgInitLink.
+	MaxLinkPackets uint64 `protobuf:"varint,3,opt,name=max_link_packets,json=maxLinkPackets,proto3" json:"max_link_packets,omitempty"`
+	// remaining_uses is the number of times the grantee can still use the authorization. Zero means no limit.
+	// The authorization is removed once it is used for the last time.
+	RemainingUses uint64 `protobuf:"varint,4,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
+}
+
+func (x *LinkAuthorization) Reset() {
+	*x = LinkAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_authz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkAuthorization) ProtoMessage() {}
+
+// Deprecated: Use LinkAuthorization.ProtoReflect.Descriptor instead.
+func (*LinkAuthorization) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LinkAuthorization) GetAuthorizationType() LinkAuthorizationType {
+	if x != nil {
+		return x.AuthorizationType
+	}
+	return LinkAuthorizationType_LINK_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+func (x *LinkAuthorization) GetAllowedChannels() []*ChannelIdentifier {
+	if x != nil {
+		return x.AllowedChannels
+	}
+	return nil
+}
+
+func (x *LinkAuthorization) GetMaxLinkPackets() uint64 {
+	if x != nil {
+		return x.MaxLinkPackets
+	}
+	return 0
+}
+
+func (x *LinkAuthorization) GetRemainingUses() uint64 {
+	if x != nil {
+		return x.RemainingUses
+	}
+	return 0
+}
+
+var File_srdtrk_linkedpackets_v1_authz_proto protoreflect.FileDescriptor
+
+var file_srdtrk_linkedpackets_v1_authz_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x23, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a,
+	0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x72, 0x64, 0x74,
+	0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5b, 0x0a, 0x10,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x73, 0x3a, 0x4a, 0xca, 0xb4, 0x2d, 0x22,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2f, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x80, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4d, 0x0a, 0x23, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49,
+	0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x24, 0x8a, 0x9d, 0x20, 0x20, 0x4c,
+	0x69, 0x6e, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x48, 0x0a, 0x21, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f,
+	0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x01, 0x1a, 0x21, 0x8a, 0x9d, 0x20, 0x1d, 0x4c, 0x69, 0x6e, 0x6b,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x48, 0x0a, 0x21, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x02,
+	0x1a, 0x21, 0x8a, 0x9d, 0x20, 0x1d, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x4c,
+	0x69, 0x6e, 0x6b, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xf4, 0x01, 0x0a, 0x1b, 0x63, 0x6f,
+	0x6d, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x7a,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4c, 0x58, 0xaa, 0x02, 0x17, 0x53, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x23, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x3a, 0x3a, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_srdtrk_linkedpackets_v1_authz_proto_rawDescOnce sync.Once
+	file_srdtrk_linkedpackets_v1_authz_proto_rawDescData = file_srdtrk_linkedpackets_v1_authz_proto_rawDesc
+)
+
+func file_srdtrk_linkedpackets_v1_authz_proto_rawDescGZIP() []byte {
+	file_srdtrk_linkedpackets_v1_authz_proto_rawDescOnce.Do(func() {
+		file_srdtrk_linkedpackets_v1_authz_proto_rawDescData = protoimpl.X.CompressGZIP(file_srdtrk_linkedpackets_v1_authz_proto_rawDescData)
+	})
+	return file_srdtrk_linkedpackets_v1_authz_proto_rawDescData
+}
+
+var file_srdtrk_linkedpackets_v1_authz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_srdtrk_linkedpackets_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_srdtrk_linkedpackets_v1_authz_proto_goTypes = []interface{}{
+	(LinkAuthorizationType)(0), // 0: srdtrk.linkedpackets.v1.LinkAuthorizationType
+	(*LinkAuthorization)(nil),  // 1: srdtrk.linkedpackets.v1.LinkAuthorization
+	(*ChannelIdentifier)(nil),  // 2: srdtrk.linkedpackets.v1.ChannelIdentifier
+}
+var file_srdtrk_linkedpackets_v1_authz_proto_depIdxs = []int32{
+	0, // 0: srdtrk.linkedpackets.v1.LinkAuthorization.authorization_type:type_name -> srdtrk.linkedpackets.v1.LinkAuthorizationType
+	2, // 1: srdtrk.linkedpackets.v1.LinkAuthorization.allowed_channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_srdtrk_linkedpackets_v1_authz_proto_init() }
+func file_srdtrk_linkedpackets_v1_authz_proto_init() {
+	if File_srdtrk_linkedpackets_v1_authz_proto != nil {
+		return
+	}
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_srdtrk_linkedpackets_v1_authz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkAuthorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_srdtrk_linkedpackets_v1_authz_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_srdtrk_linkedpackets_v1_authz_proto_goTypes,
+		DependencyIndexes: file_srdtrk_linkedpackets_v1_authz_proto_depIdxs,
+		EnumInfos:         file_srdtrk_linkedpackets_v1_authz_proto_enumTypes,
+		MessageInfos:      file_srdtrk_linkedpackets_v1_authz_proto_msgTypes,
+	}.Build()
+	File_srdtrk_linkedpackets_v1_authz_proto = out.File
+	file_srdtrk_linkedpackets_v1_authz_proto_rawDesc = nil
+	file_srdtrk_linkedpackets_v1_authz_proto_goTypes = nil
+	file_srdtrk_linkedpackets_v1_authz_proto_depIdxs = nil
+}
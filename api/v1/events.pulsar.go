@@ -0,0 +1,13208 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package linkedpacketsv1
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EventLinkChannelEnabled            protoreflect.MessageDescriptor
+	fd_EventLinkChannelEnabled_port_id    protoreflect.FieldDescriptor
+	fd_EventLinkChannelEnabled_channel_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkChannelEnabled = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkChannelEnabled")
+	fd_EventLinkChannelEnabled_port_id = md_EventLinkChannelEnabled.Fields().ByName("port_id")
+	fd_EventLinkChannelEnabled_channel_id = md_EventLinkChannelEnabled.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkChannelEnabled)(nil)
+
+type fastReflection_EventLinkChannelEnabled EventLinkChannelEnabled
+
+func (x *EventLinkChannelEnabled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkChannelEnabled)(x)
+}
+
+func (x *EventLinkChannelEnabled) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkChannelEnabled_messageType fastReflection_EventLinkChannelEnabled_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkChannelEnabled_messageType{}
+
+type fastReflection_EventLinkChannelEnabled_messageType struct{}
+
+func (x fastReflection_EventLinkChannelEnabled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkChannelEnabled)(nil)
+}
+func (x fastReflection_EventLinkChannelEnabled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkChannelEnabled)
+}
+func (x fastReflection_EventLinkChannelEnabled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkChannelEnabled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkChannelEnabled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkChannelEnabled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkChannelEnabled) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkChannelEnabled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkChannelEnabled) New() protoreflect.Message {
+	return new(fastReflection_EventLinkChannelEnabled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkChannelEnabled) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkChannelEnabled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkChannelEnabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_EventLinkChannelEnabled_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_EventLinkChannelEnabled_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkChannelEnabled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.port_id":
+		return x.PortId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkChannelEnabled"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkChannelEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkChannelEnabled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.port_id":
+		x.PortId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkChannelEnabled"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkChannelEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkChannelEnabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkChannelEnabled"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkChannelEnabled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkChannelEnabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.port_id":
+		x.PortId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkChannelEnabled"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkChannelEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkChannelEnabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.port_id":
+		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.EventLinkChannelEnabled is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.channel_id":
+		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.EventLinkChannelEnabled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkChannelEnabled"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkChannelEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkChannelEnabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.port_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkChannelEnabled.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkChannelEnabled"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkChannelEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkChannelEnabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkChannelEnabled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkChannelEnabled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkChannelEnabled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkChannelEnabled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkChannelEnabled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkChannelEnabled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkChannelEnabled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkChannelEnabled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkChannelEnabled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkChannelEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkOpened         protoreflect.MessageDescriptor
+	fd_EventLinkOpened_link_id protoreflect.FieldDescriptor
+	fd_EventLinkOpened_owner   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkOpened = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkOpened")
+	fd_EventLinkOpened_link_id = md_EventLinkOpened.Fields().ByName("link_id")
+	fd_EventLinkOpened_owner = md_EventLinkOpened.Fields().ByName("owner")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkOpened)(nil)
+
+type fastReflection_EventLinkOpened EventLinkOpened
+
+func (x *EventLinkOpened) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkOpened)(x)
+}
+
+func (x *EventLinkOpened) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkOpened_messageType fastReflection_EventLinkOpened_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkOpened_messageType{}
+
+type fastReflection_EventLinkOpened_messageType struct{}
+
+func (x fastReflection_EventLinkOpened_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkOpened)(nil)
+}
+func (x fastReflection_EventLinkOpened_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkOpened)
+}
+func (x fastReflection_EventLinkOpened_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkOpened
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkOpened) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkOpened
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkOpened) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkOpened_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkOpened) New() protoreflect.Message {
+	return new(fastReflection_EventLinkOpened)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkOpened) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkOpened)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkOpened) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkOpened_link_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventLinkOpened_owner, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkOpened) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.owner":
+		return x.Owner != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkOpened"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkOpened does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkOpened) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.owner":
+		x.Owner = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkOpened"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkOpened does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkOpened) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkOpened"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkOpened does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkOpened) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.owner":
+		x.Owner = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkOpened"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkOpened does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkOpened) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkOpened is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.EventLinkOpened is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkOpened"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkOpened does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkOpened) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkOpened.owner":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkOpened"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkOpened does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkOpened) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkOpened", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkOpened) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkOpened) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkOpened) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkOpened) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkOpened)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkOpened)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkOpened)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkOpened: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkOpened: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkPacketSent             protoreflect.MessageDescriptor
+	fd_EventLinkPacketSent_link_id     protoreflect.FieldDescriptor
+	fd_EventLinkPacketSent_link_index  protoreflect.FieldDescriptor
+	fd_EventLinkPacketSent_packet      protoreflect.FieldDescriptor
+	fd_EventLinkPacketSent_prev_packet protoreflect.FieldDescriptor
+	fd_EventLinkPacketSent_last_packet protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkPacketSent = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkPacketSent")
+	fd_EventLinkPacketSent_link_id = md_EventLinkPacketSent.Fields().ByName("link_id")
+	fd_EventLinkPacketSent_link_index = md_EventLinkPacketSent.Fields().ByName("link_index")
+	fd_EventLinkPacketSent_packet = md_EventLinkPacketSent.Fields().ByName("packet")
+	fd_EventLinkPacketSent_prev_packet = md_EventLinkPacketSent.Fields().ByName("prev_packet")
+	fd_EventLinkPacketSent_last_packet = md_EventLinkPacketSent.Fields().ByName("last_packet")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkPacketSent)(nil)
+
+type fastReflection_EventLinkPacketSent EventLinkPacketSent
+
+func (x *EventLinkPacketSent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketSent)(x)
+}
+
+func (x *EventLinkPacketSent) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkPacketSent_messageType fastReflection_EventLinkPacketSent_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkPacketSent_messageType{}
+
+type fastReflection_EventLinkPacketSent_messageType struct{}
+
+func (x fastReflection_EventLinkPacketSent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketSent)(nil)
+}
+func (x fastReflection_EventLinkPacketSent_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketSent)
+}
+func (x fastReflection_EventLinkPacketSent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketSent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkPacketSent) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketSent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkPacketSent) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkPacketSent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkPacketSent) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketSent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkPacketSent) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkPacketSent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkPacketSent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkPacketSent_link_id, value) {
+			return
+		}
+	}
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_EventLinkPacketSent_link_index, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkPacketSent_packet, value) {
+			return
+		}
+	}
+	if x.PrevPacket != nil {
+		value := protoreflect.ValueOfMessage(x.PrevPacket.ProtoReflect())
+		if !f(fd_EventLinkPacketSent_prev_packet, value) {
+			return
+		}
+	}
+	if x.LastPacket != false {
+		value := protoreflect.ValueOfBool(x.LastPacket)
+		if !f(fd_EventLinkPacketSent_last_packet, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkPacketSent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet":
+		return x.PrevPacket != nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.last_packet":
+		return x.LastPacket != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketSent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet":
+		x.PrevPacket = nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.last_packet":
+		x.LastPacket = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkPacketSent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet":
+		value := x.PrevPacket
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.last_packet":
+		value := x.LastPacket
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketSent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketSent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet":
+		x.PrevPacket = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.last_packet":
+		x.LastPacket = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketSent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet":
+		if x.PrevPacket == nil {
+			x.PrevPacket = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.PrevPacket.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkPacketSent is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.EventLinkPacketSent is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.last_packet":
+		panic(fmt.Errorf("field last_packet of message srdtrk.linkedpackets.v1.EventLinkPacketSent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkPacketSent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketSent.last_packet":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkPacketSent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkPacketSent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkPacketSent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketSent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkPacketSent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkPacketSent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkPacketSent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrevPacket != nil {
+			l = options.Size(x.PrevPacket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastPacket {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketSent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastPacket {
+			i--
+			if x.LastPacket {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PrevPacket != nil {
+			encoded, err := options.Marshal(x.PrevPacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketSent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketSent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevPacket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrevPacket == nil {
+					x.PrevPacket = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrevPacket); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastPacket", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LastPacket = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkClosed              protoreflect.MessageDescriptor
+	fd_EventLinkClosed_link_id      protoreflect.FieldDescriptor
+	fd_EventLinkClosed_owner        protoreflect.FieldDescriptor
+	fd_EventLinkClosed_packet_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkClosed = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkClosed")
+	fd_EventLinkClosed_link_id = md_EventLinkClosed.Fields().ByName("link_id")
+	fd_EventLinkClosed_owner = md_EventLinkClosed.Fields().ByName("owner")
+	fd_EventLinkClosed_packet_count = md_EventLinkClosed.Fields().ByName("packet_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkClosed)(nil)
+
+type fastReflection_EventLinkClosed EventLinkClosed
+
+func (x *EventLinkClosed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkClosed)(x)
+}
+
+func (x *EventLinkClosed) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkClosed_messageType fastReflection_EventLinkClosed_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkClosed_messageType{}
+
+type fastReflection_EventLinkClosed_messageType struct{}
+
+func (x fastReflection_EventLinkClosed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkClosed)(nil)
+}
+func (x fastReflection_EventLinkClosed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkClosed)
+}
+func (x fastReflection_EventLinkClosed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkClosed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkClosed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkClosed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkClosed) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkClosed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkClosed) New() protoreflect.Message {
+	return new(fastReflection_EventLinkClosed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkClosed) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkClosed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkClosed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkClosed_link_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventLinkClosed_owner, value) {
+			return
+		}
+	}
+	if x.PacketCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketCount)
+		if !f(fd_EventLinkClosed_packet_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkClosed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.owner":
+		return x.Owner != ""
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.packet_count":
+		return x.PacketCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkClosed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.owner":
+		x.Owner = ""
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.packet_count":
+		x.PacketCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkClosed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.packet_count":
+		value := x.PacketCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkClosed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkClosed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.owner":
+		x.Owner = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.packet_count":
+		x.PacketCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkClosed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkClosed is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.EventLinkClosed is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.packet_count":
+		panic(fmt.Errorf("field packet_count of message srdtrk.linkedpackets.v1.EventLinkClosed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkClosed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.owner":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkClosed.packet_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkClosed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkClosed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkClosed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkClosed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkClosed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkClosed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkClosed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PacketCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkClosed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PacketCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkClosed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkClosed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketCount", wireType)
+				}
+				x.PacketCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkPacketOutcome         protoreflect.MessageDescriptor
+	fd_EventLinkPacketOutcome_link_id protoreflect.FieldDescriptor
+	fd_EventLinkPacketOutcome_packet  protoreflect.FieldDescriptor
+	fd_EventLinkPacketOutcome_outcome protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkPacketOutcome = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkPacketOutcome")
+	fd_EventLinkPacketOutcome_link_id = md_EventLinkPacketOutcome.Fields().ByName("link_id")
+	fd_EventLinkPacketOutcome_packet = md_EventLinkPacketOutcome.Fields().ByName("packet")
+	fd_EventLinkPacketOutcome_outcome = md_EventLinkPacketOutcome.Fields().ByName("outcome")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkPacketOutcome)(nil)
+
+type fastReflection_EventLinkPacketOutcome EventLinkPacketOutcome
+
+func (x *EventLinkPacketOutcome) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketOutcome)(x)
+}
+
+func (x *EventLinkPacketOutcome) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkPacketOutcome_messageType fastReflection_EventLinkPacketOutcome_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkPacketOutcome_messageType{}
+
+type fastReflection_EventLinkPacketOutcome_messageType struct{}
+
+func (x fastReflection_EventLinkPacketOutcome_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketOutcome)(nil)
+}
+func (x fastReflection_EventLinkPacketOutcome_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketOutcome)
+}
+func (x fastReflection_EventLinkPacketOutcome_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketOutcome
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkPacketOutcome) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketOutcome
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkPacketOutcome) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkPacketOutcome_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkPacketOutcome) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketOutcome)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkPacketOutcome) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkPacketOutcome)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkPacketOutcome) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkPacketOutcome_link_id, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkPacketOutcome_packet, value) {
+			return
+		}
+	}
+	if x.Outcome != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Outcome))
+		if !f(fd_EventLinkPacketOutcome_outcome, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkPacketOutcome) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome":
+		return x.Outcome != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketOutcome"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketOutcome) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome":
+		x.Outcome = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketOutcome"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkPacketOutcome) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketOutcome"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketOutcome does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketOutcome) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome":
+		x.Outcome = (PacketOutcome)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketOutcome"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketOutcome) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkPacketOutcome is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome":
+		panic(fmt.Errorf("field outcome of message srdtrk.linkedpackets.v1.EventLinkPacketOutcome is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketOutcome"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkPacketOutcome) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketOutcome"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkPacketOutcome) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkPacketOutcome", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkPacketOutcome) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketOutcome) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkPacketOutcome) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkPacketOutcome) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkPacketOutcome)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Outcome != 0 {
+			n += 1 + runtime.Sov(uint64(x.Outcome))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketOutcome)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Outcome != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Outcome))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketOutcome)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketOutcome: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+				}
+				x.Outcome = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Outcome |= PacketOutcome(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkResolved_3_list)(nil)
+
+type _EventLinkResolved_3_list struct {
+	list *[]*LinkPacket
+}
+
+func (x *_EventLinkResolved_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkResolved_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkResolved_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkResolved_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkResolved_3_list) AppendMutable() protoreflect.Value {
+	v := new(LinkPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkResolved_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkResolved_3_list) NewElement() protoreflect.Value {
+	v := new(LinkPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkResolved_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkResolved         protoreflect.MessageDescriptor
+	fd_EventLinkResolved_link_id protoreflect.FieldDescriptor
+	fd_EventLinkResolved_status  protoreflect.FieldDescriptor
+	fd_EventLinkResolved_packets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkResolved = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkResolved")
+	fd_EventLinkResolved_link_id = md_EventLinkResolved.Fields().ByName("link_id")
+	fd_EventLinkResolved_status = md_EventLinkResolved.Fields().ByName("status")
+	fd_EventLinkResolved_packets = md_EventLinkResolved.Fields().ByName("packets")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkResolved)(nil)
+
+type fastReflection_EventLinkResolved EventLinkResolved
+
+func (x *EventLinkResolved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkResolved)(x)
+}
+
+func (x *EventLinkResolved) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkResolved_messageType fastReflection_EventLinkResolved_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkResolved_messageType{}
+
+type fastReflection_EventLinkResolved_messageType struct{}
+
+func (x fastReflection_EventLinkResolved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkResolved)(nil)
+}
+func (x fastReflection_EventLinkResolved_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkResolved)
+}
+func (x fastReflection_EventLinkResolved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkResolved
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkResolved) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkResolved
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkResolved) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkResolved_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkResolved) New() protoreflect.Message {
+	return new(fastReflection_EventLinkResolved)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkResolved) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkResolved)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkResolved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkResolved_link_id, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventLinkResolved_status, value) {
+			return
+		}
+	}
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkResolved_3_list{list: &x.Packets})
+		if !f(fd_EventLinkResolved_packets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkResolved) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.status":
+		return x.Status != 0
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.packets":
+		return len(x.Packets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkResolved"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkResolved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkResolved) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.status":
+		x.Status = 0
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.packets":
+		x.Packets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkResolved"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkResolved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkResolved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkResolved_3_list{})
+		}
+		listValue := &_EventLinkResolved_3_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkResolved"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkResolved does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkResolved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.status":
+		x.Status = (LinkStatus)(value.Enum())
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.packets":
+		lv := value.List()
+		clv := lv.(*_EventLinkResolved_3_list)
+		x.Packets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkResolved"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkResolved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkResolved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.packets":
+		if x.Packets == nil {
+			x.Packets = []*LinkPacket{}
+		}
+		value := &_EventLinkResolved_3_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkResolved is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.status":
+		panic(fmt.Errorf("field status of message srdtrk.linkedpackets.v1.EventLinkResolved is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkResolved"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkResolved does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkResolved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.status":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.EventLinkResolved.packets":
+		list := []*LinkPacket{}
+		return protoreflect.ValueOfList(&_EventLinkResolved_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkResolved"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkResolved does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkResolved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkResolved", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkResolved) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkResolved) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkResolved) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkResolved) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkResolved)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkResolved)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkResolved)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkResolved: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= LinkStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Packets = append(x.Packets, &LinkPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkCompensationSent            protoreflect.MessageDescriptor
+	fd_EventLinkCompensationSent_link_id    protoreflect.FieldDescriptor
+	fd_EventLinkCompensationSent_link_index protoreflect.FieldDescriptor
+	fd_EventLinkCompensationSent_packet     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkCompensationSent = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkCompensationSent")
+	fd_EventLinkCompensationSent_link_id = md_EventLinkCompensationSent.Fields().ByName("link_id")
+	fd_EventLinkCompensationSent_link_index = md_EventLinkCompensationSent.Fields().ByName("link_index")
+	fd_EventLinkCompensationSent_packet = md_EventLinkCompensationSent.Fields().ByName("packet")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkCompensationSent)(nil)
+
+type fastReflection_EventLinkCompensationSent EventLinkCompensationSent
+
+func (x *EventLinkCompensationSent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkCompensationSent)(x)
+}
+
+func (x *EventLinkCompensationSent) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkCompensationSent_messageType fastReflection_EventLinkCompensationSent_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkCompensationSent_messageType{}
+
+type fastReflection_EventLinkCompensationSent_messageType struct{}
+
+func (x fastReflection_EventLinkCompensationSent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkCompensationSent)(nil)
+}
+func (x fastReflection_EventLinkCompensationSent_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkCompensationSent)
+}
+func (x fastReflection_EventLinkCompensationSent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkCompensationSent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkCompensationSent) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkCompensationSent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkCompensationSent) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkCompensationSent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkCompensationSent) New() protoreflect.Message {
+	return new(fastReflection_EventLinkCompensationSent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkCompensationSent) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkCompensationSent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkCompensationSent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkCompensationSent_link_id, value) {
+			return
+		}
+	}
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_EventLinkCompensationSent_link_index, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkCompensationSent_packet, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkCompensationSent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet":
+		return x.Packet != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCompensationSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCompensationSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCompensationSent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet":
+		x.Packet = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCompensationSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCompensationSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkCompensationSent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCompensationSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCompensationSent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCompensationSent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCompensationSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCompensationSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCompensationSent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkCompensationSent is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.EventLinkCompensationSent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCompensationSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCompensationSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkCompensationSent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCompensationSent"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCompensationSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkCompensationSent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkCompensationSent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkCompensationSent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCompensationSent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkCompensationSent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkCompensationSent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkCompensationSent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkCompensationSent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkCompensationSent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkCompensationSent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkCompensationSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkPacketReceived             protoreflect.MessageDescriptor
+	fd_EventLinkPacketReceived_link_id     protoreflect.FieldDescriptor
+	fd_EventLinkPacketReceived_link_index  protoreflect.FieldDescriptor
+	fd_EventLinkPacketReceived_packet      protoreflect.FieldDescriptor
+	fd_EventLinkPacketReceived_last_packet protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkPacketReceived = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkPacketReceived")
+	fd_EventLinkPacketReceived_link_id = md_EventLinkPacketReceived.Fields().ByName("link_id")
+	fd_EventLinkPacketReceived_link_index = md_EventLinkPacketReceived.Fields().ByName("link_index")
+	fd_EventLinkPacketReceived_packet = md_EventLinkPacketReceived.Fields().ByName("packet")
+	fd_EventLinkPacketReceived_last_packet = md_EventLinkPacketReceived.Fields().ByName("last_packet")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkPacketReceived)(nil)
+
+type fastReflection_EventLinkPacketReceived EventLinkPacketReceived
+
+func (x *EventLinkPacketReceived) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketReceived)(x)
+}
+
+func (x *EventLinkPacketReceived) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkPacketReceived_messageType fastReflection_EventLinkPacketReceived_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkPacketReceived_messageType{}
+
+type fastReflection_EventLinkPacketReceived_messageType struct{}
+
+func (x fastReflection_EventLinkPacketReceived_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketReceived)(nil)
+}
+func (x fastReflection_EventLinkPacketReceived_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketReceived)
+}
+func (x fastReflection_EventLinkPacketReceived_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketReceived
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkPacketReceived) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketReceived
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkPacketReceived) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkPacketReceived_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkPacketReceived) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketReceived)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkPacketReceived) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkPacketReceived)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkPacketReceived) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkPacketReceived_link_id, value) {
+			return
+		}
+	}
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_EventLinkPacketReceived_link_index, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkPacketReceived_packet, value) {
+			return
+		}
+	}
+	if x.LastPacket != false {
+		value := protoreflect.ValueOfBool(x.LastPacket)
+		if !f(fd_EventLinkPacketReceived_last_packet, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkPacketReceived) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.last_packet":
+		return x.LastPacket != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketReceived"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketReceived does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketReceived) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.last_packet":
+		x.LastPacket = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketReceived"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketReceived does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkPacketReceived) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.last_packet":
+		value := x.LastPacket
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketReceived"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketReceived does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketReceived) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.last_packet":
+		x.LastPacket = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketReceived"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketReceived does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketReceived) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkPacketReceived is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.EventLinkPacketReceived is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.last_packet":
+		panic(fmt.Errorf("field last_packet of message srdtrk.linkedpackets.v1.EventLinkPacketReceived is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketReceived"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketReceived does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkPacketReceived) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketReceived.last_packet":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketReceived"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketReceived does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkPacketReceived) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkPacketReceived", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkPacketReceived) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketReceived) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkPacketReceived) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkPacketReceived) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkPacketReceived)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastPacket {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketReceived)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastPacket {
+			i--
+			if x.LastPacket {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketReceived)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketReceived: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastPacket", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LastPacket = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkAcknowledgement        protoreflect.MessageDescriptor
+	fd_EventLinkAcknowledgement_packet protoreflect.FieldDescriptor
+	fd_EventLinkAcknowledgement_code   protoreflect.FieldDescriptor
+	fd_EventLinkAcknowledgement_reason protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkAcknowledgement = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkAcknowledgement")
+	fd_EventLinkAcknowledgement_packet = md_EventLinkAcknowledgement.Fields().ByName("packet")
+	fd_EventLinkAcknowledgement_code = md_EventLinkAcknowledgement.Fields().ByName("code")
+	fd_EventLinkAcknowledgement_reason = md_EventLinkAcknowledgement.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkAcknowledgement)(nil)
+
+type fastReflection_EventLinkAcknowledgement EventLinkAcknowledgement
+
+func (x *EventLinkAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkAcknowledgement)(x)
+}
+
+func (x *EventLinkAcknowledgement) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkAcknowledgement_messageType fastReflection_EventLinkAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkAcknowledgement_messageType{}
+
+type fastReflection_EventLinkAcknowledgement_messageType struct{}
+
+func (x fastReflection_EventLinkAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkAcknowledgement)(nil)
+}
+func (x fastReflection_EventLinkAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkAcknowledgement)
+}
+func (x fastReflection_EventLinkAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkAcknowledgement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkAcknowledgement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkAcknowledgement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_EventLinkAcknowledgement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkAcknowledgement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkAcknowledgement_packet, value) {
+			return
+		}
+	}
+	if x.Code != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Code))
+		if !f(fd_EventLinkAcknowledgement_code, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventLinkAcknowledgement_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code":
+		return x.Code != 0
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code":
+		x.Code = 0
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code":
+		value := x.Code
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkAcknowledgement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code":
+		x.Code = (LinkAckCode)(value.Enum())
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code":
+		panic(fmt.Errorf("field code of message srdtrk.linkedpackets.v1.EventLinkAcknowledgement is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.reason":
+		panic(fmt.Errorf("field reason of message srdtrk.linkedpackets.v1.EventLinkAcknowledgement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.EventLinkAcknowledgement.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkAcknowledgement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkAcknowledgement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkAcknowledgement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkAcknowledgement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkAcknowledgement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Code != 0 {
+			n += 1 + runtime.Sov(uint64(x.Code))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkAcknowledgement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Code != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Code))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkAcknowledgement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkAcknowledgement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				x.Code = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Code |= LinkAckCode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkDepositRefunded_3_list)(nil)
+
+type _EventLinkDepositRefunded_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventLinkDepositRefunded_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkDepositRefunded_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkDepositRefunded_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkDepositRefunded_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkDepositRefunded_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkDepositRefunded_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkDepositRefunded_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkDepositRefunded_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkDepositRefunded         protoreflect.MessageDescriptor
+	fd_EventLinkDepositRefunded_link_id protoreflect.FieldDescriptor
+	fd_EventLinkDepositRefunded_owner   protoreflect.FieldDescriptor
+	fd_EventLinkDepositRefunded_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkDepositRefunded = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkDepositRefunded")
+	fd_EventLinkDepositRefunded_link_id = md_EventLinkDepositRefunded.Fields().ByName("link_id")
+	fd_EventLinkDepositRefunded_owner = md_EventLinkDepositRefunded.Fields().ByName("owner")
+	fd_EventLinkDepositRefunded_amount = md_EventLinkDepositRefunded.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkDepositRefunded)(nil)
+
+type fastReflection_EventLinkDepositRefunded EventLinkDepositRefunded
+
+func (x *EventLinkDepositRefunded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkDepositRefunded)(x)
+}
+
+func (x *EventLinkDepositRefunded) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkDepositRefunded_messageType fastReflection_EventLinkDepositRefunded_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkDepositRefunded_messageType{}
+
+type fastReflection_EventLinkDepositRefunded_messageType struct{}
+
+func (x fastReflection_EventLinkDepositRefunded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkDepositRefunded)(nil)
+}
+func (x fastReflection_EventLinkDepositRefunded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkDepositRefunded)
+}
+func (x fastReflection_EventLinkDepositRefunded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkDepositRefunded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkDepositRefunded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkDepositRefunded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkDepositRefunded) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkDepositRefunded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkDepositRefunded) New() protoreflect.Message {
+	return new(fastReflection_EventLinkDepositRefunded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkDepositRefunded) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkDepositRefunded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkDepositRefunded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkDepositRefunded_link_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventLinkDepositRefunded_owner, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkDepositRefunded_3_list{list: &x.Amount})
+		if !f(fd_EventLinkDepositRefunded_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkDepositRefunded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.owner":
+		return x.Owner != ""
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkDepositRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkDepositRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkDepositRefunded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.owner":
+		x.Owner = ""
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkDepositRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkDepositRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkDepositRefunded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkDepositRefunded_3_list{})
+		}
+		listValue := &_EventLinkDepositRefunded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkDepositRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkDepositRefunded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkDepositRefunded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.owner":
+		x.Owner = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount":
+		lv := value.List()
+		clv := lv.(*_EventLinkDepositRefunded_3_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkDepositRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkDepositRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkDepositRefunded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_EventLinkDepositRefunded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkDepositRefunded is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.EventLinkDepositRefunded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkDepositRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkDepositRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkDepositRefunded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.owner":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventLinkDepositRefunded_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkDepositRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkDepositRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkDepositRefunded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkDepositRefunded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkDepositRefunded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkDepositRefunded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkDepositRefunded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkDepositRefunded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkDepositRefunded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkDepositRefunded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkDepositRefunded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkDepositRefunded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkDepositRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkExpired_3_list)(nil)
+
+type _EventLinkExpired_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventLinkExpired_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkExpired_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkExpired_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkExpired_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkExpired_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkExpired_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkExpired_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkExpired_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkExpired                   protoreflect.MessageDescriptor
+	fd_EventLinkExpired_link_id           protoreflect.FieldDescriptor
+	fd_EventLinkExpired_owner             protoreflect.FieldDescriptor
+	fd_EventLinkExpired_forfeited_deposit protoreflect.FieldDescriptor
+	fd_EventLinkExpired_action            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkExpired = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkExpired")
+	fd_EventLinkExpired_link_id = md_EventLinkExpired.Fields().ByName("link_id")
+	fd_EventLinkExpired_owner = md_EventLinkExpired.Fields().ByName("owner")
+	fd_EventLinkExpired_forfeited_deposit = md_EventLinkExpired.Fields().ByName("forfeited_deposit")
+	fd_EventLinkExpired_action = md_EventLinkExpired.Fields().ByName("action")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkExpired)(nil)
+
+type fastReflection_EventLinkExpired EventLinkExpired
+
+func (x *EventLinkExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkExpired)(x)
+}
+
+func (x *EventLinkExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkExpired_messageType fastReflection_EventLinkExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkExpired_messageType{}
+
+type fastReflection_EventLinkExpired_messageType struct{}
+
+func (x fastReflection_EventLinkExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkExpired)(nil)
+}
+func (x fastReflection_EventLinkExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkExpired)
+}
+func (x fastReflection_EventLinkExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkExpired) New() protoreflect.Message {
+	return new(fastReflection_EventLinkExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkExpired_link_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventLinkExpired_owner, value) {
+			return
+		}
+	}
+	if len(x.ForfeitedDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkExpired_3_list{list: &x.ForfeitedDeposit})
+		if !f(fd_EventLinkExpired_forfeited_deposit, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_EventLinkExpired_action, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.owner":
+		return x.Owner != ""
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit":
+		return len(x.ForfeitedDeposit) != 0
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.action":
+		return x.Action != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExpired"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.owner":
+		x.Owner = ""
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit":
+		x.ForfeitedDeposit = nil
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.action":
+		x.Action = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExpired"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit":
+		if len(x.ForfeitedDeposit) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkExpired_3_list{})
+		}
+		listValue := &_EventLinkExpired_3_list{list: &x.ForfeitedDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExpired"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.owner":
+		x.Owner = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit":
+		lv := value.List()
+		clv := lv.(*_EventLinkExpired_3_list)
+		x.ForfeitedDeposit = *clv.list
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.action":
+		x.Action = (ExpiredDepositAction)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExpired"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit":
+		if x.ForfeitedDeposit == nil {
+			x.ForfeitedDeposit = []*v1beta1.Coin{}
+		}
+		value := &_EventLinkExpired_3_list{list: &x.ForfeitedDeposit}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkExpired is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.EventLinkExpired is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.action":
+		panic(fmt.Errorf("field action of message srdtrk.linkedpackets.v1.EventLinkExpired is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExpired"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.owner":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventLinkExpired_3_list{list: &list})
+	case "srdtrk.linkedpackets.v1.EventLinkExpired.action":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExpired"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ForfeitedDeposit) > 0 {
+			for _, e := range x.ForfeitedDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ForfeitedDeposit) > 0 {
+			for iNdEx := len(x.ForfeitedDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForfeitedDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForfeitedDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForfeitedDeposit = append(x.ForfeitedDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForfeitedDeposit[len(x.ForfeitedDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= ExpiredDepositAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkRelayerRewarded_3_list)(nil)
+
+type _EventLinkRelayerRewarded_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkRelayerRewarded_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkRelayerRewarded         protoreflect.MessageDescriptor
+	fd_EventLinkRelayerRewarded_link_id protoreflect.FieldDescriptor
+	fd_EventLinkRelayerRewarded_relayer protoreflect.FieldDescriptor
+	fd_EventLinkRelayerRewarded_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkRelayerRewarded = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkRelayerRewarded")
+	fd_EventLinkRelayerRewarded_link_id = md_EventLinkRelayerRewarded.Fields().ByName("link_id")
+	fd_EventLinkRelayerRewarded_relayer = md_EventLinkRelayerRewarded.Fields().ByName("relayer")
+	fd_EventLinkRelayerRewarded_amount = md_EventLinkRelayerRewarded.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkRelayerRewarded)(nil)
+
+type fastReflection_EventLinkRelayerRewarded EventLinkRelayerRewarded
+
+func (x *EventLinkRelayerRewarded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkRelayerRewarded)(x)
+}
+
+func (x *EventLinkRelayerRewarded) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkRelayerRewarded_messageType fastReflection_EventLinkRelayerRewarded_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkRelayerRewarded_messageType{}
+
+type fastReflection_EventLinkRelayerRewarded_messageType struct{}
+
+func (x fastReflection_EventLinkRelayerRewarded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkRelayerRewarded)(nil)
+}
+func (x fastReflection_EventLinkRelayerRewarded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkRelayerRewarded)
+}
+func (x fastReflection_EventLinkRelayerRewarded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkRelayerRewarded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkRelayerRewarded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkRelayerRewarded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkRelayerRewarded) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkRelayerRewarded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkRelayerRewarded) New() protoreflect.Message {
+	return new(fastReflection_EventLinkRelayerRewarded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkRelayerRewarded) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkRelayerRewarded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkRelayerRewarded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkRelayerRewarded_link_id, value) {
+			return
+		}
+	}
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_EventLinkRelayerRewarded_relayer, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkRelayerRewarded_3_list{list: &x.Amount})
+		if !f(fd_EventLinkRelayerRewarded_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkRelayerRewarded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.relayer":
+		return x.Relayer != ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewarded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.relayer":
+		x.Relayer = ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkRelayerRewarded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.relayer":
+		value := x.Relayer
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkRelayerRewarded_3_list{})
+		}
+		listValue := &_EventLinkRelayerRewarded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewarded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.relayer":
+		x.Relayer = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount":
+		lv := value.List()
+		clv := lv.(*_EventLinkRelayerRewarded_3_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewarded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_EventLinkRelayerRewarded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.relayer":
+		panic(fmt.Errorf("field relayer of message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkRelayerRewarded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.relayer":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventLinkRelayerRewarded_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkRelayerRewarded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkRelayerRewarded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkRelayerRewarded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewarded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkRelayerRewarded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkRelayerRewarded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkRelayerRewarded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Relayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkRelayerRewarded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkRelayerRewarded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkRelayerRewarded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkRelayerRewarded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkRelayerRewardRefunded_3_list)(nil)
+
+type _EventLinkRelayerRewardRefunded_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkRelayerRewardRefunded_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkRelayerRewardRefunded         protoreflect.MessageDescriptor
+	fd_EventLinkRelayerRewardRefunded_link_id protoreflect.FieldDescriptor
+	fd_EventLinkRelayerRewardRefunded_owner   protoreflect.FieldDescriptor
+	fd_EventLinkRelayerRewardRefunded_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkRelayerRewardRefunded = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkRelayerRewardRefunded")
+	fd_EventLinkRelayerRewardRefunded_link_id = md_EventLinkRelayerRewardRefunded.Fields().ByName("link_id")
+	fd_EventLinkRelayerRewardRefunded_owner = md_EventLinkRelayerRewardRefunded.Fields().ByName("owner")
+	fd_EventLinkRelayerRewardRefunded_amount = md_EventLinkRelayerRewardRefunded.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkRelayerRewardRefunded)(nil)
+
+type fastReflection_EventLinkRelayerRewardRefunded EventLinkRelayerRewardRefunded
+
+func (x *EventLinkRelayerRewardRefunded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkRelayerRewardRefunded)(x)
+}
+
+func (x *EventLinkRelayerRewardRefunded) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkRelayerRewardRefunded_messageType fastReflection_EventLinkRelayerRewardRefunded_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkRelayerRewardRefunded_messageType{}
+
+type fastReflection_EventLinkRelayerRewardRefunded_messageType struct{}
+
+func (x fastReflection_EventLinkRelayerRewardRefunded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkRelayerRewardRefunded)(nil)
+}
+func (x fastReflection_EventLinkRelayerRewardRefunded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkRelayerRewardRefunded)
+}
+func (x fastReflection_EventLinkRelayerRewardRefunded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkRelayerRewardRefunded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkRelayerRewardRefunded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkRelayerRewardRefunded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) New() protoreflect.Message {
+	return new(fastReflection_EventLinkRelayerRewardRefunded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkRelayerRewardRefunded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkRelayerRewardRefunded_link_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventLinkRelayerRewardRefunded_owner, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkRelayerRewardRefunded_3_list{list: &x.Amount})
+		if !f(fd_EventLinkRelayerRewardRefunded_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.owner":
+		return x.Owner != ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.owner":
+		x.Owner = ""
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkRelayerRewardRefunded_3_list{})
+		}
+		listValue := &_EventLinkRelayerRewardRefunded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.owner":
+		x.Owner = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount":
+		lv := value.List()
+		clv := lv.(*_EventLinkRelayerRewardRefunded_3_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_EventLinkRelayerRewardRefunded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.owner":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventLinkRelayerRewardRefunded_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkRelayerRewardRefunded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkRelayerRewardRefunded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkRelayerRewardRefunded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkRelayerRewardRefunded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkRelayerRewardRefunded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkRelayerRewardRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkCallback                  protoreflect.MessageDescriptor
+	fd_EventLinkCallback_link_id          protoreflect.FieldDescriptor
+	fd_EventLinkCallback_callback_type    protoreflect.FieldDescriptor
+	fd_EventLinkCallback_contract_address protoreflect.FieldDescriptor
+	fd_EventLinkCallback_error            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkCallback = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkCallback")
+	fd_EventLinkCallback_link_id = md_EventLinkCallback.Fields().ByName("link_id")
+	fd_EventLinkCallback_callback_type = md_EventLinkCallback.Fields().ByName("callback_type")
+	fd_EventLinkCallback_contract_address = md_EventLinkCallback.Fields().ByName("contract_address")
+	fd_EventLinkCallback_error = md_EventLinkCallback.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkCallback)(nil)
+
+type fastReflection_EventLinkCallback EventLinkCallback
+
+func (x *EventLinkCallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkCallback)(x)
+}
+
+func (x *EventLinkCallback) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkCallback_messageType fastReflection_EventLinkCallback_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkCallback_messageType{}
+
+type fastReflection_EventLinkCallback_messageType struct{}
+
+func (x fastReflection_EventLinkCallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkCallback)(nil)
+}
+func (x fastReflection_EventLinkCallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkCallback)
+}
+func (x fastReflection_EventLinkCallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkCallback
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkCallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkCallback
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkCallback) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkCallback_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkCallback) New() protoreflect.Message {
+	return new(fastReflection_EventLinkCallback)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkCallback) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkCallback)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkCallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkCallback_link_id, value) {
+			return
+		}
+	}
+	if x.CallbackType != "" {
+		value := protoreflect.ValueOfString(x.CallbackType)
+		if !f(fd_EventLinkCallback_callback_type, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_EventLinkCallback_contract_address, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventLinkCallback_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkCallback) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.callback_type":
+		return x.CallbackType != ""
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.contract_address":
+		return x.ContractAddress != ""
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCallback"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCallback) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.callback_type":
+		x.CallbackType = ""
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.contract_address":
+		x.ContractAddress = ""
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCallback"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkCallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.callback_type":
+		value := x.CallbackType
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCallback"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCallback does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.callback_type":
+		x.CallbackType = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCallback"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkCallback is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.callback_type":
+		panic(fmt.Errorf("field callback_type of message srdtrk.linkedpackets.v1.EventLinkCallback is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.contract_address":
+		panic(fmt.Errorf("field contract_address of message srdtrk.linkedpackets.v1.EventLinkCallback is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.error":
+		panic(fmt.Errorf("field error of message srdtrk.linkedpackets.v1.EventLinkCallback is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCallback"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkCallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.callback_type":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.contract_address":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkCallback.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkCallback"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkCallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkCallback", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkCallback) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkCallback) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkCallback) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkCallback) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkCallback)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CallbackType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkCallback)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CallbackType) > 0 {
+			i -= len(x.CallbackType)
+			copy(dAtA[i:], x.CallbackType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CallbackType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkCallback)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkCallback: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CallbackType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkPacketForwarded            protoreflect.MessageDescriptor
+	fd_EventLinkPacketForwarded_link_id    protoreflect.FieldDescriptor
+	fd_EventLinkPacketForwarded_link_index protoreflect.FieldDescriptor
+	fd_EventLinkPacketForwarded_packet     protoreflect.FieldDescriptor
+	fd_EventLinkPacketForwarded_hop        protoreflect.FieldDescriptor
+	fd_EventLinkPacketForwarded_prev_hop   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkPacketForwarded = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkPacketForwarded")
+	fd_EventLinkPacketForwarded_link_id = md_EventLinkPacketForwarded.Fields().ByName("link_id")
+	fd_EventLinkPacketForwarded_link_index = md_EventLinkPacketForwarded.Fields().ByName("link_index")
+	fd_EventLinkPacketForwarded_packet = md_EventLinkPacketForwarded.Fields().ByName("packet")
+	fd_EventLinkPacketForwarded_hop = md_EventLinkPacketForwarded.Fields().ByName("hop")
+	fd_EventLinkPacketForwarded_prev_hop = md_EventLinkPacketForwarded.Fields().ByName("prev_hop")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkPacketForwarded)(nil)
+
+type fastReflection_EventLinkPacketForwarded EventLinkPacketForwarded
+
+func (x *EventLinkPacketForwarded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketForwarded)(x)
+}
+
+func (x *EventLinkPacketForwarded) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkPacketForwarded_messageType fastReflection_EventLinkPacketForwarded_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkPacketForwarded_messageType{}
+
+type fastReflection_EventLinkPacketForwarded_messageType struct{}
+
+func (x fastReflection_EventLinkPacketForwarded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketForwarded)(nil)
+}
+func (x fastReflection_EventLinkPacketForwarded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketForwarded)
+}
+func (x fastReflection_EventLinkPacketForwarded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketForwarded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkPacketForwarded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketForwarded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkPacketForwarded) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkPacketForwarded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkPacketForwarded) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketForwarded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkPacketForwarded) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkPacketForwarded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkPacketForwarded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkPacketForwarded_link_id, value) {
+			return
+		}
+	}
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_EventLinkPacketForwarded_link_index, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkPacketForwarded_packet, value) {
+			return
+		}
+	}
+	if x.Hop != nil {
+		value := protoreflect.ValueOfMessage(x.Hop.ProtoReflect())
+		if !f(fd_EventLinkPacketForwarded_hop, value) {
+			return
+		}
+	}
+	if x.PrevHop != nil {
+		value := protoreflect.ValueOfMessage(x.PrevHop.ProtoReflect())
+		if !f(fd_EventLinkPacketForwarded_prev_hop, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkPacketForwarded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop":
+		return x.Hop != nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop":
+		return x.PrevHop != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketForwarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketForwarded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop":
+		x.Hop = nil
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop":
+		x.PrevHop = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketForwarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkPacketForwarded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop":
+		value := x.Hop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop":
+		value := x.PrevHop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketForwarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketForwarded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketForwarded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop":
+		x.Hop = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop":
+		x.PrevHop = value.Message().Interface().(*PacketIdentifier)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketForwarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketForwarded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop":
+		if x.Hop == nil {
+			x.Hop = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Hop.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop":
+		if x.PrevHop == nil {
+			x.PrevHop = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.PrevHop.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkPacketForwarded is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.EventLinkPacketForwarded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketForwarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkPacketForwarded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketForwarded"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkPacketForwarded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkPacketForwarded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkPacketForwarded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketForwarded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkPacketForwarded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkPacketForwarded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkPacketForwarded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Hop != nil {
+			l = options.Size(x.Hop)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrevHop != nil {
+			l = options.Size(x.PrevHop)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketForwarded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PrevHop != nil {
+			encoded, err := options.Marshal(x.PrevHop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Hop != nil {
+			encoded, err := options.Marshal(x.Hop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketForwarded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketForwarded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketForwarded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Hop == nil {
+					x.Hop = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Hop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevHop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrevHop == nil {
+					x.PrevHop = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrevHop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLinkPacketBuffered            protoreflect.MessageDescriptor
+	fd_EventLinkPacketBuffered_link_id    protoreflect.FieldDescriptor
+	fd_EventLinkPacketBuffered_link_index protoreflect.FieldDescriptor
+	fd_EventLinkPacketBuffered_packet     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkPacketBuffered = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkPacketBuffered")
+	fd_EventLinkPacketBuffered_link_id = md_EventLinkPacketBuffered.Fields().ByName("link_id")
+	fd_EventLinkPacketBuffered_link_index = md_EventLinkPacketBuffered.Fields().ByName("link_index")
+	fd_EventLinkPacketBuffered_packet = md_EventLinkPacketBuffered.Fields().ByName("packet")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkPacketBuffered)(nil)
+
+type fastReflection_EventLinkPacketBuffered EventLinkPacketBuffered
+
+func (x *EventLinkPacketBuffered) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketBuffered)(x)
+}
+
+func (x *EventLinkPacketBuffered) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkPacketBuffered_messageType fastReflection_EventLinkPacketBuffered_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkPacketBuffered_messageType{}
+
+type fastReflection_EventLinkPacketBuffered_messageType struct{}
+
+func (x fastReflection_EventLinkPacketBuffered_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkPacketBuffered)(nil)
+}
+func (x fastReflection_EventLinkPacketBuffered_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketBuffered)
+}
+func (x fastReflection_EventLinkPacketBuffered_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketBuffered
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkPacketBuffered) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkPacketBuffered
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkPacketBuffered) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkPacketBuffered_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkPacketBuffered) New() protoreflect.Message {
+	return new(fastReflection_EventLinkPacketBuffered)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkPacketBuffered) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkPacketBuffered)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkPacketBuffered) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkPacketBuffered_link_id, value) {
+			return
+		}
+	}
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_EventLinkPacketBuffered_link_index, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_EventLinkPacketBuffered_packet, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkPacketBuffered) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet":
+		return x.Packet != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketBuffered"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketBuffered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketBuffered) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet":
+		x.Packet = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketBuffered"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketBuffered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkPacketBuffered) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketBuffered"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketBuffered does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketBuffered) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketBuffered"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketBuffered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketBuffered) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkPacketBuffered is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.EventLinkPacketBuffered is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketBuffered"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketBuffered does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkPacketBuffered) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkPacketBuffered"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkPacketBuffered does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkPacketBuffered) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkPacketBuffered", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkPacketBuffered) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkPacketBuffered) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkPacketBuffered) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkPacketBuffered) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkPacketBuffered)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketBuffered)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkPacketBuffered)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketBuffered: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkPacketBuffered: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkExecuted_2_list)(nil)
+
+type _EventLinkExecuted_2_list struct {
+	list *[]*PacketIdentifier
+}
+
+func (x *_EventLinkExecuted_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkExecuted_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkExecuted_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkExecuted_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkExecuted_2_list) AppendMutable() protoreflect.Value {
+	v := new(PacketIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkExecuted_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkExecuted_2_list) NewElement() protoreflect.Value {
+	v := new(PacketIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkExecuted_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkExecuted         protoreflect.MessageDescriptor
+	fd_EventLinkExecuted_link_id protoreflect.FieldDescriptor
+	fd_EventLinkExecuted_packets protoreflect.FieldDescriptor
+	fd_EventLinkExecuted_error   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkExecuted = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkExecuted")
+	fd_EventLinkExecuted_link_id = md_EventLinkExecuted.Fields().ByName("link_id")
+	fd_EventLinkExecuted_packets = md_EventLinkExecuted.Fields().ByName("packets")
+	fd_EventLinkExecuted_error = md_EventLinkExecuted.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkExecuted)(nil)
+
+type fastReflection_EventLinkExecuted EventLinkExecuted
+
+func (x *EventLinkExecuted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkExecuted)(x)
+}
+
+func (x *EventLinkExecuted) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkExecuted_messageType fastReflection_EventLinkExecuted_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkExecuted_messageType{}
+
+type fastReflection_EventLinkExecuted_messageType struct{}
+
+func (x fastReflection_EventLinkExecuted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkExecuted)(nil)
+}
+func (x fastReflection_EventLinkExecuted_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkExecuted)
+}
+func (x fastReflection_EventLinkExecuted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkExecuted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkExecuted) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkExecuted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkExecuted) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkExecuted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkExecuted) New() protoreflect.Message {
+	return new(fastReflection_EventLinkExecuted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkExecuted) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkExecuted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkExecuted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkExecuted_link_id, value) {
+			return
+		}
+	}
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkExecuted_2_list{list: &x.Packets})
+		if !f(fd_EventLinkExecuted_packets, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventLinkExecuted_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkExecuted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.packets":
+		return len(x.Packets) != 0
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExecuted"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExecuted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.packets":
+		x.Packets = nil
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExecuted"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkExecuted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkExecuted_2_list{})
+		}
+		listValue := &_EventLinkExecuted_2_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExecuted"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExecuted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExecuted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.packets":
+		lv := value.List()
+		clv := lv.(*_EventLinkExecuted_2_list)
+		x.Packets = *clv.list
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExecuted"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExecuted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.packets":
+		if x.Packets == nil {
+			x.Packets = []*PacketIdentifier{}
+		}
+		value := &_EventLinkExecuted_2_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkExecuted is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.error":
+		panic(fmt.Errorf("field error of message srdtrk.linkedpackets.v1.EventLinkExecuted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExecuted"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkExecuted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.packets":
+		list := []*PacketIdentifier{}
+		return protoreflect.ValueOfList(&_EventLinkExecuted_2_list{list: &list})
+	case "srdtrk.linkedpackets.v1.EventLinkExecuted.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkExecuted"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkExecuted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkExecuted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkExecuted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkExecuted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkExecuted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkExecuted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkExecuted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkExecuted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkExecuted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkExecuted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Packets = append(x.Packets, &PacketIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkForceClosed_3_list)(nil)
+
+type _EventLinkForceClosed_3_list struct {
+	list *[]*PacketIdentifier
+}
+
+func (x *_EventLinkForceClosed_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkForceClosed_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkForceClosed_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkForceClosed_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkForceClosed_3_list) AppendMutable() protoreflect.Value {
+	v := new(PacketIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkForceClosed_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkForceClosed_3_list) NewElement() protoreflect.Value {
+	v := new(PacketIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkForceClosed_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventLinkForceClosed_4_list)(nil)
+
+type _EventLinkForceClosed_4_list struct {
+	list *[]*PacketIdentifier
+}
+
+func (x *_EventLinkForceClosed_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkForceClosed_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkForceClosed_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkForceClosed_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkForceClosed_4_list) AppendMutable() protoreflect.Value {
+	v := new(PacketIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkForceClosed_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkForceClosed_4_list) NewElement() protoreflect.Value {
+	v := new(PacketIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkForceClosed_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkForceClosed                  protoreflect.MessageDescriptor
+	fd_EventLinkForceClosed_link_id          protoreflect.FieldDescriptor
+	fd_EventLinkForceClosed_failed           protoreflect.FieldDescriptor
+	fd_EventLinkForceClosed_failed_packets   protoreflect.FieldDescriptor
+	fd_EventLinkForceClosed_rejected_packets protoreflect.FieldDescriptor
+	fd_EventLinkForceClosed_port_id          protoreflect.FieldDescriptor
+	fd_EventLinkForceClosed_channel_id       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkForceClosed = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkForceClosed")
+	fd_EventLinkForceClosed_link_id = md_EventLinkForceClosed.Fields().ByName("link_id")
+	fd_EventLinkForceClosed_failed = md_EventLinkForceClosed.Fields().ByName("failed")
+	fd_EventLinkForceClosed_failed_packets = md_EventLinkForceClosed.Fields().ByName("failed_packets")
+	fd_EventLinkForceClosed_rejected_packets = md_EventLinkForceClosed.Fields().ByName("rejected_packets")
+	fd_EventLinkForceClosed_port_id = md_EventLinkForceClosed.Fields().ByName("port_id")
+	fd_EventLinkForceClosed_channel_id = md_EventLinkForceClosed.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkForceClosed)(nil)
+
+type fastReflection_EventLinkForceClosed EventLinkForceClosed
+
+func (x *EventLinkForceClosed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkForceClosed)(x)
+}
+
+func (x *EventLinkForceClosed) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkForceClosed_messageType fastReflection_EventLinkForceClosed_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkForceClosed_messageType{}
+
+type fastReflection_EventLinkForceClosed_messageType struct{}
+
+func (x fastReflection_EventLinkForceClosed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkForceClosed)(nil)
+}
+func (x fastReflection_EventLinkForceClosed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkForceClosed)
+}
+func (x fastReflection_EventLinkForceClosed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkForceClosed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkForceClosed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkForceClosed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkForceClosed) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkForceClosed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkForceClosed) New() protoreflect.Message {
+	return new(fastReflection_EventLinkForceClosed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkForceClosed) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkForceClosed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkForceClosed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_EventLinkForceClosed_link_id, value) {
+			return
+		}
+	}
+	if x.Failed != false {
+		value := protoreflect.ValueOfBool(x.Failed)
+		if !f(fd_EventLinkForceClosed_failed, value) {
+			return
+		}
+	}
+	if len(x.FailedPackets) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkForceClosed_3_list{list: &x.FailedPackets})
+		if !f(fd_EventLinkForceClosed_failed_packets, value) {
+			return
+		}
+	}
+	if len(x.RejectedPackets) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkForceClosed_4_list{list: &x.RejectedPackets})
+		if !f(fd_EventLinkForceClosed_rejected_packets, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_EventLinkForceClosed_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_EventLinkForceClosed_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkForceClosed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed":
+		return x.Failed != false
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets":
+		return len(x.FailedPackets) != 0
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets":
+		return len(x.RejectedPackets) != 0
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.port_id":
+		return x.PortId != ""
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkForceClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkForceClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkForceClosed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed":
+		x.Failed = false
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets":
+		x.FailedPackets = nil
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets":
+		x.RejectedPackets = nil
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.port_id":
+		x.PortId = ""
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkForceClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkForceClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkForceClosed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed":
+		value := x.Failed
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets":
+		if len(x.FailedPackets) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkForceClosed_3_list{})
+		}
+		listValue := &_EventLinkForceClosed_3_list{list: &x.FailedPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets":
+		if len(x.RejectedPackets) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkForceClosed_4_list{})
+		}
+		listValue := &_EventLinkForceClosed_4_list{list: &x.RejectedPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkForceClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkForceClosed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkForceClosed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed":
+		x.Failed = value.Bool()
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets":
+		lv := value.List()
+		clv := lv.(*_EventLinkForceClosed_3_list)
+		x.FailedPackets = *clv.list
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets":
+		lv := value.List()
+		clv := lv.(*_EventLinkForceClosed_4_list)
+		x.RejectedPackets = *clv.list
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.port_id":
+		x.PortId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkForceClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkForceClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkForceClosed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets":
+		if x.FailedPackets == nil {
+			x.FailedPackets = []*PacketIdentifier{}
+		}
+		value := &_EventLinkForceClosed_3_list{list: &x.FailedPackets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets":
+		if x.RejectedPackets == nil {
+			x.RejectedPackets = []*PacketIdentifier{}
+		}
+		value := &_EventLinkForceClosed_4_list{list: &x.RejectedPackets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.EventLinkForceClosed is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed":
+		panic(fmt.Errorf("field failed of message srdtrk.linkedpackets.v1.EventLinkForceClosed is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.port_id":
+		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.EventLinkForceClosed is not mutable"))
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.channel_id":
+		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.EventLinkForceClosed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkForceClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkForceClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkForceClosed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets":
+		list := []*PacketIdentifier{}
+		return protoreflect.ValueOfList(&_EventLinkForceClosed_3_list{list: &list})
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets":
+		list := []*PacketIdentifier{}
+		return protoreflect.ValueOfList(&_EventLinkForceClosed_4_list{list: &list})
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.port_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.EventLinkForceClosed.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkForceClosed"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkForceClosed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkForceClosed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkForceClosed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkForceClosed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkForceClosed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkForceClosed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkForceClosed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkForceClosed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Failed {
+			n += 2
+		}
+		if len(x.FailedPackets) > 0 {
+			for _, e := range x.FailedPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RejectedPackets) > 0 {
+			for _, e := range x.RejectedPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkForceClosed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RejectedPackets) > 0 {
+			for iNdEx := len(x.RejectedPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RejectedPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.FailedPackets) > 0 {
+			for iNdEx := len(x.FailedPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FailedPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Failed {
+			i--
+			if x.Failed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkForceClosed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkForceClosed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkForceClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Failed = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedPackets = append(x.FailedPackets, &PacketIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedPackets[len(x.FailedPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RejectedPackets = append(x.RejectedPackets, &PacketIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectedPackets[len(x.RejectedPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventLinkingPaused_2_list)(nil)
+
+type _EventLinkingPaused_2_list struct {
+	list *[]*ChannelIdentifier
+}
+
+func (x *_EventLinkingPaused_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventLinkingPaused_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventLinkingPaused_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventLinkingPaused_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventLinkingPaused_2_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkingPaused_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventLinkingPaused_2_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventLinkingPaused_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventLinkingPaused          protoreflect.MessageDescriptor
+	fd_EventLinkingPaused_paused   protoreflect.FieldDescriptor
+	fd_EventLinkingPaused_channels protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_events_proto_init()
+	md_EventLinkingPaused = File_srdtrk_linkedpackets_v1_events_proto.Messages().ByName("EventLinkingPaused")
+	fd_EventLinkingPaused_paused = md_EventLinkingPaused.Fields().ByName("paused")
+	fd_EventLinkingPaused_channels = md_EventLinkingPaused.Fields().ByName("channels")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLinkingPaused)(nil)
+
+type fastReflection_EventLinkingPaused EventLinkingPaused
+
+func (x *EventLinkingPaused) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLinkingPaused)(x)
+}
+
+func (x *EventLinkingPaused) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLinkingPaused_messageType fastReflection_EventLinkingPaused_messageType
+var _ protoreflect.MessageType = fastReflection_EventLinkingPaused_messageType{}
+
+type fastReflection_EventLinkingPaused_messageType struct{}
+
+func (x fastReflection_EventLinkingPaused_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLinkingPaused)(nil)
+}
+func (x fastReflection_EventLinkingPaused_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLinkingPaused)
+}
+func (x fastReflection_EventLinkingPaused_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkingPaused
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLinkingPaused) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLinkingPaused
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLinkingPaused) Type() protoreflect.MessageType {
+	return _fastReflection_EventLinkingPaused_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLinkingPaused) New() protoreflect.Message {
+	return new(fastReflection_EventLinkingPaused)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLinkingPaused) Interface() protoreflect.ProtoMessage {
+	return (*EventLinkingPaused)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLinkingPaused) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_EventLinkingPaused_paused, value) {
+			return
+		}
+	}
+	if len(x.Channels) != 0 {
+		value := protoreflect.ValueOfList(&_EventLinkingPaused_2_list{list: &x.Channels})
+		if !f(fd_EventLinkingPaused_channels, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLinkingPaused) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.paused":
+		return x.Paused != false
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.channels":
+		return len(x.Channels) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkingPaused) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.paused":
+		x.Paused = false
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.channels":
+		x.Channels = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLinkingPaused) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.channels":
+		if len(x.Channels) == 0 {
+			return protoreflect.ValueOfList(&_EventLinkingPaused_2_list{})
+		}
+		listValue := &_EventLinkingPaused_2_list{list: &x.Channels}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkingPaused does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkingPaused) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.paused":
+		x.Paused = value.Bool()
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.channels":
+		lv := value.List()
+		clv := lv.(*_EventLinkingPaused_2_list)
+		x.Channels = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkingPaused) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.channels":
+		if x.Channels == nil {
+			x.Channels = []*ChannelIdentifier{}
+		}
+		value := &_EventLinkingPaused_2_list{list: &x.Channels}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.paused":
+		panic(fmt.Errorf("field paused of message srdtrk.linkedpackets.v1.EventLinkingPaused is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLinkingPaused) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.paused":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.EventLinkingPaused.channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_EventLinkingPaused_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.EventLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.EventLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLinkingPaused) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.EventLinkingPaused", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLinkingPaused) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLinkingPaused) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLinkingPaused) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLinkingPaused) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLinkingPaused)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Paused {
+			n += 2
+		}
+		if len(x.Channels) > 0 {
+			for _, e := range x.Channels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkingPaused)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Channels) > 0 {
+			for iNdEx := len(x.Channels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Channels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLinkingPaused)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkingPaused: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLinkingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channels = append(x.Channels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Channels[len(x.Channels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: srdtrk/linkedpackets/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventLinkChannelEnabled is emitted when packet linking is enabled on a channel during the channel handshake.
+type EventLinkChannelEnabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// port_id is the port identifier of the channel.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *EventLinkChannelEnabled) Reset() {
+	*x = EventLinkChannelEnabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkChannelEnabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkChannelEnabled) ProtoMessage() {}
+
+// Deprecated: Use EventLinkChannelEnabled.ProtoReflect.Descriptor instead.
+func (*EventLinkChannelEnabled) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventLinkChannelEnabled) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *EventLinkChannelEnabled) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// EventLinkOpened is emitted when an owner starts sending a link.
+type EventLinkOpened struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address sending the link.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *EventLinkOpened) Reset() {
+	*x = EventLinkOpened{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkOpened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkOpened) ProtoMessage() {}
+
+// Deprecated: Use EventLinkOpened.ProtoReflect.Descriptor instead.
+func (*EventLinkOpened) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventLinkOpened) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkOpened) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// EventLinkPacketSent is emitted when a packet is sent as part of a link.
+type EventLinkPacketSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// link_index is the index of the packet in the link.
+	LinkIndex uint64 `protobuf:"varint,2,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the sent packet.
+	Packet *PacketIdentifier `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`
+	// prev_packet is the identifier of the previous packet of the link. It is empty for the first packet.
+	PrevPacket *PacketIdentifier `protobuf:"bytes,4,opt,name=prev_packet,json=prevPacket,proto3" json:"prev_packet,omitempty"`
+	// last_packet is true if the packet closes the link.
+	LastPacket bool `protobuf:"varint,5,opt,name=last_packet,json=lastPacket,proto3" json:"last_packet,omitempty"`
+}
+
+func (x *EventLinkPacketSent) Reset() {
+	*x = EventLinkPacketSent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkPacketSent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkPacketSent) ProtoMessage() {}
+
+// Deprecated: Use EventLinkPacketSent.ProtoReflect.Descriptor instead.
+func (*EventLinkPacketSent) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventLinkPacketSent) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkPacketSent) GetLinkIndex() uint64 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *EventLinkPacketSent) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *EventLinkPacketSent) GetPrevPacket() *PacketIdentifier {
+	if x != nil {
+		return x.PrevPacket
+	}
+	return nil
+}
+
+func (x *EventLinkPacketSent) GetLastPacket() bool {
+	if x != nil {
+		return x.LastPacket
+	}
+	return false
+}
+
+// EventLinkClosed is emitted when a link stops accepting packets.
+type EventLinkClosed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address which sent the link.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// packet_count is the number of packets of the link. A link without packets is removed when it is closed.
+	PacketCount uint64 `protobuf:"varint,3,opt,name=packet_count,json=packetCount,proto3" json:"packet_count,omitempty"`
+}
+
+func (x *EventLinkClosed) Reset() {
+	*x = EventLinkClosed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkClosed) ProtoMessage() {}
+
+// Deprecated: Use EventLinkClosed.ProtoReflect.Descriptor instead.
+func (*EventLinkClosed) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventLinkClosed) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkClosed) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventLinkClosed) GetPacketCount() uint64 {
+	if x != nil {
+		return x.PacketCount
+	}
+	return 0
+}
+
+// EventLinkPacketOutcome is emitted when the outcome of a packet sent as part of a link is recorded.
+type EventLinkPacketOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// packet is the identifier of the packet.
+	Packet *PacketIdentifier `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`
+	// outcome is the outcome of the packet.
+	Outcome PacketOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=srdtrk.linkedpackets.v1.PacketOutcome" json:"outcome,omitempty"`
+}
+
+func (x *EventLinkPacketOutcome) Reset() {
+	*x = EventLinkPacketOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkPacketOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkPacketOutcome) ProtoMessage() {}
+
+// Deprecated: Use EventLinkPacketOutcome.ProtoReflect.Descriptor instead.
+func (*EventLinkPacketOutcome) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventLinkPacketOutcome) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkPacketOutcome) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *EventLinkPacketOutcome) GetOutcome() PacketOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PacketOutcome_PACKET_OUTCOME_UNSPECIFIED
+}
+
+// EventLinkResolved is emitted when every packet of a closed link has an outcome.
+type EventLinkResolved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// status is the final status of the link.
+	Status LinkStatus `protobuf:"varint,2,opt,name=status,proto3,enum=srdtrk.linkedpackets.v1.LinkStatus" json:"status,omitempty"`
+	// packets are the packets of the link and their outcomes, ordered by link index.
+	Packets []*LinkPacket `protobuf:"bytes,3,rep,name=packets,proto3" json:"packets,omitempty"`
+}
+
+func (x *EventLinkResolved) Reset() {
+	*x = EventLinkResolved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkResolved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkResolved) ProtoMessage() {}
+
+// Deprecated: Use EventLinkResolved.ProtoReflect.Descriptor instead.
+func (*EventLinkResolved) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventLinkResolved) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkResolved) GetStatus() LinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LinkStatus_LINK_STATUS_UNSPECIFIED
+}
+
+func (x *EventLinkResolved) GetPackets() []*LinkPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+// EventLinkCompensationSent is emitted when a compensation packet is sent for a member of a failed link.
+type EventLinkCompensationSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// link_index is the index of the compensated member in the link.
+	LinkIndex uint64 `protobuf:"varint,2,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the compensation packet.
+	Packet *PacketIdentifier `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (x *EventLinkCompensationSent) Reset() {
+	*x = EventLinkCompensationSent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkCompensationSent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkCompensationSent) ProtoMessage() {}
+
+// Deprecated: Use EventLinkCompensationSent.ProtoReflect.Descriptor instead.
+func (*EventLinkCompensationSent) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventLinkCompensationSent) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkCompensationSent) GetLinkIndex() uint64 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *EventLinkCompensationSent) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+// EventLinkPacketReceived is emitted when a linked packet is received and accepted by the link rules.
+type EventLinkPacketReceived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// link_index is the index of the packet in the link.
+	LinkIndex uint64 `protobuf:"varint,2,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the received packet on the receiving chain.
+	Packet *PacketIdentifier `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`
+	// last_packet is true if the packet is the last packet of the link.
+	LastPacket bool `protobuf:"varint,4,opt,name=last_packet,json=lastPacket,proto3" json:"last_packet,omitempty"`
+}
+
+func (x *EventLinkPacketReceived) Reset() {
+	*x = EventLinkPacketReceived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkPacketReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkPacketReceived) ProtoMessage() {}
+
+// Deprecated: Use EventLinkPacketReceived.ProtoReflect.Descriptor instead.
+func (*EventLinkPacketReceived) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventLinkPacketReceived) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkPacketReceived) GetLinkIndex() uint64 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *EventLinkPacketReceived) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *EventLinkPacketReceived) GetLastPacket() bool {
+	if x != nil {
+		return x.LastPacket
+	}
+	return false
+}
+
+// EventLinkAcknowledgement is emitted when a link acknowledgement reports that a sent packet was rejected by
+// the link rules of the receiving chain.
+type EventLinkAcknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// packet is the identifier of the sent packet.
+	Packet *PacketIdentifier `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	// code is the result of the link rules.
+	Code LinkAckCode `protobuf:"varint,2,opt,name=code,proto3,enum=srdtrk.linkedpackets.v1.LinkAckCode" json:"code,omitempty"`
+	// reason describes why the packet was rejected.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventLinkAcknowledgement) Reset() {
+	*x = EventLinkAcknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkAcknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkAcknowledgement) ProtoMessage() {}
+
+// Deprecated: Use EventLinkAcknowledgement.ProtoReflect.Descriptor instead.
+func (*EventLinkAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventLinkAcknowledgement) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *EventLinkAcknowledgement) GetCode() LinkAckCode {
+	if x != nil {
+		return x.Code
+	}
+	return LinkAckCode_LINK_ACK_CODE_UNSPECIFIED
+}
+
+func (x *EventLinkAcknowledgement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventLinkDepositRefunded is emitted when the deposit of a link is refunded to its owner after the link closed.
+type EventLinkDepositRefunded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address the deposit is refunded to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the refunded deposit.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventLinkDepositRefunded) Reset() {
+	*x = EventLinkDepositRefunded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkDepositRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkDepositRefunded) ProtoMessage() {}
+
+// Deprecated: Use EventLinkDepositRefunded.ProtoReflect.Descriptor instead.
+func (*EventLinkDepositRefunded) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventLinkDepositRefunded) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkDepositRefunded) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventLinkDepositRefunded) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventLinkExpired is emitted when an open link expires and its deposit is forfeited.
+type EventLinkExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address which opened the link.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// forfeited_deposit is the deposit of the link which was burned or sent to the community pool.
+	ForfeitedDeposit []*v1beta1.Coin `protobuf:"bytes,3,rep,name=forfeited_deposit,json=forfeitedDeposit,proto3" json:"forfeited_deposit,omitempty"`
+	// action is what happened to the forfeited deposit.
+	Action ExpiredDepositAction `protobuf:"varint,4,opt,name=action,proto3,enum=srdtrk.linkedpackets.v1.ExpiredDepositAction" json:"action,omitempty"`
+}
+
+func (x *EventLinkExpired) Reset() {
+	*x = EventLinkExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkExpired) ProtoMessage() {}
+
+// Deprecated: Use EventLinkExpired.ProtoReflect.Descriptor instead.
+func (*EventLinkExpired) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventLinkExpired) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkExpired) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventLinkExpired) GetForfeitedDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.ForfeitedDeposit
+	}
+	return nil
+}
+
+func (x *EventLinkExpired) GetAction() ExpiredDepositAction {
+	if x != nil {
+		return x.Action
+	}
+	return ExpiredDepositAction_EXPIRED_DEPOSIT_ACTION_BURN
+}
+
+// EventLinkRelayerRewarded is emitted when a share of the relayer reward of a link is paid to a relayer.
+type EventLinkRelayerRewarded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// relayer is the address the reward is paid to.
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// amount is the paid reward.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventLinkRelayerRewarded) Reset() {
+	*x = EventLinkRelayerRewarded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkRelayerRewarded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkRelayerRewarded) ProtoMessage() {}
+
+// Deprecated: Use EventLinkRelayerRewarded.ProtoReflect.Descriptor instead.
+func (*EventLinkRelayerRewarded) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventLinkRelayerRewarded) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkRelayerRewarded) GetRelayer() string {
+	if x != nil {
+		return x.Relayer
+	}
+	return ""
+}
+
+func (x *EventLinkRelayerRewarded) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventLinkRelayerRewardRefunded is emitted when the relayer reward of a link is refunded to its owner because
+// some packets of the link were not acknowledged.
+type EventLinkRelayerRewardRefunded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address the reward is refunded to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the refunded reward.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventLinkRelayerRewardRefunded) Reset() {
+	*x = EventLinkRelayerRewardRefunded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkRelayerRewardRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkRelayerRewardRefunded) ProtoMessage() {}
+
+// Deprecated: Use EventLinkRelayerRewardRefunded.ProtoReflect.Descriptor instead.
+func (*EventLinkRelayerRewardRefunded) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventLinkRelayerRewardRefunded) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkRelayerRewardRefunded) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventLinkRelayerRewardRefunded) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventLinkCallback is emitted when a contract is called back for a link.
+type EventLinkCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// callback_type is the type of the callback, either "link_resolved" on the sending chain or "link_received"
+	// on the receiving chain.
+	CallbackType string `protobuf:"bytes,2,opt,name=callback_type,json=callbackType,proto3" json:"callback_type,omitempty"`
+	// contract_address is the address of the called contract.
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// error is the error returned by the contract, empty if the callback succeeded.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventLinkCallback) Reset() {
+	*x = EventLinkCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkCallback) ProtoMessage() {}
+
+// Deprecated: Use EventLinkCallback.ProtoReflect.Descriptor instead.
+func (*EventLinkCallback) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EventLinkCallback) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkCallback) GetCallbackType() string {
+	if x != nil {
+		return x.CallbackType
+	}
+	return ""
+}
+
+func (x *EventLinkCallback) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *EventLinkCallback) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventLinkPacketForwarded is emitted when a received linked packet is forwarded to the next chain, with its
+// link data relinked to the previous hops of the link.
+type EventLinkPacketForwarded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// link_index is the index of the packet in the link.
+	LinkIndex uint64 `protobuf:"varint,2,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the received packet on this chain.
+	Packet *PacketIdentifier `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`
+	// hop is the identifier of the packet sent to forward it.
+	Hop *PacketIdentifier `protobuf:"bytes,4,opt,name=hop,proto3" json:"hop,omitempty"`
+	// prev_hop is the identifier of the previous hop of the link, empty if it is the first forwarded packet.
+	PrevHop *PacketIdentifier `protobuf:"bytes,5,opt,name=prev_hop,json=prevHop,proto3" json:"prev_hop,omitempty"`
+}
+
+func (x *EventLinkPacketForwarded) Reset() {
+	*x = EventLinkPacketForwarded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkPacketForwarded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkPacketForwarded) ProtoMessage() {}
+
+// Deprecated: Use EventLinkPacketForwarded.ProtoReflect.Descriptor instead.
+func (*EventLinkPacketForwarded) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventLinkPacketForwarded) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkPacketForwarded) GetLinkIndex() uint64 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *EventLinkPacketForwarded) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *EventLinkPacketForwarded) GetHop() *PacketIdentifier {
+	if x != nil {
+		return x.Hop
+	}
+	return nil
+}
+
+func (x *EventLinkPacketForwarded) GetPrevHop() *PacketIdentifier {
+	if x != nil {
+		return x.PrevHop
+	}
+	return nil
+}
+
+// EventLinkPacketBuffered is emitted when a received linked packet is buffered until the last packet of its link
+// is received, so that the packets of the link are executed atomically.
+type EventLinkPacketBuffered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// link_index is the index of the packet in the link.
+	LinkIndex uint64 `protobuf:"varint,2,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the buffered packet on this chain.
+	Packet *PacketIdentifier `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (x *EventLinkPacketBuffered) Reset() {
+	*x = EventLinkPacketBuffered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkPacketBuffered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkPacketBuffered) ProtoMessage() {}
+
+// Deprecated: Use EventLinkPacketBuffered.ProtoReflect.Descriptor instead.
+func (*EventLinkPacketBuffered) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventLinkPacketBuffered) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkPacketBuffered) GetLinkIndex() uint64 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *EventLinkPacketBuffered) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+// EventLinkExecuted is emitted when the packets of a link received on a channel are executed atomically.
+type EventLinkExecuted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// packets are the identifiers of the executed packets on this chain, in link order.
+	Packets []*PacketIdentifier `protobuf:"bytes,2,rep,name=packets,proto3" json:"packets,omitempty"`
+	// error is the reason the execution failed, empty if every packet succeeded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventLinkExecuted) Reset() {
+	*x = EventLinkExecuted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkExecuted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkExecuted) ProtoMessage() {}
+
+// Deprecated: Use EventLinkExecuted.ProtoReflect.Descriptor instead.
+func (*EventLinkExecuted) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EventLinkExecuted) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkExecuted) GetPackets() []*PacketIdentifier {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+func (x *EventLinkExecuted) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventLinkForceClosed is emitted when the authority force closes or force fails a link.
+type EventLinkForceClosed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// failed is true if the pending packets of the link were failed.
+	Failed bool `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// failed_packets are the identifiers of the sent packets of the link which were failed.
+	FailedPackets []*PacketIdentifier `protobuf:"bytes,3,rep,name=failed_packets,json=failedPackets,proto3" json:"failed_packets,omitempty"`
+	// rejected_packets are the identifiers of the buffered packets of the link received on this chain which were
+	// given an error acknowledgement.
+	RejectedPackets []*PacketIdentifier `protobuf:"bytes,4,rep,name=rejected_packets,json=rejectedPackets,proto3" json:"rejected_packets,omitempty"`
+	// port_id and channel_id identify the channel the link is received on by this chain, empty if the link was
+	// sent by this chain.
+	PortId    string `protobuf:"bytes,5,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *EventLinkForceClosed) Reset() {
+	*x = EventLinkForceClosed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkForceClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkForceClosed) ProtoMessage() {}
+
+// Deprecated: Use EventLinkForceClosed.ProtoReflect.Descriptor instead.
+func (*EventLinkForceClosed) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EventLinkForceClosed) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *EventLinkForceClosed) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *EventLinkForceClosed) GetFailedPackets() []*PacketIdentifier {
+	if x != nil {
+		return x.FailedPackets
+	}
+	return nil
+}
+
+func (x *EventLinkForceClosed) GetRejectedPackets() []*PacketIdentifier {
+	if x != nil {
+		return x.RejectedPackets
+	}
+	return nil
+}
+
+func (x *EventLinkForceClosed) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *EventLinkForceClosed) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// EventLinkingPaused is emitted when the authority pauses or resumes linking.
+type EventLinkingPaused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// paused is true if linking was paused and false if it was resumed.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// channels are the channels on which linking was paused or resumed, empty if it was chain-wide.
+	Channels []*ChannelIdentifier `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *EventLinkingPaused) Reset() {
+	*x = EventLinkingPaused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_events_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLinkingPaused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLinkingPaused) ProtoMessage() {}
+
+// Deprecated: Use EventLinkingPaused.ProtoReflect.Descriptor instead.
+func (*EventLinkingPaused) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EventLinkingPaused) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *EventLinkingPaused) GetChannels() []*ChannelIdentifier {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+var File_srdtrk_linkedpackets_v1_events_proto protoreflect.FileDescriptor
+
+var file_srdtrk_linkedpackets_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x0f, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x89, 0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69,
+	0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x50, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x22, 0x7d, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c,
+	0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0xb5, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x06,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
+	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x74, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x89, 0x01, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10, 0x66, 0x6f, 0x72,
+	0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x45, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e,
+	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x1e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a,
+	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xaa, 0x02, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6e,
+	0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x41, 0x0a, 0x03, 0x68, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
+	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x68,
+	0x6f, 0x70, 0x12, 0x4a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x48, 0x6f, 0x70, 0x22, 0x9a,
+	0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e,
+	0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x11,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72,
+	0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb3, 0x02, 0x0a, 0x14,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x5a, 0x0a,
+	0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x22, 0x7a, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x4c, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x42, 0xf5, 0x01,
+	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4c, 0x58, 0xaa,
+	0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74,
+	0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x53, 0x72, 0x64, 0x74,
+	0x72, 0x6b, 0x3a, 0x3a, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_srdtrk_linkedpackets_v1_events_proto_rawDescOnce sync.Once
+	file_srdtrk_linkedpackets_v1_events_proto_rawDescData = file_srdtrk_linkedpackets_v1_events_proto_rawDesc
+)
+
+func file_srdtrk_linkedpackets_v1_events_proto_rawDescGZIP() []byte {
+	file_srdtrk_linkedpackets_v1_events_proto_rawDescOnce.Do(func() {
+		file_srdtrk_linkedpackets_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_srdtrk_linkedpackets_v1_events_proto_rawDescData)
+	})
+	return file_srdtrk_linkedpackets_v1_events_proto_rawDescData
+}
+
+var file_srdtrk_linkedpackets_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_srdtrk_linkedpackets_v1_events_proto_goTypes = []interface{}{
+	(*EventLinkChannelEnabled)(nil),        // 0: srdtrk.linkedpackets.v1.EventLinkChannelEnabled
+	(*EventLinkOpened)(nil),                // 1: srdtrk.linkedpackets.v1.EventLinkOpened
+	(*EventLinkPacketSent)(nil),            // 2: srdtrk.linkedpackets.v1.EventLinkPacketSent
+	(*EventLinkClosed)(nil),                // 3: srdtrk.linkedpackets.v1.EventLinkClosed
+	(*EventLinkPacketOutcome)(nil),         // 4: srdtrk.linkedpackets.v1.EventLinkPacketOutcome
+	(*EventLinkResolved)(nil),              // 5: srdtrk.linkedpackets.v1.EventLinkResolved
+	(*EventLinkCompensationSent)(nil),      // 6: srdtrk.linkedpackets.v1.EventLinkCompensationSent
+	(*EventLinkPacketReceived)(nil),        // 7: srdtrk.linkedpackets.v1.EventLinkPacketReceived
+	(*EventLinkAcknowledgement)(nil),       // 8: srdtrk.linkedpackets.v1.EventLinkAcknowledgement
+	(*EventLinkDepositRefunded)(nil),       // 9: srdtrk.linkedpackets.v1.EventLinkDepositRefunded
+	(*EventLinkExpired)(nil),               // 10: srdtrk.linkedpackets.v1.EventLinkExpired
+	(*EventLinkRelayerRewarded)(nil),       // 11: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded
+	(*EventLinkRelayerRewardRefunded)(nil), // 12: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded
+	(*EventLinkCallback)(nil),              // 13: srdtrk.linkedpackets.v1.EventLinkCallback
+	(*EventLinkPacketForwarded)(nil),       // 14: srdtrk.linkedpackets.v1.EventLinkPacketForwarded
+	(*EventLinkPacketBuffered)(nil),        // 15: srdtrk.linkedpackets.v1.EventLinkPacketBuffered
+	(*EventLinkExecuted)(nil),              // 16: srdtrk.linkedpackets.v1.EventLinkExecuted
+	(*EventLinkForceClosed)(nil),           // 17: srdtrk.linkedpackets.v1.EventLinkForceClosed
+	(*EventLinkingPaused)(nil),             // 18: srdtrk.linkedpackets.v1.EventLinkingPaused
+	(*PacketIdentifier)(nil),               // 19: srdtrk.linkedpackets.v1.PacketIdentifier
+	(PacketOutcome)(0),                     // 20: srdtrk.linkedpackets.v1.PacketOutcome
+	(LinkStatus)(0),                        // 21: srdtrk.linkedpackets.v1.LinkStatus
+	(*LinkPacket)(nil),                     // 22: srdtrk.linkedpackets.v1.LinkPacket
+	(LinkAckCode)(0),                       // 23: srdtrk.linkedpackets.v1.LinkAckCode
+	(*v1beta1.Coin)(nil),                   // 24: cosmos.base.v1beta1.Coin
+	(ExpiredDepositAction)(0),              // 25: srdtrk.linkedpackets.v1.ExpiredDepositAction
+	(*ChannelIdentifier)(nil),              // 26: srdtrk.linkedpackets.v1.ChannelIdentifier
+}
+var file_srdtrk_linkedpackets_v1_events_proto_depIdxs = []int32{
+	19, // 0: srdtrk.linkedpackets.v1.EventLinkPacketSent.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 1: srdtrk.linkedpackets.v1.EventLinkPacketSent.prev_packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 2: srdtrk.linkedpackets.v1.EventLinkPacketOutcome.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	20, // 3: srdtrk.linkedpackets.v1.EventLinkPacketOutcome.outcome:type_name -> srdtrk.linkedpackets.v1.PacketOutcome
+	21, // 4: srdtrk.linkedpackets.v1.EventLinkResolved.status:type_name -> srdtrk.linkedpackets.v1.LinkStatus
+	22, // 5: srdtrk.linkedpackets.v1.EventLinkResolved.packets:type_name -> srdtrk.linkedpackets.v1.LinkPacket
+	19, // 6: srdtrk.linkedpackets.v1.EventLinkCompensationSent.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 7: srdtrk.linkedpackets.v1.EventLinkPacketReceived.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 8: srdtrk.linkedpackets.v1.EventLinkAcknowledgement.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	23, // 9: srdtrk.linkedpackets.v1.EventLinkAcknowledgement.code:type_name -> srdtrk.linkedpackets.v1.LinkAckCode
+	24, // 10: srdtrk.linkedpackets.v1.EventLinkDepositRefunded.amount:type_name -> cosmos.base.v1beta1.Coin
+	24, // 11: srdtrk.linkedpackets.v1.EventLinkExpired.forfeited_deposit:type_name -> cosmos.base.v1beta1.Coin
+	25, // 12: srdtrk.linkedpackets.v1.EventLinkExpired.action:type_name -> srdtrk.linkedpackets.v1.ExpiredDepositAction
+	24, // 13: srdtrk.linkedpackets.v1.EventLinkRelayerRewarded.amount:type_name -> cosmos.base.v1beta1.Coin
+	24, // 14: srdtrk.linkedpackets.v1.EventLinkRelayerRewardRefunded.amount:type_name -> cosmos.base.v1beta1.Coin
+	19, // 15: srdtrk.linkedpackets.v1.EventLinkPacketForwarded.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 16: srdtrk.linkedpackets.v1.EventLinkPacketForwarded.hop:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 17: srdtrk.linkedpackets.v1.EventLinkPacketForwarded.prev_hop:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 18: srdtrk.linkedpackets.v1.EventLinkPacketBuffered.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 19: srdtrk.linkedpackets.v1.EventLinkExecuted.packets:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 20: srdtrk.linkedpackets.v1.EventLinkForceClosed.failed_packets:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 21: srdtrk.linkedpackets.v1.EventLinkForceClosed.rejected_packets:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	26, // 22: srdtrk.linkedpackets.v1.EventLinkingPaused.channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_srdtrk_linkedpackets_v1_events_proto_init() }
+func file_srdtrk_linkedpackets_v1_events_proto_init() {
+	if File_srdtrk_linkedpackets_v1_events_proto != nil {
+		return
+	}
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkChannelEnabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkOpened); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkPacketSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkClosed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkPacketOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkResolved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkCompensationSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkPacketReceived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkAcknowledgement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkDepositRefunded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkRelayerRewarded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkRelayerRewardRefunded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkPacketForwarded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkPacketBuffered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkExecuted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkForceClosed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_events_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLinkingPaused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_srdtrk_linkedpackets_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_srdtrk_linkedpackets_v1_events_proto_goTypes,
+		DependencyIndexes: file_srdtrk_linkedpackets_v1_events_proto_depIdxs,
+		MessageInfos:      file_srdtrk_linkedpackets_v1_events_proto_msgTypes,
+	}.Build()
+	File_srdtrk_linkedpackets_v1_events_proto = out.File
+	file_srdtrk_linkedpackets_v1_events_proto_rawDesc = nil
+	file_srdtrk_linkedpackets_v1_events_proto_goTypes = nil
+	file_srdtrk_linkedpackets_v1_events_proto_depIdxs = nil
+}
@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_LinkEnabledChannel_FullMethodName = "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannel"
-	Query_Params_FullMethodName             = "/srdtrk.linkedpackets.v1.Query/Params"
+	Query_LinkEnabledChannel_FullMethodName  = "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannel"
+	Query_LinkEnabledChannels_FullMethodName = "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannels"
+	Query_Link_FullMethodName                = "/srdtrk.linkedpackets.v1.Query/Link"
+	Query_Links_FullMethodName               = "/srdtrk.linkedpackets.v1.Query/Links"
+	Query_OpenSession_FullMethodName         = "/srdtrk.linkedpackets.v1.Query/OpenSession"
+	Query_LinkingPaused_FullMethodName       = "/srdtrk.linkedpackets.v1.Query/LinkingPaused"
+	Query_AccountLinkQuota_FullMethodName    = "/srdtrk.linkedpackets.v1.Query/AccountLinkQuota"
+	Query_ChannelLinkQuota_FullMethodName    = "/srdtrk.linkedpackets.v1.Query/ChannelLinkQuota"
+	Query_Params_FullMethodName              = "/srdtrk.linkedpackets.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -29,6 +36,21 @@ const (
 type QueryClient interface {
 	// LinkEnabledChannel returns whether the channel allows linked packets or not.
 	LinkEnabledChannel(ctx context.Context, in *QueryLinkEnabledChannelRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelResponse, error)
+	// LinkEnabledChannels returns all the channels which allow linked packets.
+	LinkEnabledChannels(ctx context.Context, in *QueryLinkEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelsResponse, error)
+	// Link returns the link with the given identifier, with its packets and status.
+	Link(ctx context.Context, in *QueryLinkRequest, opts ...grpc.CallOption) (*QueryLinkResponse, error)
+	// Links returns the links opened on this chain, optionally filtered by owner, status or channel.
+	Links(ctx context.Context, in *QueryLinksRequest, opts ...grpc.CallOption) (*QueryLinksResponse, error)
+	// OpenSession returns the link currently opened by the given sender, if any.
+	OpenSession(ctx context.Context, in *QueryOpenSessionRequest, opts ...grpc.CallOption) (*QueryOpenSessionResponse, error)
+	// LinkingPaused returns whether linking is paused chain-wide or, if a channel is given, on the channel.
+	LinkingPaused(ctx context.Context, in *QueryLinkingPausedRequest, opts ...grpc.CallOption) (*QueryLinkingPausedResponse, error)
+	// AccountLinkQuota returns the number of links the given account can still open in the current rate limit window.
+	AccountLinkQuota(ctx context.Context, in *QueryAccountLinkQuotaRequest, opts ...grpc.CallOption) (*QueryAccountLinkQuotaResponse, error)
+	// ChannelLinkQuota returns the number of linked packets which can still be sent on the given channel in the
+	// current rate limit window.
+	ChannelLinkQuota(ctx context.Context, in *QueryChannelLinkQuotaRequest, opts ...grpc.CallOption) (*QueryChannelLinkQuotaResponse, error)
 	// Params returns the module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -50,6 +72,69 @@ func (c *queryClient) LinkEnabledChannel(ctx context.Context, in *QueryLinkEnabl
 	return out, nil
 }
 
+func (c *queryClient) LinkEnabledChannels(ctx context.Context, in *QueryLinkEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelsResponse, error) {
+	out := new(QueryLinkEnabledChannelsResponse)
+	err := c.cc.Invoke(ctx, Query_LinkEnabledChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Link(ctx context.Context, in *QueryLinkRequest, opts ...grpc.CallOption) (*QueryLinkResponse, error) {
+	out := new(QueryLinkResponse)
+	err := c.cc.Invoke(ctx, Query_Link_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Links(ctx context.Context, in *QueryLinksRequest, opts ...grpc.CallOption) (*QueryLinksResponse, error) {
+	out := new(QueryLinksResponse)
+	err := c.cc.Invoke(ctx, Query_Links_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpenSession(ctx context.Context, in *QueryOpenSessionRequest, opts ...grpc.CallOption) (*QueryOpenSessionResponse, error) {
+	out := new(QueryOpenSessionResponse)
+	err := c.cc.Invoke(ctx, Query_OpenSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LinkingPaused(ctx context.Context, in *QueryLinkingPausedRequest, opts ...grpc.CallOption) (*QueryLinkingPausedResponse, error) {
+	out := new(QueryLinkingPausedResponse)
+	err := c.cc.Invoke(ctx, Query_LinkingPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLinkQuota(ctx context.Context, in *QueryAccountLinkQuotaRequest, opts ...grpc.CallOption) (*QueryAccountLinkQuotaResponse, error) {
+	out := new(QueryAccountLinkQuotaResponse)
+	err := c.cc.Invoke(ctx, Query_AccountLinkQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelLinkQuota(ctx context.Context, in *QueryChannelLinkQuotaRequest, opts ...grpc.CallOption) (*QueryChannelLinkQuotaResponse, error) {
+	out := new(QueryChannelLinkQuotaResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelLinkQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -65,6 +150,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// LinkEnabledChannel returns whether the channel allows linked packets or not.
 	LinkEnabledChannel(context.Context, *QueryLinkEnabledChannelRequest) (*QueryLinkEnabledChannelResponse, error)
+	// LinkEnabledChannels returns all the channels which allow linked packets.
+	LinkEnabledChannels(context.Context, *QueryLinkEnabledChannelsRequest) (*QueryLinkEnabledChannelsResponse, error)
+	// Link returns the link with the given identifier, with its packets and status.
+	Link(context.Context, *QueryLinkRequest) (*QueryLinkResponse, error)
+	// Links returns the links opened on this chain, optionally filtered by owner, status or channel.
+	Links(context.Context, *QueryLinksRequest) (*QueryLinksResponse, error)
+	// OpenSession returns the link currently opened by the given sender, if any.
+	OpenSession(context.Context, *QueryOpenSessionRequest) (*QueryOpenSessionResponse, error)
+	// LinkingPaused returns whether linking is paused chain-wide or, if a channel is given, on the channel.
+	LinkingPaused(context.Context, *QueryLinkingPausedRequest) (*QueryLinkingPausedResponse, error)
+	// AccountLinkQuota returns the number of links the given account can still open in the current rate limit window.
+	AccountLinkQuota(context.Context, *QueryAccountLinkQuotaRequest) (*QueryAccountLinkQuotaResponse, error)
+	// ChannelLinkQuota returns the number of linked packets which can still be sent on the given channel in the
+	// current rate limit window.
+	ChannelLinkQuota(context.Context, *QueryChannelLinkQuotaRequest) (*QueryChannelLinkQuotaResponse, error)
 	// Params returns the module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -77,6 +177,27 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) LinkEnabledChannel(context.Context, *QueryLinkEnabledChannelRequest) (*QueryLinkEnabledChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkEnabledChannel not implemented")
 }
+func (UnimplementedQueryServer) LinkEnabledChannels(context.Context, *QueryLinkEnabledChannelsRequest) (*QueryLinkEnabledChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkEnabledChannels not implemented")
+}
+func (UnimplementedQueryServer) Link(context.Context, *QueryLinkRequest) (*QueryLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Link not implemented")
+}
+func (UnimplementedQueryServer) Links(context.Context, *QueryLinksRequest) (*QueryLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Links not implemented")
+}
+func (UnimplementedQueryServer) OpenSession(context.Context, *QueryOpenSessionRequest) (*QueryOpenSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (UnimplementedQueryServer) LinkingPaused(context.Context, *QueryLinkingPausedRequest) (*QueryLinkingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkingPaused not implemented")
+}
+func (UnimplementedQueryServer) AccountLinkQuota(context.Context, *QueryAccountLinkQuotaRequest) (*QueryAccountLinkQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLinkQuota not implemented")
+}
+func (UnimplementedQueryServer) ChannelLinkQuota(context.Context, *QueryChannelLinkQuotaRequest) (*QueryChannelLinkQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelLinkQuota not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -111,6 +232,132 @@ func _Query_LinkEnabledChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LinkEnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkEnabledChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkEnabledChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LinkEnabledChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkEnabledChannels(ctx, req.(*QueryLinkEnabledChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Link_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Link(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Link_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Link(ctx, req.(*QueryLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Links_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Links(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Links_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Links(ctx, req.(*QueryLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OpenSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenSession(ctx, req.(*QueryOpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LinkingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkingPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LinkingPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkingPaused(ctx, req.(*QueryLinkingPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLinkQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLinkQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLinkQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountLinkQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLinkQuota(ctx, req.(*QueryAccountLinkQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelLinkQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelLinkQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelLinkQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelLinkQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelLinkQuota(ctx, req.(*QueryChannelLinkQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -140,6 +387,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LinkEnabledChannel",
 			Handler:    _Query_LinkEnabledChannel_Handler,
 		},
+		{
+			MethodName: "LinkEnabledChannels",
+			Handler:    _Query_LinkEnabledChannels_Handler,
+		},
+		{
+			MethodName: "Link",
+			Handler:    _Query_Link_Handler,
+		},
+		{
+			MethodName: "Links",
+			Handler:    _Query_Links_Handler,
+		},
+		{
+			MethodName: "OpenSession",
+			Handler:    _Query_OpenSession_Handler,
+		},
+		{
+			MethodName: "LinkingPaused",
+			Handler:    _Query_LinkingPaused_Handler,
+		},
+		{
+			MethodName: "AccountLinkQuota",
+			Handler:    _Query_AccountLinkQuota_Handler,
+		},
+		{
+			MethodName: "ChannelLinkQuota",
+			Handler:    _Query_ChannelLinkQuota_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2,6 +2,7 @@
 package linkedpacketsv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -16,10 +17,170 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_MsgInitLink_3_list)(nil)
+
+type _MsgInitLink_3_list struct {
+	list *[]*Compensation
+}
+
+func (x *_MsgInitLink_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgInitLink_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgInitLink_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Compensation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgInitLink_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Compensation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgInitLink_3_list) AppendMutable() protoreflect.Value {
+	v := new(Compensation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgInitLink_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgInitLink_3_list) NewElement() protoreflect.Value {
+	v := new(Compensation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgInitLink_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgInitLink_4_list)(nil)
+
+type _MsgInitLink_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgInitLink_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgInitLink_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgInitLink_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgInitLink_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgInitLink_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgInitLink_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgInitLink_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgInitLink_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgInitLink_8_list)(nil)
+
+type _MsgInitLink_8_list struct {
+	list *[]*ChannelIdentifier
+}
+
+func (x *_MsgInitLink_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgInitLink_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgInitLink_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgInitLink_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgInitLink_8_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgInitLink_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgInitLink_8_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgInitLink_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgInitLink         protoreflect.MessageDescriptor
-	fd_MsgInitLink_sender  protoreflect.FieldDescriptor
-	fd_MsgInitLink_link_id protoreflect.FieldDescriptor
+	md_MsgInitLink                       protoreflect.MessageDescriptor
+	fd_MsgInitLink_sender                protoreflect.FieldDescriptor
+	fd_MsgInitLink_link_id               protoreflect.FieldDescriptor
+	fd_MsgInitLink_compensations         protoreflect.FieldDescriptor
+	fd_MsgInitLink_relayer_reward        protoreflect.FieldDescriptor
+	fd_MsgInitLink_src_callback_address  protoreflect.FieldDescriptor
+	fd_MsgInitLink_dest_callback_address protoreflect.FieldDescriptor
+	fd_MsgInitLink_atomic                protoreflect.FieldDescriptor
+	fd_MsgInitLink_allowed_channels      protoreflect.FieldDescriptor
+	fd_MsgInitLink_max_packets           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +188,13 @@ func init() {
 	md_MsgInitLink = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgInitLink")
 	fd_MsgInitLink_sender = md_MsgInitLink.Fields().ByName("sender")
 	fd_MsgInitLink_link_id = md_MsgInitLink.Fields().ByName("link_id")
+	fd_MsgInitLink_compensations = md_MsgInitLink.Fields().ByName("compensations")
+	fd_MsgInitLink_relayer_reward = md_MsgInitLink.Fields().ByName("relayer_reward")
+	fd_MsgInitLink_src_callback_address = md_MsgInitLink.Fields().ByName("src_callback_address")
+	fd_MsgInitLink_dest_callback_address = md_MsgInitLink.Fields().ByName("dest_callback_address")
+	fd_MsgInitLink_atomic = md_MsgInitLink.Fields().ByName("atomic")
+	fd_MsgInitLink_allowed_channels = md_MsgInitLink.Fields().ByName("allowed_channels")
+	fd_MsgInitLink_max_packets = md_MsgInitLink.Fields().ByName("max_packets")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgInitLink)(nil)
@@ -106,6 +274,48 @@ func (x *fastReflection_MsgInitLink) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Compensations) != 0 {
+		value := protoreflect.ValueOfList(&_MsgInitLink_3_list{list: &x.Compensations})
+		if !f(fd_MsgInitLink_compensations, value) {
+			return
+		}
+	}
+	if len(x.RelayerReward) != 0 {
+		value := protoreflect.ValueOfList(&_MsgInitLink_4_list{list: &x.RelayerReward})
+		if !f(fd_MsgInitLink_relayer_reward, value) {
+			return
+		}
+	}
+	if x.SrcCallbackAddress != "" {
+		value := protoreflect.ValueOfString(x.SrcCallbackAddress)
+		if !f(fd_MsgInitLink_src_callback_address, value) {
+			return
+		}
+	}
+	if x.DestCallbackAddress != "" {
+		value := protoreflect.ValueOfString(x.DestCallbackAddress)
+		if !f(fd_MsgInitLink_dest_callback_address, value) {
+			return
+		}
+	}
+	if x.Atomic != false {
+		value := protoreflect.ValueOfBool(x.Atomic)
+		if !f(fd_MsgInitLink_atomic, value) {
+			return
+		}
+	}
+	if len(x.AllowedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_MsgInitLink_8_list{list: &x.AllowedChannels})
+		if !f(fd_MsgInitLink_allowed_channels, value) {
+			return
+		}
+	}
+	if x.MaxPackets != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPackets)
+		if !f(fd_MsgInitLink_max_packets, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -125,6 +335,20 @@ func (x *fastReflection_MsgInitLink) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Sender != ""
 	case "srdtrk.linkedpackets.v1.MsgInitLink.link_id":
 		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.MsgInitLink.compensations":
+		return len(x.Compensations) != 0
+	case "srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward":
+		return len(x.RelayerReward) != 0
+	case "srdtrk.linkedpackets.v1.MsgInitLink.src_callback_address":
+		return x.SrcCallbackAddress != ""
+	case "srdtrk.linkedpackets.v1.MsgInitLink.dest_callback_address":
+		return x.DestCallbackAddress != ""
+	case "srdtrk.linkedpackets.v1.MsgInitLink.atomic":
+		return x.Atomic != false
+	case "srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels":
+		return len(x.AllowedChannels) != 0
+	case "srdtrk.linkedpackets.v1.MsgInitLink.max_packets":
+		return x.MaxPackets != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLink"))
@@ -145,6 +369,20 @@ func (x *fastReflection_MsgInitLink) Clear(fd protoreflect.FieldDescriptor) {
 		x.Sender = ""
 	case "srdtrk.linkedpackets.v1.MsgInitLink.link_id":
 		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.MsgInitLink.compensations":
+		x.Compensations = nil
+	case "srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward":
+		x.RelayerReward = nil
+	case "srdtrk.linkedpackets.v1.MsgInitLink.src_callback_address":
+		x.SrcCallbackAddress = ""
+	case "srdtrk.linkedpackets.v1.MsgInitLink.dest_callback_address":
+		x.DestCallbackAddress = ""
+	case "srdtrk.linkedpackets.v1.MsgInitLink.atomic":
+		x.Atomic = false
+	case "srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels":
+		x.AllowedChannels = nil
+	case "srdtrk.linkedpackets.v1.MsgInitLink.max_packets":
+		x.MaxPackets = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLink"))
@@ -167,6 +405,36 @@ func (x *fastReflection_MsgInitLink) Get(descriptor protoreflect.FieldDescriptor
 	case "srdtrk.linkedpackets.v1.MsgInitLink.link_id":
 		value := x.LinkId
 		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.compensations":
+		if len(x.Compensations) == 0 {
+			return protoreflect.ValueOfList(&_MsgInitLink_3_list{})
+		}
+		listValue := &_MsgInitLink_3_list{list: &x.Compensations}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward":
+		if len(x.RelayerReward) == 0 {
+			return protoreflect.ValueOfList(&_MsgInitLink_4_list{})
+		}
+		listValue := &_MsgInitLink_4_list{list: &x.RelayerReward}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.src_callback_address":
+		value := x.SrcCallbackAddress
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.dest_callback_address":
+		value := x.DestCallbackAddress
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.atomic":
+		value := x.Atomic
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels":
+		if len(x.AllowedChannels) == 0 {
+			return protoreflect.ValueOfList(&_MsgInitLink_8_list{})
+		}
+		listValue := &_MsgInitLink_8_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.max_packets":
+		value := x.MaxPackets
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLink"))
@@ -191,6 +459,26 @@ func (x *fastReflection_MsgInitLink) Set(fd protoreflect.FieldDescriptor, value
 		x.Sender = value.Interface().(string)
 	case "srdtrk.linkedpackets.v1.MsgInitLink.link_id":
 		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.compensations":
+		lv := value.List()
+		clv := lv.(*_MsgInitLink_3_list)
+		x.Compensations = *clv.list
+	case "srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward":
+		lv := value.List()
+		clv := lv.(*_MsgInitLink_4_list)
+		x.RelayerReward = *clv.list
+	case "srdtrk.linkedpackets.v1.MsgInitLink.src_callback_address":
+		x.SrcCallbackAddress = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.dest_callback_address":
+		x.DestCallbackAddress = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.atomic":
+		x.Atomic = value.Bool()
+	case "srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels":
+		lv := value.List()
+		clv := lv.(*_MsgInitLink_8_list)
+		x.AllowedChannels = *clv.list
+	case "srdtrk.linkedpackets.v1.MsgInitLink.max_packets":
+		x.MaxPackets = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLink"))
@@ -211,10 +499,36 @@ func (x *fastReflection_MsgInitLink) Set(fd protoreflect.FieldDescriptor, value
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgInitLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLink.compensations":
+		if x.Compensations == nil {
+			x.Compensations = []*Compensation{}
+		}
+		value := &_MsgInitLink_3_list{list: &x.Compensations}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward":
+		if x.RelayerReward == nil {
+			x.RelayerReward = []*v1beta1.Coin{}
+		}
+		value := &_MsgInitLink_4_list{list: &x.RelayerReward}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels":
+		if x.AllowedChannels == nil {
+			x.AllowedChannels = []*ChannelIdentifier{}
+		}
+		value := &_MsgInitLink_8_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(value)
 	case "srdtrk.linkedpackets.v1.MsgInitLink.sender":
 		panic(fmt.Errorf("field sender of message srdtrk.linkedpackets.v1.MsgInitLink is not mutable"))
 	case "srdtrk.linkedpackets.v1.MsgInitLink.link_id":
 		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.MsgInitLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgInitLink.src_callback_address":
+		panic(fmt.Errorf("field src_callback_address of message srdtrk.linkedpackets.v1.MsgInitLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgInitLink.dest_callback_address":
+		panic(fmt.Errorf("field dest_callback_address of message srdtrk.linkedpackets.v1.MsgInitLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgInitLink.atomic":
+		panic(fmt.Errorf("field atomic of message srdtrk.linkedpackets.v1.MsgInitLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgInitLink.max_packets":
+		panic(fmt.Errorf("field max_packets of message srdtrk.linkedpackets.v1.MsgInitLink is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLink"))
@@ -232,6 +546,23 @@ func (x *fastReflection_MsgInitLink) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfString("")
 	case "srdtrk.linkedpackets.v1.MsgInitLink.link_id":
 		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgInitLink.compensations":
+		list := []*Compensation{}
+		return protoreflect.ValueOfList(&_MsgInitLink_3_list{list: &list})
+	case "srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgInitLink_4_list{list: &list})
+	case "srdtrk.linkedpackets.v1.MsgInitLink.src_callback_address":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgInitLink.dest_callback_address":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgInitLink.atomic":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_MsgInitLink_8_list{list: &list})
+	case "srdtrk.linkedpackets.v1.MsgInitLink.max_packets":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLink"))
@@ -309,6 +640,38 @@ func (x *fastReflection_MsgInitLink) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Compensations) > 0 {
+			for _, e := range x.Compensations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RelayerReward) > 0 {
+			for _, e := range x.RelayerReward {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SrcCallbackAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestCallbackAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Atomic {
+			n += 2
+		}
+		if len(x.AllowedChannels) > 0 {
+			for _, e := range x.AllowedChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPackets != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPackets))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -338,6 +701,83 @@ func (x *fastReflection_MsgInitLink) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPackets != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPackets))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.AllowedChannels) > 0 {
+			for iNdEx := len(x.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.Atomic {
+			i--
+			if x.Atomic {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.DestCallbackAddress) > 0 {
+			i -= len(x.DestCallbackAddress)
+			copy(dAtA[i:], x.DestCallbackAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestCallbackAddress)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SrcCallbackAddress) > 0 {
+			i -= len(x.SrcCallbackAddress)
+			copy(dAtA[i:], x.SrcCallbackAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcCallbackAddress)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RelayerReward) > 0 {
+			for iNdEx := len(x.RelayerReward) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RelayerReward[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Compensations) > 0 {
+			for iNdEx := len(x.Compensations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Compensations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.LinkId) > 0 {
 			i -= len(x.LinkId)
 			copy(dAtA[i:], x.LinkId)
@@ -465,88 +905,295 @@ func (x *fastReflection_MsgInitLink) ProtoMethods() *protoiface.Methods {
 				}
 				x.LinkId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Compensations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Compensations = append(x.Compensations, &Compensation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Compensations[len(x.Compensations)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.RelayerReward = append(x.RelayerReward, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelayerReward[len(x.RelayerReward)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgInitLinkResponse protoreflect.MessageDescriptor
-)
-
-func init() {
-	file_srdtrk_linkedpackets_v1_tx_proto_init()
-	md_MsgInitLinkResponse = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgInitLinkResponse")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgInitLinkResponse)(nil)
-
-type fastReflection_MsgInitLinkResponse MsgInitLinkResponse
-
-func (x *MsgInitLinkResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgInitLinkResponse)(x)
-}
-
-func (x *MsgInitLinkResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgInitLinkResponse_messageType fastReflection_MsgInitLinkResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgInitLinkResponse_messageType{}
-
-type fastReflection_MsgInitLinkResponse_messageType struct{}
-
-func (x fastReflection_MsgInitLinkResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgInitLinkResponse)(nil)
-}
-func (x fastReflection_MsgInitLinkResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgInitLinkResponse)
-}
-func (x fastReflection_MsgInitLinkResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgInitLinkResponse
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgInitLinkResponse) Descriptor() protoreflect.MessageDescriptor {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcCallbackAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SrcCallbackAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestCallbackAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestCallbackAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Atomic = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedChannels = append(x.AllowedChannels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedChannels[len(x.AllowedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+				}
+				x.MaxPackets = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPackets |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgInitLinkResponse         protoreflect.MessageDescriptor
+	fd_MsgInitLinkResponse_link_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgInitLinkResponse = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgInitLinkResponse")
+	fd_MsgInitLinkResponse_link_id = md_MsgInitLinkResponse.Fields().ByName("link_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgInitLinkResponse)(nil)
+
+type fastReflection_MsgInitLinkResponse MsgInitLinkResponse
+
+func (x *MsgInitLinkResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitLinkResponse)(x)
+}
+
+func (x *MsgInitLinkResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgInitLinkResponse_messageType fastReflection_MsgInitLinkResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitLinkResponse_messageType{}
+
+type fastReflection_MsgInitLinkResponse_messageType struct{}
+
+func (x fastReflection_MsgInitLinkResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitLinkResponse)(nil)
+}
+func (x fastReflection_MsgInitLinkResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitLinkResponse)
+}
+func (x fastReflection_MsgInitLinkResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitLinkResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgInitLinkResponse) Descriptor() protoreflect.MessageDescriptor {
 	return md_MsgInitLinkResponse
 }
 
@@ -574,6 +1221,12 @@ func (x *fastReflection_MsgInitLinkResponse) Interface() protoreflect.ProtoMessa
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_MsgInitLinkResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_MsgInitLinkResponse_link_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -589,6 +1242,8 @@ func (x *fastReflection_MsgInitLinkResponse) Range(f func(protoreflect.FieldDesc
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MsgInitLinkResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLinkResponse.link_id":
+		return x.LinkId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLinkResponse"))
@@ -605,6 +1260,8 @@ func (x *fastReflection_MsgInitLinkResponse) Has(fd protoreflect.FieldDescriptor
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgInitLinkResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLinkResponse.link_id":
+		x.LinkId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLinkResponse"))
@@ -621,6 +1278,9 @@ func (x *fastReflection_MsgInitLinkResponse) Clear(fd protoreflect.FieldDescript
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MsgInitLinkResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLinkResponse.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLinkResponse"))
@@ -641,6 +1301,8 @@ func (x *fastReflection_MsgInitLinkResponse) Get(descriptor protoreflect.FieldDe
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgInitLinkResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLinkResponse.link_id":
+		x.LinkId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLinkResponse"))
@@ -661,6 +1323,8 @@ func (x *fastReflection_MsgInitLinkResponse) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgInitLinkResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLinkResponse.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.MsgInitLinkResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLinkResponse"))
@@ -674,6 +1338,8 @@ func (x *fastReflection_MsgInitLinkResponse) Mutable(fd protoreflect.FieldDescri
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MsgInitLinkResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgInitLinkResponse.link_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgInitLinkResponse"))
@@ -743,6 +1409,10 @@ func (x *fastReflection_MsgInitLinkResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -772,6 +1442,13 @@ func (x *fastReflection_MsgInitLinkResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -821,6 +1498,38 @@ func (x *fastReflection_MsgInitLinkResponse) ProtoMethods() *protoiface.Methods
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2487,10 +3196,2914 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
+var (
+	md_MsgForceCloseLink            protoreflect.MessageDescriptor
+	fd_MsgForceCloseLink_authority  protoreflect.FieldDescriptor
+	fd_MsgForceCloseLink_link_id    protoreflect.FieldDescriptor
+	fd_MsgForceCloseLink_port_id    protoreflect.FieldDescriptor
+	fd_MsgForceCloseLink_channel_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgForceCloseLink = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgForceCloseLink")
+	fd_MsgForceCloseLink_authority = md_MsgForceCloseLink.Fields().ByName("authority")
+	fd_MsgForceCloseLink_link_id = md_MsgForceCloseLink.Fields().ByName("link_id")
+	fd_MsgForceCloseLink_port_id = md_MsgForceCloseLink.Fields().ByName("port_id")
+	fd_MsgForceCloseLink_channel_id = md_MsgForceCloseLink.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgForceCloseLink)(nil)
+
+type fastReflection_MsgForceCloseLink MsgForceCloseLink
+
+func (x *MsgForceCloseLink) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgForceCloseLink)(x)
+}
+
+func (x *MsgForceCloseLink) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgForceCloseLink_messageType fastReflection_MsgForceCloseLink_messageType
+var _ protoreflect.MessageType = fastReflection_MsgForceCloseLink_messageType{}
+
+type fastReflection_MsgForceCloseLink_messageType struct{}
+
+func (x fastReflection_MsgForceCloseLink_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgForceCloseLink)(nil)
+}
+func (x fastReflection_MsgForceCloseLink_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgForceCloseLink)
+}
+func (x fastReflection_MsgForceCloseLink_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceCloseLink
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgForceCloseLink) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceCloseLink
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgForceCloseLink) Type() protoreflect.MessageType {
+	return _fastReflection_MsgForceCloseLink_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgForceCloseLink) New() protoreflect.Message {
+	return new(fastReflection_MsgForceCloseLink)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgForceCloseLink) Interface() protoreflect.ProtoMessage {
+	return (*MsgForceCloseLink)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgForceCloseLink) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgForceCloseLink_authority, value) {
+			return
+		}
+	}
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_MsgForceCloseLink_link_id, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_MsgForceCloseLink_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_MsgForceCloseLink_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgForceCloseLink) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.authority":
+		return x.Authority != ""
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.port_id":
+		return x.PortId != ""
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLink) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.authority":
+		x.Authority = ""
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.port_id":
+		x.PortId = ""
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgForceCloseLink) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLink does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLink) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.authority":
+		x.Authority = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.port_id":
+		x.PortId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.authority":
+		panic(fmt.Errorf("field authority of message srdtrk.linkedpackets.v1.MsgForceCloseLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.MsgForceCloseLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.port_id":
+		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.MsgForceCloseLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.channel_id":
+		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.MsgForceCloseLink is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgForceCloseLink) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.authority":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.port_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgForceCloseLink.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgForceCloseLink) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.MsgForceCloseLink", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgForceCloseLink) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLink) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgForceCloseLink) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgForceCloseLink) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgForceCloseLink)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceCloseLink)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceCloseLink)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceCloseLink: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceCloseLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgForceCloseLinkResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgForceCloseLinkResponse = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgForceCloseLinkResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgForceCloseLinkResponse)(nil)
+
+type fastReflection_MsgForceCloseLinkResponse MsgForceCloseLinkResponse
+
+func (x *MsgForceCloseLinkResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgForceCloseLinkResponse)(x)
+}
+
+func (x *MsgForceCloseLinkResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgForceCloseLinkResponse_messageType fastReflection_MsgForceCloseLinkResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgForceCloseLinkResponse_messageType{}
+
+type fastReflection_MsgForceCloseLinkResponse_messageType struct{}
+
+func (x fastReflection_MsgForceCloseLinkResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgForceCloseLinkResponse)(nil)
+}
+func (x fastReflection_MsgForceCloseLinkResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgForceCloseLinkResponse)
+}
+func (x fastReflection_MsgForceCloseLinkResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceCloseLinkResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgForceCloseLinkResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceCloseLinkResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgForceCloseLinkResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgForceCloseLinkResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgForceCloseLinkResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgForceCloseLinkResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgForceCloseLinkResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgForceCloseLinkResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgForceCloseLinkResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgForceCloseLinkResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLinkResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgForceCloseLinkResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLinkResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLinkResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgForceCloseLinkResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgForceCloseLinkResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgForceCloseLinkResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceCloseLinkResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgForceCloseLinkResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgForceCloseLinkResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgForceCloseLinkResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceCloseLinkResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceCloseLinkResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceCloseLinkResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceCloseLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgForceFailLink            protoreflect.MessageDescriptor
+	fd_MsgForceFailLink_authority  protoreflect.FieldDescriptor
+	fd_MsgForceFailLink_link_id    protoreflect.FieldDescriptor
+	fd_MsgForceFailLink_port_id    protoreflect.FieldDescriptor
+	fd_MsgForceFailLink_channel_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgForceFailLink = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgForceFailLink")
+	fd_MsgForceFailLink_authority = md_MsgForceFailLink.Fields().ByName("authority")
+	fd_MsgForceFailLink_link_id = md_MsgForceFailLink.Fields().ByName("link_id")
+	fd_MsgForceFailLink_port_id = md_MsgForceFailLink.Fields().ByName("port_id")
+	fd_MsgForceFailLink_channel_id = md_MsgForceFailLink.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgForceFailLink)(nil)
+
+type fastReflection_MsgForceFailLink MsgForceFailLink
+
+func (x *MsgForceFailLink) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgForceFailLink)(x)
+}
+
+func (x *MsgForceFailLink) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgForceFailLink_messageType fastReflection_MsgForceFailLink_messageType
+var _ protoreflect.MessageType = fastReflection_MsgForceFailLink_messageType{}
+
+type fastReflection_MsgForceFailLink_messageType struct{}
+
+func (x fastReflection_MsgForceFailLink_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgForceFailLink)(nil)
+}
+func (x fastReflection_MsgForceFailLink_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgForceFailLink)
+}
+func (x fastReflection_MsgForceFailLink_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceFailLink
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgForceFailLink) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceFailLink
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgForceFailLink) Type() protoreflect.MessageType {
+	return _fastReflection_MsgForceFailLink_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgForceFailLink) New() protoreflect.Message {
+	return new(fastReflection_MsgForceFailLink)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgForceFailLink) Interface() protoreflect.ProtoMessage {
+	return (*MsgForceFailLink)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgForceFailLink) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgForceFailLink_authority, value) {
+			return
+		}
+	}
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_MsgForceFailLink_link_id, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_MsgForceFailLink_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_MsgForceFailLink_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgForceFailLink) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.authority":
+		return x.Authority != ""
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.port_id":
+		return x.PortId != ""
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLink) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.authority":
+		x.Authority = ""
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.port_id":
+		x.PortId = ""
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgForceFailLink) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLink does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLink) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.authority":
+		x.Authority = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.port_id":
+		x.PortId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.authority":
+		panic(fmt.Errorf("field authority of message srdtrk.linkedpackets.v1.MsgForceFailLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.MsgForceFailLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.port_id":
+		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.MsgForceFailLink is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.channel_id":
+		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.MsgForceFailLink is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgForceFailLink) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.authority":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.port_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgForceFailLink.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgForceFailLink) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.MsgForceFailLink", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgForceFailLink) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLink) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgForceFailLink) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgForceFailLink) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgForceFailLink)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceFailLink)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceFailLink)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceFailLink: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceFailLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgForceFailLinkResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgForceFailLinkResponse = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgForceFailLinkResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgForceFailLinkResponse)(nil)
+
+type fastReflection_MsgForceFailLinkResponse MsgForceFailLinkResponse
+
+func (x *MsgForceFailLinkResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgForceFailLinkResponse)(x)
+}
+
+func (x *MsgForceFailLinkResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgForceFailLinkResponse_messageType fastReflection_MsgForceFailLinkResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgForceFailLinkResponse_messageType{}
+
+type fastReflection_MsgForceFailLinkResponse_messageType struct{}
+
+func (x fastReflection_MsgForceFailLinkResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgForceFailLinkResponse)(nil)
+}
+func (x fastReflection_MsgForceFailLinkResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgForceFailLinkResponse)
+}
+func (x fastReflection_MsgForceFailLinkResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceFailLinkResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgForceFailLinkResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForceFailLinkResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgForceFailLinkResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgForceFailLinkResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgForceFailLinkResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgForceFailLinkResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgForceFailLinkResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgForceFailLinkResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgForceFailLinkResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgForceFailLinkResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLinkResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgForceFailLinkResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLinkResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLinkResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLinkResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgForceFailLinkResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgForceFailLinkResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgForceFailLinkResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.MsgForceFailLinkResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgForceFailLinkResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForceFailLinkResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgForceFailLinkResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgForceFailLinkResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgForceFailLinkResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceFailLinkResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForceFailLinkResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceFailLinkResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForceFailLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetLinkingPaused_3_list)(nil)
+
+type _MsgSetLinkingPaused_3_list struct {
+	list *[]*ChannelIdentifier
+}
+
+func (x *_MsgSetLinkingPaused_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetLinkingPaused_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetLinkingPaused_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetLinkingPaused_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetLinkingPaused_3_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetLinkingPaused_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetLinkingPaused_3_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetLinkingPaused_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetLinkingPaused           protoreflect.MessageDescriptor
+	fd_MsgSetLinkingPaused_authority protoreflect.FieldDescriptor
+	fd_MsgSetLinkingPaused_paused    protoreflect.FieldDescriptor
+	fd_MsgSetLinkingPaused_channels  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgSetLinkingPaused = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgSetLinkingPaused")
+	fd_MsgSetLinkingPaused_authority = md_MsgSetLinkingPaused.Fields().ByName("authority")
+	fd_MsgSetLinkingPaused_paused = md_MsgSetLinkingPaused.Fields().ByName("paused")
+	fd_MsgSetLinkingPaused_channels = md_MsgSetLinkingPaused.Fields().ByName("channels")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetLinkingPaused)(nil)
+
+type fastReflection_MsgSetLinkingPaused MsgSetLinkingPaused
+
+func (x *MsgSetLinkingPaused) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetLinkingPaused)(x)
+}
+
+func (x *MsgSetLinkingPaused) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetLinkingPaused_messageType fastReflection_MsgSetLinkingPaused_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetLinkingPaused_messageType{}
+
+type fastReflection_MsgSetLinkingPaused_messageType struct{}
+
+func (x fastReflection_MsgSetLinkingPaused_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetLinkingPaused)(nil)
+}
+func (x fastReflection_MsgSetLinkingPaused_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetLinkingPaused)
+}
+func (x fastReflection_MsgSetLinkingPaused_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetLinkingPaused
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetLinkingPaused) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetLinkingPaused
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetLinkingPaused) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetLinkingPaused_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetLinkingPaused) New() protoreflect.Message {
+	return new(fastReflection_MsgSetLinkingPaused)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetLinkingPaused) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetLinkingPaused)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetLinkingPaused) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgSetLinkingPaused_authority, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_MsgSetLinkingPaused_paused, value) {
+			return
+		}
+	}
+	if len(x.Channels) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetLinkingPaused_3_list{list: &x.Channels})
+		if !f(fd_MsgSetLinkingPaused_channels, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetLinkingPaused) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.authority":
+		return x.Authority != ""
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.paused":
+		return x.Paused != false
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels":
+		return len(x.Channels) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPaused) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.authority":
+		x.Authority = ""
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.paused":
+		x.Paused = false
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels":
+		x.Channels = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetLinkingPaused) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels":
+		if len(x.Channels) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetLinkingPaused_3_list{})
+		}
+		listValue := &_MsgSetLinkingPaused_3_list{list: &x.Channels}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPaused does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPaused) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.authority":
+		x.Authority = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.paused":
+		x.Paused = value.Bool()
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels":
+		lv := value.List()
+		clv := lv.(*_MsgSetLinkingPaused_3_list)
+		x.Channels = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPaused) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels":
+		if x.Channels == nil {
+			x.Channels = []*ChannelIdentifier{}
+		}
+		value := &_MsgSetLinkingPaused_3_list{list: &x.Channels}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.authority":
+		panic(fmt.Errorf("field authority of message srdtrk.linkedpackets.v1.MsgSetLinkingPaused is not mutable"))
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.paused":
+		panic(fmt.Errorf("field paused of message srdtrk.linkedpackets.v1.MsgSetLinkingPaused is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetLinkingPaused) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.authority":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.paused":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_MsgSetLinkingPaused_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPaused"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPaused does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetLinkingPaused) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.MsgSetLinkingPaused", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetLinkingPaused) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPaused) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetLinkingPaused) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetLinkingPaused) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetLinkingPaused)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if len(x.Channels) > 0 {
+			for _, e := range x.Channels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetLinkingPaused)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Channels) > 0 {
+			for iNdEx := len(x.Channels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Channels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetLinkingPaused)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetLinkingPaused: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetLinkingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channels = append(x.Channels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Channels[len(x.Channels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetLinkingPausedResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_tx_proto_init()
+	md_MsgSetLinkingPausedResponse = File_srdtrk_linkedpackets_v1_tx_proto.Messages().ByName("MsgSetLinkingPausedResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetLinkingPausedResponse)(nil)
+
+type fastReflection_MsgSetLinkingPausedResponse MsgSetLinkingPausedResponse
+
+func (x *MsgSetLinkingPausedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetLinkingPausedResponse)(x)
+}
+
+func (x *MsgSetLinkingPausedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetLinkingPausedResponse_messageType fastReflection_MsgSetLinkingPausedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetLinkingPausedResponse_messageType{}
+
+type fastReflection_MsgSetLinkingPausedResponse_messageType struct{}
+
+func (x fastReflection_MsgSetLinkingPausedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetLinkingPausedResponse)(nil)
+}
+func (x fastReflection_MsgSetLinkingPausedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetLinkingPausedResponse)
+}
+func (x fastReflection_MsgSetLinkingPausedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetLinkingPausedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetLinkingPausedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetLinkingPausedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetLinkingPausedResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetLinkingPausedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetLinkingPausedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPausedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetLinkingPausedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetLinkingPausedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetLinkingPausedResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetLinkingPausedResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetLinkingPausedResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetLinkingPausedResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetLinkingPausedResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetLinkingPausedResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetLinkingPausedResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetLinkingPausedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetLinkingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
 // source: srdtrk/linkedpackets/v1/tx.proto
 
 const (
@@ -2508,8 +6121,30 @@ type MsgInitLink struct {
 
 	// sender is the message sender.
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// link_id is the link identifier.
+	// link_id is the link identifier. If empty, a link identifier is generated.
 	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// compensations are the optional compensating interchain account transactions of the link members.
+	// If a member of the link fails, the compensations of the members that succeeded are sent in reverse
+	// link index order.
+	Compensations []*Compensation `protobuf:"bytes,3,rep,name=compensations,proto3" json:"compensations,omitempty"`
+	// relayer_reward is the optional reward escrowed from the sender and paid to the relayers of the link
+	// once every member of the link is acknowledged.
+	RelayerReward []*v1beta1.Coin `protobuf:"bytes,4,rep,name=relayer_reward,json=relayerReward,proto3" json:"relayer_reward,omitempty"`
+	// src_callback_address is the optional address of the contract called on this chain once the link is
+	// resolved.
+	SrcCallbackAddress string `protobuf:"bytes,5,opt,name=src_callback_address,json=srcCallbackAddress,proto3" json:"src_callback_address,omitempty"`
+	// dest_callback_address is the optional address of the contract called on the receiving chain once the
+	// last packet of the link is received.
+	DestCallbackAddress string `protobuf:"bytes,6,opt,name=dest_callback_address,json=destCallbackAddress,proto3" json:"dest_callback_address,omitempty"`
+	// atomic requests the interchain accounts host to execute the packets of the link together once its last
+	// packet is received, and to fail all of them if any of them fails. The packets of an atomic link must be
+	// interchain accounts packets sent on the same channel.
+	Atomic bool `protobuf:"varint,7,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// allowed_channels optionally restricts the channels on which the packets of the link can be sent.
+	AllowedChannels []*ChannelIdentifier `protobuf:"bytes,8,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// max_packets is the optional maximum number of packets sent as part of the link. Zero means no limit
+	// besides the limit of the module parameters.
+	MaxPackets uint64 `protobuf:"varint,9,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
 }
 
 func (x *MsgInitLink) Reset() {
@@ -2527,23 +6162,72 @@ func (x *MsgInitLink) String() string {
 
 func (*MsgInitLink) ProtoMessage() {}
 
-// Deprecated: Use MsgInitLink.ProtoReflect.Descriptor instead.
-func (*MsgInitLink) Descriptor() ([]byte, []int) {
-	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgInitLink.ProtoReflect.Descriptor instead.
+func (*MsgInitLink) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgInitLink) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgInitLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *MsgInitLink) GetCompensations() []*Compensation {
+	if x != nil {
+		return x.Compensations
+	}
+	return nil
+}
+
+func (x *MsgInitLink) GetRelayerReward() []*v1beta1.Coin {
+	if x != nil {
+		return x.RelayerReward
+	}
+	return nil
+}
+
+func (x *MsgInitLink) GetSrcCallbackAddress() string {
+	if x != nil {
+		return x.SrcCallbackAddress
+	}
+	return ""
+}
+
+func (x *MsgInitLink) GetDestCallbackAddress() string {
+	if x != nil {
+		return x.DestCallbackAddress
+	}
+	return ""
+}
+
+func (x *MsgInitLink) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
 }
 
-func (x *MsgInitLink) GetSender() string {
+func (x *MsgInitLink) GetAllowedChannels() []*ChannelIdentifier {
 	if x != nil {
-		return x.Sender
+		return x.AllowedChannels
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgInitLink) GetLinkId() string {
+func (x *MsgInitLink) GetMaxPackets() uint64 {
 	if x != nil {
-		return x.LinkId
+		return x.MaxPackets
 	}
-	return ""
+	return 0
 }
 
 // MsgInitLinkResponse defines the Msg/InitLink response type.
@@ -2551,6 +6235,9 @@ type MsgInitLinkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the started link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 }
 
 func (x *MsgInitLinkResponse) Reset() {
@@ -2573,6 +6260,13 @@ func (*MsgInitLinkResponse) Descriptor() ([]byte, []int) {
 	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *MsgInitLinkResponse) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
 // MsgStopLink defines the message stopping packet linking.
 type MsgStopLink struct {
 	state         protoimpl.MessageState
@@ -2713,6 +6407,271 @@ func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
 	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
+// MsgForceCloseLink is the Msg/ForceCloseLink request type.
+type MsgForceCloseLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// link_id is the identifier of the link to close.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// port_id and channel_id identify the channel the link is received on by this chain. They are left empty to
+	// close the link sent by this chain instead.
+	PortId    string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *MsgForceCloseLink) Reset() {
+	*x = MsgForceCloseLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgForceCloseLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgForceCloseLink) ProtoMessage() {}
+
+// Deprecated: Use MsgForceCloseLink.ProtoReflect.Descriptor instead.
+func (*MsgForceCloseLink) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgForceCloseLink) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgForceCloseLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *MsgForceCloseLink) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *MsgForceCloseLink) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// MsgForceCloseLinkResponse defines the Msg/ForceCloseLink response type.
+type MsgForceCloseLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgForceCloseLinkResponse) Reset() {
+	*x = MsgForceCloseLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgForceCloseLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgForceCloseLinkResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgForceCloseLinkResponse.ProtoReflect.Descriptor instead.
+func (*MsgForceCloseLinkResponse) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgForceFailLink is the Msg/ForceFailLink request type.
+type MsgForceFailLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// link_id is the identifier of the link to fail.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// port_id and channel_id identify the channel the link is received on by this chain. They are left empty to
+	// fail the link sent by this chain instead.
+	PortId    string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *MsgForceFailLink) Reset() {
+	*x = MsgForceFailLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgForceFailLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgForceFailLink) ProtoMessage() {}
+
+// Deprecated: Use MsgForceFailLink.ProtoReflect.Descriptor instead.
+func (*MsgForceFailLink) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgForceFailLink) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgForceFailLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *MsgForceFailLink) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *MsgForceFailLink) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// MsgForceFailLinkResponse defines the Msg/ForceFailLink response type.
+type MsgForceFailLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgForceFailLinkResponse) Reset() {
+	*x = MsgForceFailLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgForceFailLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgForceFailLinkResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgForceFailLinkResponse.ProtoReflect.Descriptor instead.
+func (*MsgForceFailLinkResponse) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgSetLinkingPaused is the Msg/SetLinkingPaused request type.
+type MsgSetLinkingPaused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused pauses linking if true and resumes it otherwise.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// channels are the channels on which linking is paused or resumed. Linking is paused or resumed chain-wide
+	// if empty. Resuming linking chain-wide does not resume the channels paused individually.
+	Channels []*ChannelIdentifier `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *MsgSetLinkingPaused) Reset() {
+	*x = MsgSetLinkingPaused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetLinkingPaused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetLinkingPaused) ProtoMessage() {}
+
+// Deprecated: Use MsgSetLinkingPaused.ProtoReflect.Descriptor instead.
+func (*MsgSetLinkingPaused) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgSetLinkingPaused) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgSetLinkingPaused) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *MsgSetLinkingPaused) GetChannels() []*ChannelIdentifier {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// MsgSetLinkingPausedResponse defines the Msg/SetLinkingPaused response type.
+type MsgSetLinkingPausedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetLinkingPausedResponse) Reset() {
+	*x = MsgSetLinkingPausedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetLinkingPausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetLinkingPausedResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetLinkingPausedResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetLinkingPausedResponse) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
 var File_srdtrk_linkedpackets_v1_tx_proto protoreflect.FileDescriptor
 
 var file_srdtrk_linkedpackets_v1_tx_proto_rawDesc = []byte{
@@ -2723,77 +6682,179 @@ var file_srdtrk_linkedpackets_v1_tx_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
-	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73,
 	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
 	0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a,
-	0x0b, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x3a, 0x30, 0x82,
-	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x73,
-	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x22,
-	0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f,
-	0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x30, 0x82,
-	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x73,
-	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x22,
-	0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x73, 0x72, 0x64, 0x74, 0x72,
-	0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb8, 0x02, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x12, 0x5e, 0x0a, 0x08, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x04,
+	0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x51,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x72, 0x63, 0x5f, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x72, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x74, 0x6f, 0x6d, 0x69, 0x63, 0x12, 0x5b, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x2e, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x69, 0x6e, 0x6b, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x30, 0x82, 0xe7,
+	0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x73, 0x72,
+	0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x15,
+	0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b,
+	0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19,
+	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x11, 0x4d, 0x73,
+	0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x39, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xd5, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x38,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x25, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
 	0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x1a, 0x2c, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x3a, 0x3b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x28, 0x73,
+	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x91, 0x05, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5e,
+	0x0a, 0x08, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x2e, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x1a, 0x2c, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e,
+	0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x08, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x2e, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b,
+	0x1a, 0x2c, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74,
+	0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28,
 	0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70,
-	0x4c, 0x69, 0x6e, 0x6b, 0x1a, 0x2c, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x30, 0x2e, 0x73,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x30, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2a, 0x2e, 0x73,
 	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
-	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xf1, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x72,
-	0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x72, 0x64,
-	0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x53, 0x4c, 0x58, 0xaa, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17,
-	0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b,
-	0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19,
-	0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x3a, 0x3a, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x1a, 0x32, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x0d,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e,
+	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x1a, 0x31, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x2c, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x34, 0x2e,
+	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xf1, 0x01, 0x0a, 0x1b, 0x63,
+	0x6f, 0x6d, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4c, 0x58, 0xaa, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x53,
+	0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x19, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x3a, 0x3a, 0x4c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2808,29 +6869,48 @@ func file_srdtrk_linkedpackets_v1_tx_proto_rawDescGZIP() []byte {
 	return file_srdtrk_linkedpackets_v1_tx_proto_rawDescData
 }
 
-var file_srdtrk_linkedpackets_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_srdtrk_linkedpackets_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_srdtrk_linkedpackets_v1_tx_proto_goTypes = []interface{}{
-	(*MsgInitLink)(nil),             // 0: srdtrk.linkedpackets.v1.MsgInitLink
-	(*MsgInitLinkResponse)(nil),     // 1: srdtrk.linkedpackets.v1.MsgInitLinkResponse
-	(*MsgStopLink)(nil),             // 2: srdtrk.linkedpackets.v1.MsgStopLink
-	(*MsgStopLinkResponse)(nil),     // 3: srdtrk.linkedpackets.v1.MsgStopLinkResponse
-	(*MsgUpdateParams)(nil),         // 4: srdtrk.linkedpackets.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil), // 5: srdtrk.linkedpackets.v1.MsgUpdateParamsResponse
-	(*Params)(nil),                  // 6: srdtrk.linkedpackets.v1.Params
+	(*MsgInitLink)(nil),                 // 0: srdtrk.linkedpackets.v1.MsgInitLink
+	(*MsgInitLinkResponse)(nil),         // 1: srdtrk.linkedpackets.v1.MsgInitLinkResponse
+	(*MsgStopLink)(nil),                 // 2: srdtrk.linkedpackets.v1.MsgStopLink
+	(*MsgStopLinkResponse)(nil),         // 3: srdtrk.linkedpackets.v1.MsgStopLinkResponse
+	(*MsgUpdateParams)(nil),             // 4: srdtrk.linkedpackets.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),     // 5: srdtrk.linkedpackets.v1.MsgUpdateParamsResponse
+	(*MsgForceCloseLink)(nil),           // 6: srdtrk.linkedpackets.v1.MsgForceCloseLink
+	(*MsgForceCloseLinkResponse)(nil),   // 7: srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse
+	(*MsgForceFailLink)(nil),            // 8: srdtrk.linkedpackets.v1.MsgForceFailLink
+	(*MsgForceFailLinkResponse)(nil),    // 9: srdtrk.linkedpackets.v1.MsgForceFailLinkResponse
+	(*MsgSetLinkingPaused)(nil),         // 10: srdtrk.linkedpackets.v1.MsgSetLinkingPaused
+	(*MsgSetLinkingPausedResponse)(nil), // 11: srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse
+	(*Compensation)(nil),                // 12: srdtrk.linkedpackets.v1.Compensation
+	(*v1beta1.Coin)(nil),                // 13: cosmos.base.v1beta1.Coin
+	(*ChannelIdentifier)(nil),           // 14: srdtrk.linkedpackets.v1.ChannelIdentifier
+	(*Params)(nil),                      // 15: srdtrk.linkedpackets.v1.Params
 }
 var file_srdtrk_linkedpackets_v1_tx_proto_depIdxs = []int32{
-	6, // 0: srdtrk.linkedpackets.v1.MsgUpdateParams.params:type_name -> srdtrk.linkedpackets.v1.Params
-	0, // 1: srdtrk.linkedpackets.v1.Msg.InitLink:input_type -> srdtrk.linkedpackets.v1.MsgInitLink
-	2, // 2: srdtrk.linkedpackets.v1.Msg.StopLink:input_type -> srdtrk.linkedpackets.v1.MsgStopLink
-	4, // 3: srdtrk.linkedpackets.v1.Msg.UpdateParams:input_type -> srdtrk.linkedpackets.v1.MsgUpdateParams
-	1, // 4: srdtrk.linkedpackets.v1.Msg.InitLink:output_type -> srdtrk.linkedpackets.v1.MsgInitLinkResponse
-	3, // 5: srdtrk.linkedpackets.v1.Msg.StopLink:output_type -> srdtrk.linkedpackets.v1.MsgStopLinkResponse
-	5, // 6: srdtrk.linkedpackets.v1.Msg.UpdateParams:output_type -> srdtrk.linkedpackets.v1.MsgUpdateParamsResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	12, // 0: srdtrk.linkedpackets.v1.MsgInitLink.compensations:type_name -> srdtrk.linkedpackets.v1.Compensation
+	13, // 1: srdtrk.linkedpackets.v1.MsgInitLink.relayer_reward:type_name -> cosmos.base.v1beta1.Coin
+	14, // 2: srdtrk.linkedpackets.v1.MsgInitLink.allowed_channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	15, // 3: srdtrk.linkedpackets.v1.MsgUpdateParams.params:type_name -> srdtrk.linkedpackets.v1.Params
+	14, // 4: srdtrk.linkedpackets.v1.MsgSetLinkingPaused.channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	0,  // 5: srdtrk.linkedpackets.v1.Msg.InitLink:input_type -> srdtrk.linkedpackets.v1.MsgInitLink
+	2,  // 6: srdtrk.linkedpackets.v1.Msg.StopLink:input_type -> srdtrk.linkedpackets.v1.MsgStopLink
+	4,  // 7: srdtrk.linkedpackets.v1.Msg.UpdateParams:input_type -> srdtrk.linkedpackets.v1.MsgUpdateParams
+	6,  // 8: srdtrk.linkedpackets.v1.Msg.ForceCloseLink:input_type -> srdtrk.linkedpackets.v1.MsgForceCloseLink
+	8,  // 9: srdtrk.linkedpackets.v1.Msg.ForceFailLink:input_type -> srdtrk.linkedpackets.v1.MsgForceFailLink
+	10, // 10: srdtrk.linkedpackets.v1.Msg.SetLinkingPaused:input_type -> srdtrk.linkedpackets.v1.MsgSetLinkingPaused
+	1,  // 11: srdtrk.linkedpackets.v1.Msg.InitLink:output_type -> srdtrk.linkedpackets.v1.MsgInitLinkResponse
+	3,  // 12: srdtrk.linkedpackets.v1.Msg.StopLink:output_type -> srdtrk.linkedpackets.v1.MsgStopLinkResponse
+	5,  // 13: srdtrk.linkedpackets.v1.Msg.UpdateParams:output_type -> srdtrk.linkedpackets.v1.MsgUpdateParamsResponse
+	7,  // 14: srdtrk.linkedpackets.v1.Msg.ForceCloseLink:output_type -> srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse
+	9,  // 15: srdtrk.linkedpackets.v1.Msg.ForceFailLink:output_type -> srdtrk.linkedpackets.v1.MsgForceFailLinkResponse
+	11, // 16: srdtrk.linkedpackets.v1.Msg.SetLinkingPaused:output_type -> srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_srdtrk_linkedpackets_v1_tx_proto_init() }
@@ -2912,6 +6992,78 @@ func file_srdtrk_linkedpackets_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgForceCloseLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgForceCloseLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgForceFailLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgForceFailLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetLinkingPaused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetLinkingPausedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2919,7 +7071,7 @@ func file_srdtrk_linkedpackets_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_srdtrk_linkedpackets_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
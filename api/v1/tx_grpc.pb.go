@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_InitLink_FullMethodName     = "/srdtrk.linkedpackets.v1.Msg/InitLink"
-	Msg_StopLink_FullMethodName     = "/srdtrk.linkedpackets.v1.Msg/StopLink"
-	Msg_UpdateParams_FullMethodName = "/srdtrk.linkedpackets.v1.Msg/UpdateParams"
+	Msg_InitLink_FullMethodName         = "/srdtrk.linkedpackets.v1.Msg/InitLink"
+	Msg_StopLink_FullMethodName         = "/srdtrk.linkedpackets.v1.Msg/StopLink"
+	Msg_UpdateParams_FullMethodName     = "/srdtrk.linkedpackets.v1.Msg/UpdateParams"
+	Msg_ForceCloseLink_FullMethodName   = "/srdtrk.linkedpackets.v1.Msg/ForceCloseLink"
+	Msg_ForceFailLink_FullMethodName    = "/srdtrk.linkedpackets.v1.Msg/ForceFailLink"
+	Msg_SetLinkingPaused_FullMethodName = "/srdtrk.linkedpackets.v1.Msg/SetLinkingPaused"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +37,15 @@ type MsgClient interface {
 	StopLink(ctx context.Context, in *MsgStopLink, opts ...grpc.CallOption) (*MsgStopLinkResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ForceCloseLink closes a stuck link sent by this chain, or discards the state of a stuck link received on a
+	// channel of this chain. It is gated by the module authority.
+	ForceCloseLink(ctx context.Context, in *MsgForceCloseLink, opts ...grpc.CallOption) (*MsgForceCloseLinkResponse, error)
+	// ForceFailLink closes a stuck link sent by this chain and fails its pending packets, or discards the state of a
+	// stuck link received on a channel of this chain. It is gated by the module authority.
+	ForceFailLink(ctx context.Context, in *MsgForceFailLink, opts ...grpc.CallOption) (*MsgForceFailLinkResponse, error)
+	// SetLinkingPaused pauses or resumes linking chain-wide or on some channels. It is gated by the module
+	// authority.
+	SetLinkingPaused(ctx context.Context, in *MsgSetLinkingPaused, opts ...grpc.CallOption) (*MsgSetLinkingPausedResponse, error)
 }
 
 type msgClient struct {
@@ -71,6 +83,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ForceCloseLink(ctx context.Context, in *MsgForceCloseLink, opts ...grpc.CallOption) (*MsgForceCloseLinkResponse, error) {
+	out := new(MsgForceCloseLinkResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceCloseLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceFailLink(ctx context.Context, in *MsgForceFailLink, opts ...grpc.CallOption) (*MsgForceFailLinkResponse, error) {
+	out := new(MsgForceFailLinkResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceFailLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLinkingPaused(ctx context.Context, in *MsgSetLinkingPaused, opts ...grpc.CallOption) (*MsgSetLinkingPausedResponse, error) {
+	out := new(MsgSetLinkingPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetLinkingPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -81,6 +120,15 @@ type MsgServer interface {
 	StopLink(context.Context, *MsgStopLink) (*MsgStopLinkResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ForceCloseLink closes a stuck link sent by this chain, or discards the state of a stuck link received on a
+	// channel of this chain. It is gated by the module authority.
+	ForceCloseLink(context.Context, *MsgForceCloseLink) (*MsgForceCloseLinkResponse, error)
+	// ForceFailLink closes a stuck link sent by this chain and fails its pending packets, or discards the state of a
+	// stuck link received on a channel of this chain. It is gated by the module authority.
+	ForceFailLink(context.Context, *MsgForceFailLink) (*MsgForceFailLinkResponse, error)
+	// SetLinkingPaused pauses or resumes linking chain-wide or on some channels. It is gated by the module
+	// authority.
+	SetLinkingPaused(context.Context, *MsgSetLinkingPaused) (*MsgSetLinkingPausedResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +145,15 @@ func (UnimplementedMsgServer) StopLink(context.Context, *MsgStopLink) (*MsgStopL
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ForceCloseLink(context.Context, *MsgForceCloseLink) (*MsgForceCloseLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCloseLink not implemented")
+}
+func (UnimplementedMsgServer) ForceFailLink(context.Context, *MsgForceFailLink) (*MsgForceFailLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceFailLink not implemented")
+}
+func (UnimplementedMsgServer) SetLinkingPaused(context.Context, *MsgSetLinkingPaused) (*MsgSetLinkingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkingPaused not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +221,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ForceCloseLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceCloseLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceCloseLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceCloseLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceCloseLink(ctx, req.(*MsgForceCloseLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceFailLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceFailLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceFailLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceFailLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceFailLink(ctx, req.(*MsgForceFailLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLinkingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLinkingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLinkingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetLinkingPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLinkingPaused(ctx, req.(*MsgSetLinkingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +294,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ForceCloseLink",
+			Handler:    _Msg_ForceCloseLink_Handler,
+		},
+		{
+			MethodName: "ForceFailLink",
+			Handler:    _Msg_ForceFailLink_Handler,
+		},
+		{
+			MethodName: "SetLinkingPaused",
+			Handler:    _Msg_SetLinkingPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "srdtrk/linkedpackets/v1/tx.proto",
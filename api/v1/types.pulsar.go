@@ -2,6 +2,7 @@
 package linkedpacketsv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -10,18 +11,139 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]string
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedPacketDataTypes as it is not of Message kind"))
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                                       protoreflect.MessageDescriptor
+	fd_Params_linking_enabled                       protoreflect.FieldDescriptor
+	fd_Params_max_link_packets                      protoreflect.FieldDescriptor
+	fd_Params_max_open_links_per_account            protoreflect.FieldDescriptor
+	fd_Params_link_expiry_blocks                    protoreflect.FieldDescriptor
+	fd_Params_allowed_packet_data_types             protoreflect.FieldDescriptor
+	fd_Params_link_deposit                          protoreflect.FieldDescriptor
+	fd_Params_expired_deposit_action                protoreflect.FieldDescriptor
+	fd_Params_rate_limit_window_blocks              protoreflect.FieldDescriptor
+	fd_Params_max_links_per_account_window          protoreflect.FieldDescriptor
+	fd_Params_max_linked_packets_per_channel_window protoreflect.FieldDescriptor
+	fd_Params_resolved_link_retention_blocks        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_srdtrk_linkedpackets_v1_types_proto_init()
 	md_Params = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("Params")
+	fd_Params_linking_enabled = md_Params.Fields().ByName("linking_enabled")
+	fd_Params_max_link_packets = md_Params.Fields().ByName("max_link_packets")
+	fd_Params_max_open_links_per_account = md_Params.Fields().ByName("max_open_links_per_account")
+	fd_Params_link_expiry_blocks = md_Params.Fields().ByName("link_expiry_blocks")
+	fd_Params_allowed_packet_data_types = md_Params.Fields().ByName("allowed_packet_data_types")
+	fd_Params_link_deposit = md_Params.Fields().ByName("link_deposit")
+	fd_Params_expired_deposit_action = md_Params.Fields().ByName("expired_deposit_action")
+	fd_Params_rate_limit_window_blocks = md_Params.Fields().ByName("rate_limit_window_blocks")
+	fd_Params_max_links_per_account_window = md_Params.Fields().ByName("max_links_per_account_window")
+	fd_Params_max_linked_packets_per_channel_window = md_Params.Fields().ByName("max_linked_packets_per_channel_window")
+	fd_Params_resolved_link_retention_blocks = md_Params.Fields().ByName("resolved_link_retention_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -89,6 +211,72 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkingEnabled != false {
+		value := protoreflect.ValueOfBool(x.LinkingEnabled)
+		if !f(fd_Params_linking_enabled, value) {
+			return
+		}
+	}
+	if x.MaxLinkPackets != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLinkPackets)
+		if !f(fd_Params_max_link_packets, value) {
+			return
+		}
+	}
+	if x.MaxOpenLinksPerAccount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOpenLinksPerAccount)
+		if !f(fd_Params_max_open_links_per_account, value) {
+			return
+		}
+	}
+	if x.LinkExpiryBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkExpiryBlocks)
+		if !f(fd_Params_link_expiry_blocks, value) {
+			return
+		}
+	}
+	if len(x.AllowedPacketDataTypes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.AllowedPacketDataTypes})
+		if !f(fd_Params_allowed_packet_data_types, value) {
+			return
+		}
+	}
+	if len(x.LinkDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.LinkDeposit})
+		if !f(fd_Params_link_deposit, value) {
+			return
+		}
+	}
+	if x.ExpiredDepositAction != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ExpiredDepositAction))
+		if !f(fd_Params_expired_deposit_action, value) {
+			return
+		}
+	}
+	if x.RateLimitWindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RateLimitWindowBlocks)
+		if !f(fd_Params_rate_limit_window_blocks, value) {
+			return
+		}
+	}
+	if x.MaxLinksPerAccountWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLinksPerAccountWindow)
+		if !f(fd_Params_max_links_per_account_window, value) {
+			return
+		}
+	}
+	if x.MaxLinkedPacketsPerChannelWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLinkedPacketsPerChannelWindow)
+		if !f(fd_Params_max_linked_packets_per_channel_window, value) {
+			return
+		}
+	}
+	if x.ResolvedLinkRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ResolvedLinkRetentionBlocks)
+		if !f(fd_Params_resolved_link_retention_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -104,6 +292,28 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Params.linking_enabled":
+		return x.LinkingEnabled != false
+	case "srdtrk.linkedpackets.v1.Params.max_link_packets":
+		return x.MaxLinkPackets != uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.max_open_links_per_account":
+		return x.MaxOpenLinksPerAccount != uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.link_expiry_blocks":
+		return x.LinkExpiryBlocks != uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.allowed_packet_data_types":
+		return len(x.AllowedPacketDataTypes) != 0
+	case "srdtrk.linkedpackets.v1.Params.link_deposit":
+		return len(x.LinkDeposit) != 0
+	case "srdtrk.linkedpackets.v1.Params.expired_deposit_action":
+		return x.ExpiredDepositAction != 0
+	case "srdtrk.linkedpackets.v1.Params.rate_limit_window_blocks":
+		return x.RateLimitWindowBlocks != uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.max_links_per_account_window":
+		return x.MaxLinksPerAccountWindow != uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.max_linked_packets_per_channel_window":
+		return x.MaxLinkedPacketsPerChannelWindow != uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.resolved_link_retention_blocks":
+		return x.ResolvedLinkRetentionBlocks != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Params"))
@@ -120,6 +330,28 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Params.linking_enabled":
+		x.LinkingEnabled = false
+	case "srdtrk.linkedpackets.v1.Params.max_link_packets":
+		x.MaxLinkPackets = uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.max_open_links_per_account":
+		x.MaxOpenLinksPerAccount = uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.link_expiry_blocks":
+		x.LinkExpiryBlocks = uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.allowed_packet_data_types":
+		x.AllowedPacketDataTypes = nil
+	case "srdtrk.linkedpackets.v1.Params.link_deposit":
+		x.LinkDeposit = nil
+	case "srdtrk.linkedpackets.v1.Params.expired_deposit_action":
+		x.ExpiredDepositAction = 0
+	case "srdtrk.linkedpackets.v1.Params.rate_limit_window_blocks":
+		x.RateLimitWindowBlocks = uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.max_links_per_account_window":
+		x.MaxLinksPerAccountWindow = uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.max_linked_packets_per_channel_window":
+		x.MaxLinkedPacketsPerChannelWindow = uint64(0)
+	case "srdtrk.linkedpackets.v1.Params.resolved_link_retention_blocks":
+		x.ResolvedLinkRetentionBlocks = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Params"))
@@ -136,6 +368,45 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.Params.linking_enabled":
+		value := x.LinkingEnabled
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.Params.max_link_packets":
+		value := x.MaxLinkPackets
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Params.max_open_links_per_account":
+		value := x.MaxOpenLinksPerAccount
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Params.link_expiry_blocks":
+		value := x.LinkExpiryBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Params.allowed_packet_data_types":
+		if len(x.AllowedPacketDataTypes) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.AllowedPacketDataTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Params.link_deposit":
+		if len(x.LinkDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.LinkDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Params.expired_deposit_action":
+		value := x.ExpiredDepositAction
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.Params.rate_limit_window_blocks":
+		value := x.RateLimitWindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Params.max_links_per_account_window":
+		value := x.MaxLinksPerAccountWindow
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Params.max_linked_packets_per_channel_window":
+		value := x.MaxLinkedPacketsPerChannelWindow
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Params.resolved_link_retention_blocks":
+		value := x.ResolvedLinkRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Params"))
@@ -156,6 +427,32 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Params.linking_enabled":
+		x.LinkingEnabled = value.Bool()
+	case "srdtrk.linkedpackets.v1.Params.max_link_packets":
+		x.MaxLinkPackets = value.Uint()
+	case "srdtrk.linkedpackets.v1.Params.max_open_links_per_account":
+		x.MaxOpenLinksPerAccount = value.Uint()
+	case "srdtrk.linkedpackets.v1.Params.link_expiry_blocks":
+		x.LinkExpiryBlocks = value.Uint()
+	case "srdtrk.linkedpackets.v1.Params.allowed_packet_data_types":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.AllowedPacketDataTypes = *clv.list
+	case "srdtrk.linkedpackets.v1.Params.link_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.LinkDeposit = *clv.list
+	case "srdtrk.linkedpackets.v1.Params.expired_deposit_action":
+		x.ExpiredDepositAction = (ExpiredDepositAction)(value.Enum())
+	case "srdtrk.linkedpackets.v1.Params.rate_limit_window_blocks":
+		x.RateLimitWindowBlocks = value.Uint()
+	case "srdtrk.linkedpackets.v1.Params.max_links_per_account_window":
+		x.MaxLinksPerAccountWindow = value.Uint()
+	case "srdtrk.linkedpackets.v1.Params.max_linked_packets_per_channel_window":
+		x.MaxLinkedPacketsPerChannelWindow = value.Uint()
+	case "srdtrk.linkedpackets.v1.Params.resolved_link_retention_blocks":
+		x.ResolvedLinkRetentionBlocks = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Params"))
@@ -176,6 +473,36 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Params.allowed_packet_data_types":
+		if x.AllowedPacketDataTypes == nil {
+			x.AllowedPacketDataTypes = []string{}
+		}
+		value := &_Params_5_list{list: &x.AllowedPacketDataTypes}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Params.link_deposit":
+		if x.LinkDeposit == nil {
+			x.LinkDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_6_list{list: &x.LinkDeposit}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Params.linking_enabled":
+		panic(fmt.Errorf("field linking_enabled of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.max_link_packets":
+		panic(fmt.Errorf("field max_link_packets of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.max_open_links_per_account":
+		panic(fmt.Errorf("field max_open_links_per_account of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.link_expiry_blocks":
+		panic(fmt.Errorf("field link_expiry_blocks of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.expired_deposit_action":
+		panic(fmt.Errorf("field expired_deposit_action of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.rate_limit_window_blocks":
+		panic(fmt.Errorf("field rate_limit_window_blocks of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.max_links_per_account_window":
+		panic(fmt.Errorf("field max_links_per_account_window of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.max_linked_packets_per_channel_window":
+		panic(fmt.Errorf("field max_linked_packets_per_channel_window of message srdtrk.linkedpackets.v1.Params is not mutable"))
+	case "srdtrk.linkedpackets.v1.Params.resolved_link_retention_blocks":
+		panic(fmt.Errorf("field resolved_link_retention_blocks of message srdtrk.linkedpackets.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Params"))
@@ -189,6 +516,30 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Params.linking_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.Params.max_link_packets":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Params.max_open_links_per_account":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Params.link_expiry_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Params.allowed_packet_data_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Params.link_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Params.expired_deposit_action":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.Params.rate_limit_window_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Params.max_links_per_account_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Params.max_linked_packets_per_channel_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Params.resolved_link_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Params"))
@@ -258,6 +609,45 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.LinkingEnabled {
+			n += 2
+		}
+		if x.MaxLinkPackets != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLinkPackets))
+		}
+		if x.MaxOpenLinksPerAccount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOpenLinksPerAccount))
+		}
+		if x.LinkExpiryBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkExpiryBlocks))
+		}
+		if len(x.AllowedPacketDataTypes) > 0 {
+			for _, s := range x.AllowedPacketDataTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LinkDeposit) > 0 {
+			for _, e := range x.LinkDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpiredDepositAction != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiredDepositAction))
+		}
+		if x.RateLimitWindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.RateLimitWindowBlocks))
+		}
+		if x.MaxLinksPerAccountWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLinksPerAccountWindow))
+		}
+		if x.MaxLinkedPacketsPerChannelWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLinkedPacketsPerChannelWindow))
+		}
+		if x.ResolvedLinkRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolvedLinkRetentionBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -287,6 +677,81 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ResolvedLinkRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolvedLinkRetentionBlocks))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxLinkedPacketsPerChannelWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLinkedPacketsPerChannelWindow))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxLinksPerAccountWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLinksPerAccountWindow))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RateLimitWindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RateLimitWindowBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ExpiredDepositAction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiredDepositAction))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.LinkDeposit) > 0 {
+			for iNdEx := len(x.LinkDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LinkDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.AllowedPacketDataTypes) > 0 {
+			for iNdEx := len(x.AllowedPacketDataTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedPacketDataTypes[iNdEx])
+				copy(dAtA[i:], x.AllowedPacketDataTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedPacketDataTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.LinkExpiryBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkExpiryBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxOpenLinksPerAccount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOpenLinksPerAccount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxLinkPackets != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLinkPackets))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LinkingEnabled {
+			i--
+			if x.LinkingEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -336,66 +801,304 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkingEnabled", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.LinkingEnabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLinkPackets", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MaxLinkPackets = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLinkPackets |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Metadata                        protoreflect.MessageDescriptor
-	fd_Metadata_linked_packets_version protoreflect.FieldDescriptor
-	fd_Metadata_app_version            protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_srdtrk_linkedpackets_v1_types_proto_init()
-	md_Metadata = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("Metadata")
-	fd_Metadata_linked_packets_version = md_Metadata.Fields().ByName("linked_packets_version")
-	fd_Metadata_app_version = md_Metadata.Fields().ByName("app_version")
-}
-
-var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
-
-type fastReflection_Metadata Metadata
-
-func (x *Metadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Metadata)(x)
-}
-
-func (x *Metadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOpenLinksPerAccount", wireType)
+				}
+				x.MaxOpenLinksPerAccount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOpenLinksPerAccount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkExpiryBlocks", wireType)
+				}
+				x.LinkExpiryBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkExpiryBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedPacketDataTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedPacketDataTypes = append(x.AllowedPacketDataTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkDeposit = append(x.LinkDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LinkDeposit[len(x.LinkDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiredDepositAction", wireType)
+				}
+				x.ExpiredDepositAction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiredDepositAction |= ExpiredDepositAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindowBlocks", wireType)
+				}
+				x.RateLimitWindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RateLimitWindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLinksPerAccountWindow", wireType)
+				}
+				x.MaxLinksPerAccountWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLinksPerAccountWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLinkedPacketsPerChannelWindow", wireType)
+				}
+				x.MaxLinkedPacketsPerChannelWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLinkedPacketsPerChannelWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolvedLinkRetentionBlocks", wireType)
+				}
+				x.ResolvedLinkRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolvedLinkRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Metadata                        protoreflect.MessageDescriptor
+	fd_Metadata_linked_packets_version protoreflect.FieldDescriptor
+	fd_Metadata_app_version            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_Metadata = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("Metadata")
+	fd_Metadata_linked_packets_version = md_Metadata.Fields().ByName("linked_packets_version")
+	fd_Metadata_app_version = md_Metadata.Fields().ByName("app_version")
+}
+
+var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+
+type fastReflection_Metadata Metadata
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Metadata)(x)
+}
+
+func (x *Metadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -855,619 +1558,698 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_Counter         protoreflect.MessageDescriptor
-	fd_Counter_count   protoreflect.FieldDescriptor
-	fd_Counter_address protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_srdtrk_linkedpackets_v1_types_proto_init()
-	md_Counter = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("Counter")
-	fd_Counter_count = md_Counter.Fields().ByName("count")
-	fd_Counter_address = md_Counter.Fields().ByName("address")
-}
-
-var _ protoreflect.Message = (*fastReflection_Counter)(nil)
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
 
-type fastReflection_Counter Counter
-
-func (x *Counter) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Counter)(x)
+type _GenesisState_3_list struct {
+	list *[]*ChannelIdentifier
 }
 
-func (x *Counter) slowProtoReflect() protoreflect.Message {
-	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return mi.MessageOf(x)
+	return len(*x.list)
 }
 
-var _fastReflection_Counter_messageType fastReflection_Counter_messageType
-var _ protoreflect.MessageType = fastReflection_Counter_messageType{}
-
-type fastReflection_Counter_messageType struct{}
-
-func (x fastReflection_Counter_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Counter)(nil)
-}
-func (x fastReflection_Counter_messageType) New() protoreflect.Message {
-	return new(fastReflection_Counter)
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
-func (x fastReflection_Counter_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Counter
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Counter) Descriptor() protoreflect.MessageDescriptor {
-	return md_Counter
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Counter) Type() protoreflect.MessageType {
-	return _fastReflection_Counter_messageType
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Counter) New() protoreflect.Message {
-	return new(fastReflection_Counter)
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Counter) Interface() protoreflect.ProtoMessage {
-	return (*Counter)(x)
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Counter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Count != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Count)
-		if !f(fd_Counter_count, value) {
-			return
-		}
-	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Counter_address, value) {
-			return
-		}
-	}
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Counter) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.Counter.count":
-		return x.Count != uint64(0)
-	case "srdtrk.linkedpackets.v1.Counter.address":
-		return x.Address != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Counter"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Counter does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*Link
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Counter) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.Counter.count":
-		x.Count = uint64(0)
-	case "srdtrk.linkedpackets.v1.Counter.address":
-		x.Address = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Counter"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Counter does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Counter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "srdtrk.linkedpackets.v1.Counter.count":
-		value := x.Count
-		return protoreflect.ValueOfUint64(value)
-	case "srdtrk.linkedpackets.v1.Counter.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Counter"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Counter does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Link)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Link)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(Link)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Counter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.Counter.count":
-		x.Count = value.Uint()
-	case "srdtrk.linkedpackets.v1.Counter.address":
-		x.Address = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Counter"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Counter does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(Link)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*PacketLink
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Counter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.Counter.count":
-		panic(fmt.Errorf("field count of message srdtrk.linkedpackets.v1.Counter is not mutable"))
-	case "srdtrk.linkedpackets.v1.Counter.address":
-		panic(fmt.Errorf("field address of message srdtrk.linkedpackets.v1.Counter is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Counter"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Counter does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketLink)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketLink)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(PacketLink)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Counter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.Counter.count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "srdtrk.linkedpackets.v1.Counter.address":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Counter"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Counter does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(PacketLink)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*LinkSession
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Counter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.Counter", d.FullName()))
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkSession)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkSession)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(LinkSession)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Counter) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(LinkSession)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Counter) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_Counter) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*ForwardedLinkPacket
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_Counter) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Counter)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Count != 0 {
-			n += 1 + runtime.Sov(uint64(x.Count))
-		}
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Counter)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Count != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Counter)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Counter: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-				}
-				x.Count = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Count |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardedLinkPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardedLinkPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(ForwardedLinkPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(ForwardedLinkPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-var _ protoreflect.List = (*_GenesisState_1_list)(nil)
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
 
-type _GenesisState_1_list struct {
-	list *[]*Counter
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*BufferedLink
 }
 
-func (x *_GenesisState_1_list) Len() int {
+func (x *_GenesisState_12_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_GenesisState_1_list) Get(i int) protoreflect.Value {
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) Set(i int, value protoreflect.Value) {
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Counter)
+	concreteValue := valueUnwrapped.Interface().(*BufferedLink)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_GenesisState_1_list) Append(value protoreflect.Value) {
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Counter)
+	concreteValue := valueUnwrapped.Interface().(*BufferedLink)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_GenesisState_1_list) AppendMutable() protoreflect.Value {
-	v := new(Counter)
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(BufferedLink)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) Truncate(n int) {
+func (x *_GenesisState_12_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_GenesisState_1_list) NewElement() protoreflect.Value {
-	v := new(Counter)
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(BufferedLink)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) IsValid() bool {
+func (x *_GenesisState_12_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState          protoreflect.MessageDescriptor
-	fd_GenesisState_counters protoreflect.FieldDescriptor
-	fd_GenesisState_params   protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
 
-func init() {
-	file_srdtrk_linkedpackets_v1_types_proto_init()
-	md_GenesisState = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_counters = md_GenesisState.Fields().ByName("counters")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+type _GenesisState_14_list struct {
+	list *[]*ChannelIdentifier
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*AccountRateLimit
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountRateLimit)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountRateLimit)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Counters) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.Counters})
-		if !f(fd_GenesisState_counters, value) {
-			return
-		}
-	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(AccountRateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(AccountRateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*ChannelRateLimit
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelRateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelRateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelRateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(ChannelRateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*ReceivedLinkPacket
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReceivedLinkPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReceivedLinkPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(ReceivedLinkPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(ReceivedLinkPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_link_enabled_channels  protoreflect.FieldDescriptor
+	fd_GenesisState_links                  protoreflect.FieldDescriptor
+	fd_GenesisState_packet_links           protoreflect.FieldDescriptor
+	fd_GenesisState_link_sequence          protoreflect.FieldDescriptor
+	fd_GenesisState_sessions               protoreflect.FieldDescriptor
+	fd_GenesisState_forwarded_link_packets protoreflect.FieldDescriptor
+	fd_GenesisState_buffered_links         protoreflect.FieldDescriptor
+	fd_GenesisState_linking_paused         protoreflect.FieldDescriptor
+	fd_GenesisState_paused_channels        protoreflect.FieldDescriptor
+	fd_GenesisState_account_rate_limits    protoreflect.FieldDescriptor
+	fd_GenesisState_channel_rate_limits    protoreflect.FieldDescriptor
+	fd_GenesisState_received_link_packets  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_GenesisState = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_link_enabled_channels = md_GenesisState.Fields().ByName("link_enabled_channels")
+	fd_GenesisState_links = md_GenesisState.Fields().ByName("links")
+	fd_GenesisState_packet_links = md_GenesisState.Fields().ByName("packet_links")
+	fd_GenesisState_link_sequence = md_GenesisState.Fields().ByName("link_sequence")
+	fd_GenesisState_sessions = md_GenesisState.Fields().ByName("sessions")
+	fd_GenesisState_forwarded_link_packets = md_GenesisState.Fields().ByName("forwarded_link_packets")
+	fd_GenesisState_buffered_links = md_GenesisState.Fields().ByName("buffered_links")
+	fd_GenesisState_linking_paused = md_GenesisState.Fields().ByName("linking_paused")
+	fd_GenesisState_paused_channels = md_GenesisState.Fields().ByName("paused_channels")
+	fd_GenesisState_account_rate_limits = md_GenesisState.Fields().ByName("account_rate_limits")
+	fd_GenesisState_channel_rate_limits = md_GenesisState.Fields().ByName("channel_rate_limits")
+	fd_GenesisState_received_link_packets = md_GenesisState.Fields().ByName("received_link_packets")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.LinkEnabledChannels) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.LinkEnabledChannels})
+		if !f(fd_GenesisState_link_enabled_channels, value) {
+			return
+		}
+	}
+	if len(x.Links) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Links})
+		if !f(fd_GenesisState_links, value) {
+			return
+		}
+	}
+	if len(x.PacketLinks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PacketLinks})
+		if !f(fd_GenesisState_packet_links, value) {
+			return
+		}
+	}
+	if x.LinkSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkSequence)
+		if !f(fd_GenesisState_link_sequence, value) {
+			return
+		}
+	}
+	if len(x.Sessions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.Sessions})
+		if !f(fd_GenesisState_sessions, value) {
+			return
+		}
+	}
+	if len(x.ForwardedLinkPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ForwardedLinkPackets})
+		if !f(fd_GenesisState_forwarded_link_packets, value) {
+			return
+		}
+	}
+	if len(x.BufferedLinks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.BufferedLinks})
+		if !f(fd_GenesisState_buffered_links, value) {
+			return
+		}
+	}
+	if x.LinkingPaused != false {
+		value := protoreflect.ValueOfBool(x.LinkingPaused)
+		if !f(fd_GenesisState_linking_paused, value) {
+			return
+		}
+	}
+	if len(x.PausedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.PausedChannels})
+		if !f(fd_GenesisState_paused_channels, value) {
+			return
+		}
+	}
+	if len(x.AccountRateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.AccountRateLimits})
+		if !f(fd_GenesisState_account_rate_limits, value) {
+			return
+		}
+	}
+	if len(x.ChannelRateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.ChannelRateLimits})
+		if !f(fd_GenesisState_channel_rate_limits, value) {
+			return
+		}
+	}
+	if len(x.ReceivedLinkPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.ReceivedLinkPackets})
+		if !f(fd_GenesisState_received_link_packets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
 // Some fields have the property of nullability where it is possible to
 // distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
@@ -1479,10 +2261,32 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.GenesisState.counters":
-		return len(x.Counters) != 0
 	case "srdtrk.linkedpackets.v1.GenesisState.params":
 		return x.Params != nil
+	case "srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels":
+		return len(x.LinkEnabledChannels) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.links":
+		return len(x.Links) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.packet_links":
+		return len(x.PacketLinks) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.link_sequence":
+		return x.LinkSequence != uint64(0)
+	case "srdtrk.linkedpackets.v1.GenesisState.sessions":
+		return len(x.Sessions) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets":
+		return len(x.ForwardedLinkPackets) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.buffered_links":
+		return len(x.BufferedLinks) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.linking_paused":
+		return x.LinkingPaused != false
+	case "srdtrk.linkedpackets.v1.GenesisState.paused_channels":
+		return len(x.PausedChannels) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.account_rate_limits":
+		return len(x.AccountRateLimits) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits":
+		return len(x.ChannelRateLimits) != 0
+	case "srdtrk.linkedpackets.v1.GenesisState.received_link_packets":
+		return len(x.ReceivedLinkPackets) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
@@ -1499,10 +2303,32 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.GenesisState.counters":
-		x.Counters = nil
 	case "srdtrk.linkedpackets.v1.GenesisState.params":
 		x.Params = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels":
+		x.LinkEnabledChannels = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.links":
+		x.Links = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.packet_links":
+		x.PacketLinks = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.link_sequence":
+		x.LinkSequence = uint64(0)
+	case "srdtrk.linkedpackets.v1.GenesisState.sessions":
+		x.Sessions = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets":
+		x.ForwardedLinkPackets = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.buffered_links":
+		x.BufferedLinks = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.linking_paused":
+		x.LinkingPaused = false
+	case "srdtrk.linkedpackets.v1.GenesisState.paused_channels":
+		x.PausedChannels = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.account_rate_limits":
+		x.AccountRateLimits = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits":
+		x.ChannelRateLimits = nil
+	case "srdtrk.linkedpackets.v1.GenesisState.received_link_packets":
+		x.ReceivedLinkPackets = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
@@ -1519,15 +2345,75 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "srdtrk.linkedpackets.v1.GenesisState.counters":
-		if len(x.Counters) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_1_list{})
-		}
-		listValue := &_GenesisState_1_list{list: &x.Counters}
-		return protoreflect.ValueOfList(listValue)
 	case "srdtrk.linkedpackets.v1.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels":
+		if len(x.LinkEnabledChannels) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.LinkEnabledChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.links":
+		if len(x.Links) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Links}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.packet_links":
+		if len(x.PacketLinks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.PacketLinks}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.link_sequence":
+		value := x.LinkSequence
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.sessions":
+		if len(x.Sessions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.Sessions}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets":
+		if len(x.ForwardedLinkPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.ForwardedLinkPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.buffered_links":
+		if len(x.BufferedLinks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.BufferedLinks}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.linking_paused":
+		value := x.LinkingPaused
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.paused_channels":
+		if len(x.PausedChannels) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.PausedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.account_rate_limits":
+		if len(x.AccountRateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.AccountRateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits":
+		if len(x.ChannelRateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.ChannelRateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.GenesisState.received_link_packets":
+		if len(x.ReceivedLinkPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.ReceivedLinkPackets}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
@@ -1548,12 +2434,52 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.GenesisState.counters":
-		lv := value.List()
-		clv := lv.(*_GenesisState_1_list)
-		x.Counters = *clv.list
 	case "srdtrk.linkedpackets.v1.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.LinkEnabledChannels = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.links":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Links = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.packet_links":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.PacketLinks = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.link_sequence":
+		x.LinkSequence = value.Uint()
+	case "srdtrk.linkedpackets.v1.GenesisState.sessions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.Sessions = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.ForwardedLinkPackets = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.buffered_links":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.BufferedLinks = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.linking_paused":
+		x.LinkingPaused = value.Bool()
+	case "srdtrk.linkedpackets.v1.GenesisState.paused_channels":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.PausedChannels = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.account_rate_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.AccountRateLimits = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.ChannelRateLimits = *clv.list
+	case "srdtrk.linkedpackets.v1.GenesisState.received_link_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.ReceivedLinkPackets = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
@@ -1574,37 +2500,126 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.GenesisState.counters":
-		if x.Counters == nil {
-			x.Counters = []*Counter{}
-		}
-		value := &_GenesisState_1_list{list: &x.Counters}
-		return protoreflect.ValueOfList(value)
 	case "srdtrk.linkedpackets.v1.GenesisState.params":
 		if x.Params == nil {
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
+	case "srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels":
+		if x.LinkEnabledChannels == nil {
+			x.LinkEnabledChannels = []*ChannelIdentifier{}
 		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.GenesisState.counters":
-		list := []*Counter{}
-		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
-	case "srdtrk.linkedpackets.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
+		value := &_GenesisState_3_list{list: &x.LinkEnabledChannels}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.links":
+		if x.Links == nil {
+			x.Links = []*Link{}
+		}
+		value := &_GenesisState_6_list{list: &x.Links}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.packet_links":
+		if x.PacketLinks == nil {
+			x.PacketLinks = []*PacketLink{}
+		}
+		value := &_GenesisState_7_list{list: &x.PacketLinks}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.sessions":
+		if x.Sessions == nil {
+			x.Sessions = []*LinkSession{}
+		}
+		value := &_GenesisState_10_list{list: &x.Sessions}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets":
+		if x.ForwardedLinkPackets == nil {
+			x.ForwardedLinkPackets = []*ForwardedLinkPacket{}
+		}
+		value := &_GenesisState_11_list{list: &x.ForwardedLinkPackets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.buffered_links":
+		if x.BufferedLinks == nil {
+			x.BufferedLinks = []*BufferedLink{}
+		}
+		value := &_GenesisState_12_list{list: &x.BufferedLinks}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.paused_channels":
+		if x.PausedChannels == nil {
+			x.PausedChannels = []*ChannelIdentifier{}
+		}
+		value := &_GenesisState_14_list{list: &x.PausedChannels}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.account_rate_limits":
+		if x.AccountRateLimits == nil {
+			x.AccountRateLimits = []*AccountRateLimit{}
+		}
+		value := &_GenesisState_15_list{list: &x.AccountRateLimits}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits":
+		if x.ChannelRateLimits == nil {
+			x.ChannelRateLimits = []*ChannelRateLimit{}
+		}
+		value := &_GenesisState_16_list{list: &x.ChannelRateLimits}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.received_link_packets":
+		if x.ReceivedLinkPackets == nil {
+			x.ReceivedLinkPackets = []*ReceivedLinkPacket{}
+		}
+		value := &_GenesisState_17_list{list: &x.ReceivedLinkPackets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.GenesisState.link_sequence":
+		panic(fmt.Errorf("field link_sequence of message srdtrk.linkedpackets.v1.GenesisState is not mutable"))
+	case "srdtrk.linkedpackets.v1.GenesisState.linking_paused":
+		panic(fmt.Errorf("field linking_paused of message srdtrk.linkedpackets.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.links":
+		list := []*Link{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.packet_links":
+		list := []*PacketLink{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.link_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.GenesisState.sessions":
+		list := []*LinkSession{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets":
+		list := []*ForwardedLinkPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.buffered_links":
+		list := []*BufferedLink{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.linking_paused":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.GenesisState.paused_channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.account_rate_limits":
+		list := []*AccountRateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits":
+		list := []*ChannelRateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "srdtrk.linkedpackets.v1.GenesisState.received_link_packets":
+		list := []*ReceivedLinkPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.GenesisState"))
 		}
@@ -1673,15 +2688,75 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Counters) > 0 {
-			for _, e := range x.Counters {
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LinkEnabledChannels) > 0 {
+			for _, e := range x.LinkEnabledChannels {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Links) > 0 {
+			for _, e := range x.Links {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PacketLinks) > 0 {
+			for _, e := range x.PacketLinks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LinkSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkSequence))
+		}
+		if len(x.Sessions) > 0 {
+			for _, e := range x.Sessions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ForwardedLinkPackets) > 0 {
+			for _, e := range x.ForwardedLinkPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BufferedLinks) > 0 {
+			for _, e := range x.BufferedLinks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LinkingPaused {
+			n += 2
+		}
+		if len(x.PausedChannels) > 0 {
+			for _, e := range x.PausedChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AccountRateLimits) > 0 {
+			for _, e := range x.AccountRateLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChannelRateLimits) > 0 {
+			for _, e := range x.ChannelRateLimits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReceivedLinkPackets) > 0 {
+			for _, e := range x.ReceivedLinkPackets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1712,23 +2787,87 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.ReceivedLinkPackets) > 0 {
+			for iNdEx := len(x.ReceivedLinkPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReceivedLinkPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.ChannelRateLimits) > 0 {
+			for iNdEx := len(x.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChannelRateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.AccountRateLimits) > 0 {
+			for iNdEx := len(x.AccountRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccountRateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.PausedChannels) > 0 {
+			for iNdEx := len(x.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PausedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.LinkingPaused {
 			i--
-			dAtA[i] = 0x12
+			if x.LinkingPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
 		}
-		if len(x.Counters) > 0 {
-			for iNdEx := len(x.Counters) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Counters[iNdEx])
+		if len(x.BufferedLinks) > 0 {
+			for iNdEx := len(x.BufferedLinks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BufferedLinks[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1739,9 +2878,108 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ForwardedLinkPackets) > 0 {
+			for iNdEx := len(x.ForwardedLinkPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForwardedLinkPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Sessions) > 0 {
+			for iNdEx := len(x.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Sessions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.LinkSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkSequence))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.PacketLinks) > 0 {
+			for iNdEx := len(x.PacketLinks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PacketLinks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Links) > 0 {
+			for iNdEx := len(x.Links) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Links[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
 			}
 		}
+		if len(x.LinkEnabledChannels) > 0 {
+			for iNdEx := len(x.LinkEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LinkEnabledChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1791,9 +3029,9 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1820,14 +3058,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Counters = append(x.Counters, &Counter{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Counters[len(x.Counters)-1]); err != nil {
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkEnabledChannels", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1854,824 +3094,12943 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.LinkEnabledChannels = append(x.LinkEnabledChannels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LinkEnabledChannels[len(x.LinkEnabledChannels)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Links = append(x.Links, &Link{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Links[len(x.Links)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketLinks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PacketLinks = append(x.PacketLinks, &PacketLink{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PacketLinks[len(x.PacketLinks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkSequence", wireType)
+				}
+				x.LinkSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sessions = append(x.Sessions, &LinkSession{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sessions[len(x.Sessions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardedLinkPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForwardedLinkPackets = append(x.ForwardedLinkPackets, &ForwardedLinkPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForwardedLinkPackets[len(x.ForwardedLinkPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BufferedLinks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BufferedLinks = append(x.BufferedLinks, &BufferedLink{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BufferedLinks[len(x.BufferedLinks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkingPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LinkingPaused = bool(v != 0)
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PausedChannels = append(x.PausedChannels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedChannels[len(x.PausedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountRateLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountRateLimits = append(x.AccountRateLimits, &AccountRateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccountRateLimits[len(x.AccountRateLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelRateLimits = append(x.ChannelRateLimits, &ChannelRateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelRateLimits[len(x.ChannelRateLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedLinkPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceivedLinkPackets = append(x.ReceivedLinkPackets, &ReceivedLinkPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceivedLinkPackets[len(x.ReceivedLinkPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RateLimitUsage        protoreflect.MessageDescriptor
+	fd_RateLimitUsage_window protoreflect.FieldDescriptor
+	fd_RateLimitUsage_used   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_RateLimitUsage = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("RateLimitUsage")
+	fd_RateLimitUsage_window = md_RateLimitUsage.Fields().ByName("window")
+	fd_RateLimitUsage_used = md_RateLimitUsage.Fields().ByName("used")
+}
+
+var _ protoreflect.Message = (*fastReflection_RateLimitUsage)(nil)
+
+type fastReflection_RateLimitUsage RateLimitUsage
+
+func (x *RateLimitUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RateLimitUsage)(x)
+}
+
+func (x *RateLimitUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RateLimitUsage_messageType fastReflection_RateLimitUsage_messageType
+var _ protoreflect.MessageType = fastReflection_RateLimitUsage_messageType{}
+
+type fastReflection_RateLimitUsage_messageType struct{}
+
+func (x fastReflection_RateLimitUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RateLimitUsage)(nil)
+}
+func (x fastReflection_RateLimitUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_RateLimitUsage)
+}
+func (x fastReflection_RateLimitUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RateLimitUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RateLimitUsage) Type() protoreflect.MessageType {
+	return _fastReflection_RateLimitUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RateLimitUsage) New() protoreflect.Message {
+	return new(fastReflection_RateLimitUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RateLimitUsage) Interface() protoreflect.ProtoMessage {
+	return (*RateLimitUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RateLimitUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_RateLimitUsage_window, value) {
+			return
+		}
+	}
+	if x.Used != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Used)
+		if !f(fd_RateLimitUsage_used, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RateLimitUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.window":
+		return x.Window != uint64(0)
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.used":
+		return x.Used != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitUsage"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.window":
+		x.Window = uint64(0)
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.used":
+		x.Used = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitUsage"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RateLimitUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.used":
+		value := x.Used
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitUsage"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.window":
+		x.Window = value.Uint()
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.used":
+		x.Used = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitUsage"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.window":
+		panic(fmt.Errorf("field window of message srdtrk.linkedpackets.v1.RateLimitUsage is not mutable"))
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.used":
+		panic(fmt.Errorf("field used of message srdtrk.linkedpackets.v1.RateLimitUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitUsage"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RateLimitUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.RateLimitUsage.used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitUsage"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RateLimitUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.RateLimitUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RateLimitUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RateLimitUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RateLimitUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RateLimitUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.Used != 0 {
+			n += 1 + runtime.Sov(uint64(x.Used))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimitUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Used != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Used))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimitUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+				}
+				x.Used = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Used |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AccountRateLimit         protoreflect.MessageDescriptor
+	fd_AccountRateLimit_account protoreflect.FieldDescriptor
+	fd_AccountRateLimit_usage   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_AccountRateLimit = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("AccountRateLimit")
+	fd_AccountRateLimit_account = md_AccountRateLimit.Fields().ByName("account")
+	fd_AccountRateLimit_usage = md_AccountRateLimit.Fields().ByName("usage")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccountRateLimit)(nil)
+
+type fastReflection_AccountRateLimit AccountRateLimit
+
+func (x *AccountRateLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccountRateLimit)(x)
+}
+
+func (x *AccountRateLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccountRateLimit_messageType fastReflection_AccountRateLimit_messageType
+var _ protoreflect.MessageType = fastReflection_AccountRateLimit_messageType{}
+
+type fastReflection_AccountRateLimit_messageType struct{}
+
+func (x fastReflection_AccountRateLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccountRateLimit)(nil)
+}
+func (x fastReflection_AccountRateLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccountRateLimit)
+}
+func (x fastReflection_AccountRateLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountRateLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccountRateLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountRateLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccountRateLimit) Type() protoreflect.MessageType {
+	return _fastReflection_AccountRateLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccountRateLimit) New() protoreflect.Message {
+	return new(fastReflection_AccountRateLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccountRateLimit) Interface() protoreflect.ProtoMessage {
+	return (*AccountRateLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccountRateLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Account != "" {
+		value := protoreflect.ValueOfString(x.Account)
+		if !f(fd_AccountRateLimit_account, value) {
+			return
+		}
+	}
+	if x.Usage != nil {
+		value := protoreflect.ValueOfMessage(x.Usage.ProtoReflect())
+		if !f(fd_AccountRateLimit_usage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccountRateLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.account":
+		return x.Account != ""
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.usage":
+		return x.Usage != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.AccountRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.AccountRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountRateLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.account":
+		x.Account = ""
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.usage":
+		x.Usage = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.AccountRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.AccountRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccountRateLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.account":
+		value := x.Account
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.usage":
+		value := x.Usage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.AccountRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.AccountRateLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountRateLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.account":
+		x.Account = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.usage":
+		x.Usage = value.Message().Interface().(*RateLimitUsage)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.AccountRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.AccountRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountRateLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.usage":
+		if x.Usage == nil {
+			x.Usage = new(RateLimitUsage)
+		}
+		return protoreflect.ValueOfMessage(x.Usage.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.account":
+		panic(fmt.Errorf("field account of message srdtrk.linkedpackets.v1.AccountRateLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.AccountRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.AccountRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccountRateLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.account":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.AccountRateLimit.usage":
+		m := new(RateLimitUsage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.AccountRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.AccountRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccountRateLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.AccountRateLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccountRateLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountRateLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccountRateLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccountRateLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccountRateLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Account)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Usage != nil {
+			l = options.Size(x.Usage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccountRateLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Usage != nil {
+			encoded, err := options.Marshal(x.Usage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Account) > 0 {
+			i -= len(x.Account)
+			copy(dAtA[i:], x.Account)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccountRateLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountRateLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Account = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Usage == nil {
+					x.Usage = &RateLimitUsage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Usage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChannelRateLimit         protoreflect.MessageDescriptor
+	fd_ChannelRateLimit_channel protoreflect.FieldDescriptor
+	fd_ChannelRateLimit_usage   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_ChannelRateLimit = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("ChannelRateLimit")
+	fd_ChannelRateLimit_channel = md_ChannelRateLimit.Fields().ByName("channel")
+	fd_ChannelRateLimit_usage = md_ChannelRateLimit.Fields().ByName("usage")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChannelRateLimit)(nil)
+
+type fastReflection_ChannelRateLimit ChannelRateLimit
+
+func (x *ChannelRateLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChannelRateLimit)(x)
+}
+
+func (x *ChannelRateLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChannelRateLimit_messageType fastReflection_ChannelRateLimit_messageType
+var _ protoreflect.MessageType = fastReflection_ChannelRateLimit_messageType{}
+
+type fastReflection_ChannelRateLimit_messageType struct{}
+
+func (x fastReflection_ChannelRateLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChannelRateLimit)(nil)
+}
+func (x fastReflection_ChannelRateLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChannelRateLimit)
+}
+func (x fastReflection_ChannelRateLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChannelRateLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChannelRateLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChannelRateLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChannelRateLimit) Type() protoreflect.MessageType {
+	return _fastReflection_ChannelRateLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChannelRateLimit) New() protoreflect.Message {
+	return new(fastReflection_ChannelRateLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChannelRateLimit) Interface() protoreflect.ProtoMessage {
+	return (*ChannelRateLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChannelRateLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != nil {
+		value := protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+		if !f(fd_ChannelRateLimit_channel, value) {
+			return
+		}
+	}
+	if x.Usage != nil {
+		value := protoreflect.ValueOfMessage(x.Usage.ProtoReflect())
+		if !f(fd_ChannelRateLimit_usage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChannelRateLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.channel":
+		return x.Channel != nil
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.usage":
+		return x.Usage != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelRateLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.channel":
+		x.Channel = nil
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.usage":
+		x.Usage = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChannelRateLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.channel":
+		value := x.Channel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.usage":
+		value := x.Usage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelRateLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelRateLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.channel":
+		x.Channel = value.Message().Interface().(*ChannelIdentifier)
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.usage":
+		x.Usage = value.Message().Interface().(*RateLimitUsage)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelRateLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.channel":
+		if x.Channel == nil {
+			x.Channel = new(ChannelIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.usage":
+		if x.Usage == nil {
+			x.Usage = new(RateLimitUsage)
+		}
+		return protoreflect.ValueOfMessage(x.Usage.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChannelRateLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.channel":
+		m := new(ChannelIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ChannelRateLimit.usage":
+		m := new(RateLimitUsage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelRateLimit"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChannelRateLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.ChannelRateLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChannelRateLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelRateLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChannelRateLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChannelRateLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChannelRateLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Channel != nil {
+			l = options.Size(x.Channel)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Usage != nil {
+			l = options.Size(x.Usage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChannelRateLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Usage != nil {
+			encoded, err := options.Marshal(x.Usage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Channel != nil {
+			encoded, err := options.Marshal(x.Channel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChannelRateLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChannelRateLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChannelRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Channel == nil {
+					x.Channel = &ChannelIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Channel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Usage == nil {
+					x.Usage = &RateLimitUsage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Usage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RateLimitQuota                   protoreflect.MessageDescriptor
+	fd_RateLimitQuota_limit             protoreflect.FieldDescriptor
+	fd_RateLimitQuota_remaining         protoreflect.FieldDescriptor
+	fd_RateLimitQuota_window_end_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_RateLimitQuota = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("RateLimitQuota")
+	fd_RateLimitQuota_limit = md_RateLimitQuota.Fields().ByName("limit")
+	fd_RateLimitQuota_remaining = md_RateLimitQuota.Fields().ByName("remaining")
+	fd_RateLimitQuota_window_end_height = md_RateLimitQuota.Fields().ByName("window_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_RateLimitQuota)(nil)
+
+type fastReflection_RateLimitQuota RateLimitQuota
+
+func (x *RateLimitQuota) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RateLimitQuota)(x)
+}
+
+func (x *RateLimitQuota) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RateLimitQuota_messageType fastReflection_RateLimitQuota_messageType
+var _ protoreflect.MessageType = fastReflection_RateLimitQuota_messageType{}
+
+type fastReflection_RateLimitQuota_messageType struct{}
+
+func (x fastReflection_RateLimitQuota_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RateLimitQuota)(nil)
+}
+func (x fastReflection_RateLimitQuota_messageType) New() protoreflect.Message {
+	return new(fastReflection_RateLimitQuota)
+}
+func (x fastReflection_RateLimitQuota_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitQuota
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RateLimitQuota) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitQuota
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RateLimitQuota) Type() protoreflect.MessageType {
+	return _fastReflection_RateLimitQuota_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RateLimitQuota) New() protoreflect.Message {
+	return new(fastReflection_RateLimitQuota)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RateLimitQuota) Interface() protoreflect.ProtoMessage {
+	return (*RateLimitQuota)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RateLimitQuota) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_RateLimitQuota_limit, value) {
+			return
+		}
+	}
+	if x.Remaining != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Remaining)
+		if !f(fd_RateLimitQuota_remaining, value) {
+			return
+		}
+	}
+	if x.WindowEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowEndHeight)
+		if !f(fd_RateLimitQuota_window_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RateLimitQuota) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.limit":
+		return x.Limit != uint64(0)
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.remaining":
+		return x.Remaining != uint64(0)
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.window_end_height":
+		return x.WindowEndHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitQuota"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitQuota) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.limit":
+		x.Limit = uint64(0)
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.remaining":
+		x.Remaining = uint64(0)
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.window_end_height":
+		x.WindowEndHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitQuota"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RateLimitQuota) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.remaining":
+		value := x.Remaining
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.window_end_height":
+		value := x.WindowEndHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitQuota"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitQuota does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitQuota) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.limit":
+		x.Limit = value.Uint()
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.remaining":
+		x.Remaining = value.Uint()
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.window_end_height":
+		x.WindowEndHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitQuota"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitQuota) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.limit":
+		panic(fmt.Errorf("field limit of message srdtrk.linkedpackets.v1.RateLimitQuota is not mutable"))
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.remaining":
+		panic(fmt.Errorf("field remaining of message srdtrk.linkedpackets.v1.RateLimitQuota is not mutable"))
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.window_end_height":
+		panic(fmt.Errorf("field window_end_height of message srdtrk.linkedpackets.v1.RateLimitQuota is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitQuota"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RateLimitQuota) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.remaining":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.RateLimitQuota.window_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.RateLimitQuota"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.RateLimitQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RateLimitQuota) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.RateLimitQuota", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RateLimitQuota) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RateLimitQuota) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RateLimitQuota) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RateLimitQuota) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RateLimitQuota)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.Remaining != 0 {
+			n += 1 + runtime.Sov(uint64(x.Remaining))
+		}
+		if x.WindowEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimitQuota)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.WindowEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowEndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Remaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Remaining))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RateLimitQuota)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitQuota: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RateLimitQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				x.Remaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Remaining |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+				}
+				x.WindowEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LinkSession             protoreflect.MessageDescriptor
+	fd_LinkSession_link_id     protoreflect.FieldDescriptor
+	fd_LinkSession_prev_packet protoreflect.FieldDescriptor
+	fd_LinkSession_owner       protoreflect.FieldDescriptor
+	fd_LinkSession_link_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_LinkSession = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("LinkSession")
+	fd_LinkSession_link_id = md_LinkSession.Fields().ByName("link_id")
+	fd_LinkSession_prev_packet = md_LinkSession.Fields().ByName("prev_packet")
+	fd_LinkSession_owner = md_LinkSession.Fields().ByName("owner")
+	fd_LinkSession_link_index = md_LinkSession.Fields().ByName("link_index")
+}
+
+var _ protoreflect.Message = (*fastReflection_LinkSession)(nil)
+
+type fastReflection_LinkSession LinkSession
+
+func (x *LinkSession) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinkSession)(x)
+}
+
+func (x *LinkSession) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LinkSession_messageType fastReflection_LinkSession_messageType
+var _ protoreflect.MessageType = fastReflection_LinkSession_messageType{}
+
+type fastReflection_LinkSession_messageType struct{}
+
+func (x fastReflection_LinkSession_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinkSession)(nil)
+}
+func (x fastReflection_LinkSession_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinkSession)
+}
+func (x fastReflection_LinkSession_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkSession
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LinkSession) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkSession
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LinkSession) Type() protoreflect.MessageType {
+	return _fastReflection_LinkSession_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LinkSession) New() protoreflect.Message {
+	return new(fastReflection_LinkSession)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LinkSession) Interface() protoreflect.ProtoMessage {
+	return (*LinkSession)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LinkSession) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_LinkSession_link_id, value) {
+			return
+		}
+	}
+	if x.PrevPacket != nil {
+		value := protoreflect.ValueOfMessage(x.PrevPacket.ProtoReflect())
+		if !f(fd_LinkSession_prev_packet, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_LinkSession_owner, value) {
+			return
+		}
+	}
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_LinkSession_link_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LinkSession) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkSession.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.LinkSession.prev_packet":
+		return x.PrevPacket != nil
+	case "srdtrk.linkedpackets.v1.LinkSession.owner":
+		return x.Owner != ""
+	case "srdtrk.linkedpackets.v1.LinkSession.link_index":
+		return x.LinkIndex != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkSession"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkSession) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkSession.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.LinkSession.prev_packet":
+		x.PrevPacket = nil
+	case "srdtrk.linkedpackets.v1.LinkSession.owner":
+		x.Owner = ""
+	case "srdtrk.linkedpackets.v1.LinkSession.link_index":
+		x.LinkIndex = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkSession"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LinkSession) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkSession.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.LinkSession.prev_packet":
+		value := x.PrevPacket
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.LinkSession.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.LinkSession.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkSession"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkSession does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkSession) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkSession.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.LinkSession.prev_packet":
+		x.PrevPacket = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.LinkSession.owner":
+		x.Owner = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.LinkSession.link_index":
+		x.LinkIndex = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkSession"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkSession) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkSession.prev_packet":
+		if x.PrevPacket == nil {
+			x.PrevPacket = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.PrevPacket.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.LinkSession.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.LinkSession is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkSession.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.LinkSession is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkSession.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.LinkSession is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkSession"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LinkSession) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkSession.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.LinkSession.prev_packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.LinkSession.owner":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.LinkSession.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkSession"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LinkSession) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.LinkSession", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LinkSession) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkSession) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LinkSession) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LinkSession) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LinkSession)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrevPacket != nil {
+			l = options.Size(x.PrevPacket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LinkSession)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PrevPacket != nil {
+			encoded, err := options.Marshal(x.PrevPacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LinkSession)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkSession: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevPacket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrevPacket == nil {
+					x.PrevPacket = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrevPacket); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PacketLink         protoreflect.MessageDescriptor
+	fd_PacketLink_packet  protoreflect.FieldDescriptor
+	fd_PacketLink_link_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_PacketLink = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("PacketLink")
+	fd_PacketLink_packet = md_PacketLink.Fields().ByName("packet")
+	fd_PacketLink_link_id = md_PacketLink.Fields().ByName("link_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_PacketLink)(nil)
+
+type fastReflection_PacketLink PacketLink
+
+func (x *PacketLink) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PacketLink)(x)
+}
+
+func (x *PacketLink) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PacketLink_messageType fastReflection_PacketLink_messageType
+var _ protoreflect.MessageType = fastReflection_PacketLink_messageType{}
+
+type fastReflection_PacketLink_messageType struct{}
+
+func (x fastReflection_PacketLink_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PacketLink)(nil)
+}
+func (x fastReflection_PacketLink_messageType) New() protoreflect.Message {
+	return new(fastReflection_PacketLink)
+}
+func (x fastReflection_PacketLink_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketLink
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PacketLink) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketLink
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PacketLink) Type() protoreflect.MessageType {
+	return _fastReflection_PacketLink_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PacketLink) New() protoreflect.Message {
+	return new(fastReflection_PacketLink)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PacketLink) Interface() protoreflect.ProtoMessage {
+	return (*PacketLink)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PacketLink) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_PacketLink_packet, value) {
+			return
+		}
+	}
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_PacketLink_link_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PacketLink) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketLink.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.PacketLink.link_id":
+		return x.LinkId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketLink) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketLink.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.PacketLink.link_id":
+		x.LinkId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PacketLink) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketLink.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.PacketLink.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketLink does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketLink) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketLink.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.PacketLink.link_id":
+		x.LinkId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketLink.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.PacketLink.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.PacketLink is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PacketLink) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketLink.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.PacketLink.link_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PacketLink) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.PacketLink", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PacketLink) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketLink) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PacketLink) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PacketLink) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PacketLink)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PacketLink)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PacketLink)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketLink: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReceivedLinkPacket          protoreflect.MessageDescriptor
+	fd_ReceivedLinkPacket_channel  protoreflect.FieldDescriptor
+	fd_ReceivedLinkPacket_link_id  protoreflect.FieldDescriptor
+	fd_ReceivedLinkPacket_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_ReceivedLinkPacket = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("ReceivedLinkPacket")
+	fd_ReceivedLinkPacket_channel = md_ReceivedLinkPacket.Fields().ByName("channel")
+	fd_ReceivedLinkPacket_link_id = md_ReceivedLinkPacket.Fields().ByName("link_id")
+	fd_ReceivedLinkPacket_sequence = md_ReceivedLinkPacket.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReceivedLinkPacket)(nil)
+
+type fastReflection_ReceivedLinkPacket ReceivedLinkPacket
+
+func (x *ReceivedLinkPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReceivedLinkPacket)(x)
+}
+
+func (x *ReceivedLinkPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReceivedLinkPacket_messageType fastReflection_ReceivedLinkPacket_messageType
+var _ protoreflect.MessageType = fastReflection_ReceivedLinkPacket_messageType{}
+
+type fastReflection_ReceivedLinkPacket_messageType struct{}
+
+func (x fastReflection_ReceivedLinkPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReceivedLinkPacket)(nil)
+}
+func (x fastReflection_ReceivedLinkPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReceivedLinkPacket)
+}
+func (x fastReflection_ReceivedLinkPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReceivedLinkPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReceivedLinkPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReceivedLinkPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReceivedLinkPacket) Type() protoreflect.MessageType {
+	return _fastReflection_ReceivedLinkPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReceivedLinkPacket) New() protoreflect.Message {
+	return new(fastReflection_ReceivedLinkPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReceivedLinkPacket) Interface() protoreflect.ProtoMessage {
+	return (*ReceivedLinkPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReceivedLinkPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != nil {
+		value := protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+		if !f(fd_ReceivedLinkPacket_channel, value) {
+			return
+		}
+	}
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_ReceivedLinkPacket_link_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_ReceivedLinkPacket_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReceivedLinkPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel":
+		return x.Channel != nil
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ReceivedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ReceivedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceivedLinkPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel":
+		x.Channel = nil
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ReceivedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ReceivedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReceivedLinkPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel":
+		value := x.Channel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ReceivedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ReceivedLinkPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceivedLinkPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel":
+		x.Channel = value.Message().Interface().(*ChannelIdentifier)
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ReceivedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ReceivedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceivedLinkPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel":
+		if x.Channel == nil {
+			x.Channel = new(ChannelIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.ReceivedLinkPacket is not mutable"))
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.sequence":
+		panic(fmt.Errorf("field sequence of message srdtrk.linkedpackets.v1.ReceivedLinkPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ReceivedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ReceivedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReceivedLinkPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel":
+		m := new(ChannelIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.ReceivedLinkPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ReceivedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ReceivedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReceivedLinkPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.ReceivedLinkPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReceivedLinkPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceivedLinkPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReceivedLinkPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReceivedLinkPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReceivedLinkPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Channel != nil {
+			l = options.Size(x.Channel)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReceivedLinkPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Channel != nil {
+			encoded, err := options.Marshal(x.Channel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReceivedLinkPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReceivedLinkPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReceivedLinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Channel == nil {
+					x.Channel = &ChannelIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Channel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardedLinkPacket          protoreflect.MessageDescriptor
+	fd_ForwardedLinkPacket_channel  protoreflect.FieldDescriptor
+	fd_ForwardedLinkPacket_link_id  protoreflect.FieldDescriptor
+	fd_ForwardedLinkPacket_last_hop protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_ForwardedLinkPacket = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("ForwardedLinkPacket")
+	fd_ForwardedLinkPacket_channel = md_ForwardedLinkPacket.Fields().ByName("channel")
+	fd_ForwardedLinkPacket_link_id = md_ForwardedLinkPacket.Fields().ByName("link_id")
+	fd_ForwardedLinkPacket_last_hop = md_ForwardedLinkPacket.Fields().ByName("last_hop")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardedLinkPacket)(nil)
+
+type fastReflection_ForwardedLinkPacket ForwardedLinkPacket
+
+func (x *ForwardedLinkPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardedLinkPacket)(x)
+}
+
+func (x *ForwardedLinkPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardedLinkPacket_messageType fastReflection_ForwardedLinkPacket_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardedLinkPacket_messageType{}
+
+type fastReflection_ForwardedLinkPacket_messageType struct{}
+
+func (x fastReflection_ForwardedLinkPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardedLinkPacket)(nil)
+}
+func (x fastReflection_ForwardedLinkPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardedLinkPacket)
+}
+func (x fastReflection_ForwardedLinkPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardedLinkPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardedLinkPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardedLinkPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardedLinkPacket) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardedLinkPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardedLinkPacket) New() protoreflect.Message {
+	return new(fastReflection_ForwardedLinkPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardedLinkPacket) Interface() protoreflect.ProtoMessage {
+	return (*ForwardedLinkPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardedLinkPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != nil {
+		value := protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+		if !f(fd_ForwardedLinkPacket_channel, value) {
+			return
+		}
+	}
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_ForwardedLinkPacket_link_id, value) {
+			return
+		}
+	}
+	if x.LastHop != nil {
+		value := protoreflect.ValueOfMessage(x.LastHop.ProtoReflect())
+		if !f(fd_ForwardedLinkPacket_last_hop, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardedLinkPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel":
+		return x.Channel != nil
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop":
+		return x.LastHop != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ForwardedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ForwardedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardedLinkPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel":
+		x.Channel = nil
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop":
+		x.LastHop = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ForwardedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ForwardedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardedLinkPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel":
+		value := x.Channel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop":
+		value := x.LastHop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ForwardedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ForwardedLinkPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardedLinkPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel":
+		x.Channel = value.Message().Interface().(*ChannelIdentifier)
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop":
+		x.LastHop = value.Message().Interface().(*PacketIdentifier)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ForwardedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ForwardedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardedLinkPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel":
+		if x.Channel == nil {
+			x.Channel = new(ChannelIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop":
+		if x.LastHop == nil {
+			x.LastHop = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.LastHop.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.ForwardedLinkPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ForwardedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ForwardedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardedLinkPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel":
+		m := new(ChannelIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ForwardedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ForwardedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardedLinkPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.ForwardedLinkPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardedLinkPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardedLinkPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardedLinkPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardedLinkPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardedLinkPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Channel != nil {
+			l = options.Size(x.Channel)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastHop != nil {
+			l = options.Size(x.LastHop)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardedLinkPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastHop != nil {
+			encoded, err := options.Marshal(x.LastHop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Channel != nil {
+			encoded, err := options.Marshal(x.Channel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardedLinkPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardedLinkPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardedLinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Channel == nil {
+					x.Channel = &ChannelIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Channel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastHop == nil {
+					x.LastHop = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastHop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BufferedLinkPacket         protoreflect.MessageDescriptor
+	fd_BufferedLinkPacket_packet  protoreflect.FieldDescriptor
+	fd_BufferedLinkPacket_relayer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_BufferedLinkPacket = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("BufferedLinkPacket")
+	fd_BufferedLinkPacket_packet = md_BufferedLinkPacket.Fields().ByName("packet")
+	fd_BufferedLinkPacket_relayer = md_BufferedLinkPacket.Fields().ByName("relayer")
+}
+
+var _ protoreflect.Message = (*fastReflection_BufferedLinkPacket)(nil)
+
+type fastReflection_BufferedLinkPacket BufferedLinkPacket
+
+func (x *BufferedLinkPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BufferedLinkPacket)(x)
+}
+
+func (x *BufferedLinkPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BufferedLinkPacket_messageType fastReflection_BufferedLinkPacket_messageType
+var _ protoreflect.MessageType = fastReflection_BufferedLinkPacket_messageType{}
+
+type fastReflection_BufferedLinkPacket_messageType struct{}
+
+func (x fastReflection_BufferedLinkPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BufferedLinkPacket)(nil)
+}
+func (x fastReflection_BufferedLinkPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_BufferedLinkPacket)
+}
+func (x fastReflection_BufferedLinkPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BufferedLinkPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BufferedLinkPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_BufferedLinkPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BufferedLinkPacket) Type() protoreflect.MessageType {
+	return _fastReflection_BufferedLinkPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BufferedLinkPacket) New() protoreflect.Message {
+	return new(fastReflection_BufferedLinkPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BufferedLinkPacket) Interface() protoreflect.ProtoMessage {
+	return (*BufferedLinkPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BufferedLinkPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Packet) != 0 {
+		value := protoreflect.ValueOfBytes(x.Packet)
+		if !f(fd_BufferedLinkPacket_packet, value) {
+			return
+		}
+	}
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_BufferedLinkPacket_relayer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BufferedLinkPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.packet":
+		return len(x.Packet) != 0
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.relayer":
+		return x.Relayer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.relayer":
+		x.Relayer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BufferedLinkPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.packet":
+		value := x.Packet
+		return protoreflect.ValueOfBytes(value)
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.relayer":
+		value := x.Relayer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.packet":
+		x.Packet = value.Bytes()
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.relayer":
+		x.Relayer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.packet":
+		panic(fmt.Errorf("field packet of message srdtrk.linkedpackets.v1.BufferedLinkPacket is not mutable"))
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.relayer":
+		panic(fmt.Errorf("field relayer of message srdtrk.linkedpackets.v1.BufferedLinkPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BufferedLinkPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.packet":
+		return protoreflect.ValueOfBytes(nil)
+	case "srdtrk.linkedpackets.v1.BufferedLinkPacket.relayer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BufferedLinkPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.BufferedLinkPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BufferedLinkPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BufferedLinkPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BufferedLinkPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BufferedLinkPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Packet)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Relayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BufferedLinkPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Packet) > 0 {
+			i -= len(x.Packet)
+			copy(dAtA[i:], x.Packet)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Packet)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BufferedLinkPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BufferedLinkPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BufferedLinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Packet = append(x.Packet[:0], dAtA[iNdEx:postIndex]...)
+				if x.Packet == nil {
+					x.Packet = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BufferedLinkPackets_1_list)(nil)
+
+type _BufferedLinkPackets_1_list struct {
+	list *[]*BufferedLinkPacket
+}
+
+func (x *_BufferedLinkPackets_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BufferedLinkPackets_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BufferedLinkPackets_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BufferedLinkPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BufferedLinkPackets_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BufferedLinkPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BufferedLinkPackets_1_list) AppendMutable() protoreflect.Value {
+	v := new(BufferedLinkPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BufferedLinkPackets_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BufferedLinkPackets_1_list) NewElement() protoreflect.Value {
+	v := new(BufferedLinkPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BufferedLinkPackets_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BufferedLinkPackets         protoreflect.MessageDescriptor
+	fd_BufferedLinkPackets_packets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_BufferedLinkPackets = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("BufferedLinkPackets")
+	fd_BufferedLinkPackets_packets = md_BufferedLinkPackets.Fields().ByName("packets")
+}
+
+var _ protoreflect.Message = (*fastReflection_BufferedLinkPackets)(nil)
+
+type fastReflection_BufferedLinkPackets BufferedLinkPackets
+
+func (x *BufferedLinkPackets) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BufferedLinkPackets)(x)
+}
+
+func (x *BufferedLinkPackets) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BufferedLinkPackets_messageType fastReflection_BufferedLinkPackets_messageType
+var _ protoreflect.MessageType = fastReflection_BufferedLinkPackets_messageType{}
+
+type fastReflection_BufferedLinkPackets_messageType struct{}
+
+func (x fastReflection_BufferedLinkPackets_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BufferedLinkPackets)(nil)
+}
+func (x fastReflection_BufferedLinkPackets_messageType) New() protoreflect.Message {
+	return new(fastReflection_BufferedLinkPackets)
+}
+func (x fastReflection_BufferedLinkPackets_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BufferedLinkPackets
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BufferedLinkPackets) Descriptor() protoreflect.MessageDescriptor {
+	return md_BufferedLinkPackets
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BufferedLinkPackets) Type() protoreflect.MessageType {
+	return _fastReflection_BufferedLinkPackets_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BufferedLinkPackets) New() protoreflect.Message {
+	return new(fastReflection_BufferedLinkPackets)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BufferedLinkPackets) Interface() protoreflect.ProtoMessage {
+	return (*BufferedLinkPackets)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BufferedLinkPackets) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_BufferedLinkPackets_1_list{list: &x.Packets})
+		if !f(fd_BufferedLinkPackets_packets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BufferedLinkPackets) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPackets.packets":
+		return len(x.Packets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPackets"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPackets does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPackets) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPackets.packets":
+		x.Packets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPackets"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPackets does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BufferedLinkPackets) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPackets.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_BufferedLinkPackets_1_list{})
+		}
+		listValue := &_BufferedLinkPackets_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPackets"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPackets does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPackets) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPackets.packets":
+		lv := value.List()
+		clv := lv.(*_BufferedLinkPackets_1_list)
+		x.Packets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPackets"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPackets does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPackets) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPackets.packets":
+		if x.Packets == nil {
+			x.Packets = []*BufferedLinkPacket{}
+		}
+		value := &_BufferedLinkPackets_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPackets"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPackets does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BufferedLinkPackets) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLinkPackets.packets":
+		list := []*BufferedLinkPacket{}
+		return protoreflect.ValueOfList(&_BufferedLinkPackets_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLinkPackets"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLinkPackets does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BufferedLinkPackets) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.BufferedLinkPackets", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BufferedLinkPackets) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLinkPackets) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BufferedLinkPackets) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BufferedLinkPackets) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BufferedLinkPackets)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BufferedLinkPackets)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BufferedLinkPackets)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BufferedLinkPackets: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BufferedLinkPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Packets = append(x.Packets, &BufferedLinkPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BufferedLink_3_list)(nil)
+
+type _BufferedLink_3_list struct {
+	list *[]*BufferedLinkPacket
+}
+
+func (x *_BufferedLink_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BufferedLink_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BufferedLink_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BufferedLinkPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BufferedLink_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BufferedLinkPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BufferedLink_3_list) AppendMutable() protoreflect.Value {
+	v := new(BufferedLinkPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BufferedLink_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BufferedLink_3_list) NewElement() protoreflect.Value {
+	v := new(BufferedLinkPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BufferedLink_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BufferedLink         protoreflect.MessageDescriptor
+	fd_BufferedLink_channel protoreflect.FieldDescriptor
+	fd_BufferedLink_link_id protoreflect.FieldDescriptor
+	fd_BufferedLink_packets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_BufferedLink = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("BufferedLink")
+	fd_BufferedLink_channel = md_BufferedLink.Fields().ByName("channel")
+	fd_BufferedLink_link_id = md_BufferedLink.Fields().ByName("link_id")
+	fd_BufferedLink_packets = md_BufferedLink.Fields().ByName("packets")
+}
+
+var _ protoreflect.Message = (*fastReflection_BufferedLink)(nil)
+
+type fastReflection_BufferedLink BufferedLink
+
+func (x *BufferedLink) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BufferedLink)(x)
+}
+
+func (x *BufferedLink) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BufferedLink_messageType fastReflection_BufferedLink_messageType
+var _ protoreflect.MessageType = fastReflection_BufferedLink_messageType{}
+
+type fastReflection_BufferedLink_messageType struct{}
+
+func (x fastReflection_BufferedLink_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BufferedLink)(nil)
+}
+func (x fastReflection_BufferedLink_messageType) New() protoreflect.Message {
+	return new(fastReflection_BufferedLink)
+}
+func (x fastReflection_BufferedLink_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BufferedLink
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BufferedLink) Descriptor() protoreflect.MessageDescriptor {
+	return md_BufferedLink
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BufferedLink) Type() protoreflect.MessageType {
+	return _fastReflection_BufferedLink_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BufferedLink) New() protoreflect.Message {
+	return new(fastReflection_BufferedLink)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BufferedLink) Interface() protoreflect.ProtoMessage {
+	return (*BufferedLink)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BufferedLink) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != nil {
+		value := protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+		if !f(fd_BufferedLink_channel, value) {
+			return
+		}
+	}
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_BufferedLink_link_id, value) {
+			return
+		}
+	}
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_BufferedLink_3_list{list: &x.Packets})
+		if !f(fd_BufferedLink_packets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BufferedLink) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLink.channel":
+		return x.Channel != nil
+	case "srdtrk.linkedpackets.v1.BufferedLink.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.BufferedLink.packets":
+		return len(x.Packets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLink) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLink.channel":
+		x.Channel = nil
+	case "srdtrk.linkedpackets.v1.BufferedLink.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.BufferedLink.packets":
+		x.Packets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BufferedLink) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLink.channel":
+		value := x.Channel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.BufferedLink.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.BufferedLink.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_BufferedLink_3_list{})
+		}
+		listValue := &_BufferedLink_3_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLink does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLink) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLink.channel":
+		x.Channel = value.Message().Interface().(*ChannelIdentifier)
+	case "srdtrk.linkedpackets.v1.BufferedLink.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.BufferedLink.packets":
+		lv := value.List()
+		clv := lv.(*_BufferedLink_3_list)
+		x.Packets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLink.channel":
+		if x.Channel == nil {
+			x.Channel = new(ChannelIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Channel.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.BufferedLink.packets":
+		if x.Packets == nil {
+			x.Packets = []*BufferedLinkPacket{}
+		}
+		value := &_BufferedLink_3_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.BufferedLink.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.BufferedLink is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BufferedLink) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.BufferedLink.channel":
+		m := new(ChannelIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.BufferedLink.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.BufferedLink.packets":
+		list := []*BufferedLinkPacket{}
+		return protoreflect.ValueOfList(&_BufferedLink_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.BufferedLink"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.BufferedLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BufferedLink) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.BufferedLink", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BufferedLink) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BufferedLink) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BufferedLink) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BufferedLink) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BufferedLink)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Channel != nil {
+			l = options.Size(x.Channel)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BufferedLink)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Channel != nil {
+			encoded, err := options.Marshal(x.Channel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BufferedLink)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BufferedLink: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BufferedLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Channel == nil {
+					x.Channel = &ChannelIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Channel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Packets = append(x.Packets, &BufferedLinkPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PacketIdentifier            protoreflect.MessageDescriptor
+	fd_PacketIdentifier_port_id    protoreflect.FieldDescriptor
+	fd_PacketIdentifier_channel_id protoreflect.FieldDescriptor
+	fd_PacketIdentifier_seq        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_PacketIdentifier = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("PacketIdentifier")
+	fd_PacketIdentifier_port_id = md_PacketIdentifier.Fields().ByName("port_id")
+	fd_PacketIdentifier_channel_id = md_PacketIdentifier.Fields().ByName("channel_id")
+	fd_PacketIdentifier_seq = md_PacketIdentifier.Fields().ByName("seq")
+}
+
+var _ protoreflect.Message = (*fastReflection_PacketIdentifier)(nil)
+
+type fastReflection_PacketIdentifier PacketIdentifier
+
+func (x *PacketIdentifier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PacketIdentifier)(x)
+}
+
+func (x *PacketIdentifier) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PacketIdentifier_messageType fastReflection_PacketIdentifier_messageType
+var _ protoreflect.MessageType = fastReflection_PacketIdentifier_messageType{}
+
+type fastReflection_PacketIdentifier_messageType struct{}
+
+func (x fastReflection_PacketIdentifier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PacketIdentifier)(nil)
+}
+func (x fastReflection_PacketIdentifier_messageType) New() protoreflect.Message {
+	return new(fastReflection_PacketIdentifier)
+}
+func (x fastReflection_PacketIdentifier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketIdentifier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PacketIdentifier) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketIdentifier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PacketIdentifier) Type() protoreflect.MessageType {
+	return _fastReflection_PacketIdentifier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PacketIdentifier) New() protoreflect.Message {
+	return new(fastReflection_PacketIdentifier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PacketIdentifier) Interface() protoreflect.ProtoMessage {
+	return (*PacketIdentifier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PacketIdentifier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_PacketIdentifier_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_PacketIdentifier_channel_id, value) {
+			return
+		}
+	}
+	if x.Seq != "" {
+		value := protoreflect.ValueOfString(x.Seq)
+		if !f(fd_PacketIdentifier_seq, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PacketIdentifier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
+		return x.PortId != ""
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
+		return x.ChannelId != ""
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
+		return x.Seq != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketIdentifier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
+		x.PortId = ""
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
+		x.ChannelId = ""
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
+		x.Seq = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PacketIdentifier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
+		value := x.Seq
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketIdentifier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
+		x.PortId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
+		x.Seq = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketIdentifier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
+		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.PacketIdentifier is not mutable"))
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
+		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.PacketIdentifier is not mutable"))
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
+		panic(fmt.Errorf("field seq of message srdtrk.linkedpackets.v1.PacketIdentifier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PacketIdentifier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PacketIdentifier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.PacketIdentifier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PacketIdentifier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketIdentifier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PacketIdentifier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PacketIdentifier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PacketIdentifier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Seq)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PacketIdentifier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Seq) > 0 {
+			i -= len(x.Seq)
+			copy(dAtA[i:], x.Seq)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seq)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PacketIdentifier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketIdentifier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seq = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChannelIdentifier            protoreflect.MessageDescriptor
+	fd_ChannelIdentifier_port_id    protoreflect.FieldDescriptor
+	fd_ChannelIdentifier_channel_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_ChannelIdentifier = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("ChannelIdentifier")
+	fd_ChannelIdentifier_port_id = md_ChannelIdentifier.Fields().ByName("port_id")
+	fd_ChannelIdentifier_channel_id = md_ChannelIdentifier.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChannelIdentifier)(nil)
+
+type fastReflection_ChannelIdentifier ChannelIdentifier
+
+func (x *ChannelIdentifier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChannelIdentifier)(x)
+}
+
+func (x *ChannelIdentifier) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChannelIdentifier_messageType fastReflection_ChannelIdentifier_messageType
+var _ protoreflect.MessageType = fastReflection_ChannelIdentifier_messageType{}
+
+type fastReflection_ChannelIdentifier_messageType struct{}
+
+func (x fastReflection_ChannelIdentifier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChannelIdentifier)(nil)
+}
+func (x fastReflection_ChannelIdentifier_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChannelIdentifier)
+}
+func (x fastReflection_ChannelIdentifier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChannelIdentifier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChannelIdentifier) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChannelIdentifier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChannelIdentifier) Type() protoreflect.MessageType {
+	return _fastReflection_ChannelIdentifier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChannelIdentifier) New() protoreflect.Message {
+	return new(fastReflection_ChannelIdentifier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChannelIdentifier) Interface() protoreflect.ProtoMessage {
+	return (*ChannelIdentifier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChannelIdentifier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_ChannelIdentifier_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_ChannelIdentifier_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChannelIdentifier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.port_id":
+		return x.PortId != ""
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelIdentifier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.port_id":
+		x.PortId = ""
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChannelIdentifier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelIdentifier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelIdentifier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.port_id":
+		x.PortId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelIdentifier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.port_id":
+		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.ChannelIdentifier is not mutable"))
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.channel_id":
+		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.ChannelIdentifier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChannelIdentifier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.port_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.ChannelIdentifier.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.ChannelIdentifier"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.ChannelIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChannelIdentifier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.ChannelIdentifier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChannelIdentifier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelIdentifier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChannelIdentifier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChannelIdentifier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChannelIdentifier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChannelIdentifier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChannelIdentifier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChannelIdentifier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChannelIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Link_4_list)(nil)
+
+type _Link_4_list struct {
+	list *[]*LinkPacket
+}
+
+func (x *_Link_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Link_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Link_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Link_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Link_4_list) AppendMutable() protoreflect.Value {
+	v := new(LinkPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Link_4_list) NewElement() protoreflect.Value {
+	v := new(LinkPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Link_5_list)(nil)
+
+type _Link_5_list struct {
+	list *[]*Compensation
+}
+
+func (x *_Link_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Link_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Link_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Compensation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Link_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Compensation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Link_5_list) AppendMutable() protoreflect.Value {
+	v := new(Compensation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Link_5_list) NewElement() protoreflect.Value {
+	v := new(Compensation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Link_6_list)(nil)
+
+type _Link_6_list struct {
+	list *[]*CompensationPacket
+}
+
+func (x *_Link_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Link_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Link_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompensationPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Link_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompensationPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Link_6_list) AppendMutable() protoreflect.Value {
+	v := new(CompensationPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Link_6_list) NewElement() protoreflect.Value {
+	v := new(CompensationPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Link_9_list)(nil)
+
+type _Link_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Link_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Link_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Link_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Link_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Link_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Link_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Link_10_list)(nil)
+
+type _Link_10_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Link_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Link_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Link_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Link_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Link_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Link_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Link_14_list)(nil)
+
+type _Link_14_list struct {
+	list *[]*ChannelIdentifier
+}
+
+func (x *_Link_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Link_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Link_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Link_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Link_14_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Link_14_list) NewElement() protoreflect.Value {
+	v := new(ChannelIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Link_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Link                       protoreflect.MessageDescriptor
+	fd_Link_link_id               protoreflect.FieldDescriptor
+	fd_Link_owner                 protoreflect.FieldDescriptor
+	fd_Link_status                protoreflect.FieldDescriptor
+	fd_Link_packets               protoreflect.FieldDescriptor
+	fd_Link_compensations         protoreflect.FieldDescriptor
+	fd_Link_compensation_packets  protoreflect.FieldDescriptor
+	fd_Link_open_time             protoreflect.FieldDescriptor
+	fd_Link_open_height           protoreflect.FieldDescriptor
+	fd_Link_deposit               protoreflect.FieldDescriptor
+	fd_Link_relayer_reward        protoreflect.FieldDescriptor
+	fd_Link_src_callback_address  protoreflect.FieldDescriptor
+	fd_Link_dest_callback_address protoreflect.FieldDescriptor
+	fd_Link_atomic                protoreflect.FieldDescriptor
+	fd_Link_allowed_channels      protoreflect.FieldDescriptor
+	fd_Link_max_packets           protoreflect.FieldDescriptor
+	fd_Link_resolve_height        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_Link = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("Link")
+	fd_Link_link_id = md_Link.Fields().ByName("link_id")
+	fd_Link_owner = md_Link.Fields().ByName("owner")
+	fd_Link_status = md_Link.Fields().ByName("status")
+	fd_Link_packets = md_Link.Fields().ByName("packets")
+	fd_Link_compensations = md_Link.Fields().ByName("compensations")
+	fd_Link_compensation_packets = md_Link.Fields().ByName("compensation_packets")
+	fd_Link_open_time = md_Link.Fields().ByName("open_time")
+	fd_Link_open_height = md_Link.Fields().ByName("open_height")
+	fd_Link_deposit = md_Link.Fields().ByName("deposit")
+	fd_Link_relayer_reward = md_Link.Fields().ByName("relayer_reward")
+	fd_Link_src_callback_address = md_Link.Fields().ByName("src_callback_address")
+	fd_Link_dest_callback_address = md_Link.Fields().ByName("dest_callback_address")
+	fd_Link_atomic = md_Link.Fields().ByName("atomic")
+	fd_Link_allowed_channels = md_Link.Fields().ByName("allowed_channels")
+	fd_Link_max_packets = md_Link.Fields().ByName("max_packets")
+	fd_Link_resolve_height = md_Link.Fields().ByName("resolve_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_Link)(nil)
+
+type fastReflection_Link Link
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Link)(x)
+}
+
+func (x *Link) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Link_messageType fastReflection_Link_messageType
+var _ protoreflect.MessageType = fastReflection_Link_messageType{}
+
+type fastReflection_Link_messageType struct{}
+
+func (x fastReflection_Link_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Link)(nil)
+}
+func (x fastReflection_Link_messageType) New() protoreflect.Message {
+	return new(fastReflection_Link)
+}
+func (x fastReflection_Link_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Link
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Link) Descriptor() protoreflect.MessageDescriptor {
+	return md_Link
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Link) Type() protoreflect.MessageType {
+	return _fastReflection_Link_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Link) New() protoreflect.Message {
+	return new(fastReflection_Link)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Link) Interface() protoreflect.ProtoMessage {
+	return (*Link)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Link) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkId != "" {
+		value := protoreflect.ValueOfString(x.LinkId)
+		if !f(fd_Link_link_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Link_owner, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Link_status, value) {
+			return
+		}
+	}
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_Link_4_list{list: &x.Packets})
+		if !f(fd_Link_packets, value) {
+			return
+		}
+	}
+	if len(x.Compensations) != 0 {
+		value := protoreflect.ValueOfList(&_Link_5_list{list: &x.Compensations})
+		if !f(fd_Link_compensations, value) {
+			return
+		}
+	}
+	if len(x.CompensationPackets) != 0 {
+		value := protoreflect.ValueOfList(&_Link_6_list{list: &x.CompensationPackets})
+		if !f(fd_Link_compensation_packets, value) {
+			return
+		}
+	}
+	if x.OpenTime != nil {
+		value := protoreflect.ValueOfMessage(x.OpenTime.ProtoReflect())
+		if !f(fd_Link_open_time, value) {
+			return
+		}
+	}
+	if x.OpenHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OpenHeight)
+		if !f(fd_Link_open_height, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_Link_9_list{list: &x.Deposit})
+		if !f(fd_Link_deposit, value) {
+			return
+		}
+	}
+	if len(x.RelayerReward) != 0 {
+		value := protoreflect.ValueOfList(&_Link_10_list{list: &x.RelayerReward})
+		if !f(fd_Link_relayer_reward, value) {
+			return
+		}
+	}
+	if x.SrcCallbackAddress != "" {
+		value := protoreflect.ValueOfString(x.SrcCallbackAddress)
+		if !f(fd_Link_src_callback_address, value) {
+			return
+		}
+	}
+	if x.DestCallbackAddress != "" {
+		value := protoreflect.ValueOfString(x.DestCallbackAddress)
+		if !f(fd_Link_dest_callback_address, value) {
+			return
+		}
+	}
+	if x.Atomic != false {
+		value := protoreflect.ValueOfBool(x.Atomic)
+		if !f(fd_Link_atomic, value) {
+			return
+		}
+	}
+	if len(x.AllowedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_Link_14_list{list: &x.AllowedChannels})
+		if !f(fd_Link_allowed_channels, value) {
+			return
+		}
+	}
+	if x.MaxPackets != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPackets)
+		if !f(fd_Link_max_packets, value) {
+			return
+		}
+	}
+	if x.ResolveHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ResolveHeight)
+		if !f(fd_Link_resolve_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Link) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Link.link_id":
+		return x.LinkId != ""
+	case "srdtrk.linkedpackets.v1.Link.owner":
+		return x.Owner != ""
+	case "srdtrk.linkedpackets.v1.Link.status":
+		return x.Status != 0
+	case "srdtrk.linkedpackets.v1.Link.packets":
+		return len(x.Packets) != 0
+	case "srdtrk.linkedpackets.v1.Link.compensations":
+		return len(x.Compensations) != 0
+	case "srdtrk.linkedpackets.v1.Link.compensation_packets":
+		return len(x.CompensationPackets) != 0
+	case "srdtrk.linkedpackets.v1.Link.open_time":
+		return x.OpenTime != nil
+	case "srdtrk.linkedpackets.v1.Link.open_height":
+		return x.OpenHeight != uint64(0)
+	case "srdtrk.linkedpackets.v1.Link.deposit":
+		return len(x.Deposit) != 0
+	case "srdtrk.linkedpackets.v1.Link.relayer_reward":
+		return len(x.RelayerReward) != 0
+	case "srdtrk.linkedpackets.v1.Link.src_callback_address":
+		return x.SrcCallbackAddress != ""
+	case "srdtrk.linkedpackets.v1.Link.dest_callback_address":
+		return x.DestCallbackAddress != ""
+	case "srdtrk.linkedpackets.v1.Link.atomic":
+		return x.Atomic != false
+	case "srdtrk.linkedpackets.v1.Link.allowed_channels":
+		return len(x.AllowedChannels) != 0
+	case "srdtrk.linkedpackets.v1.Link.max_packets":
+		return x.MaxPackets != uint64(0)
+	case "srdtrk.linkedpackets.v1.Link.resolve_height":
+		return x.ResolveHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Link"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Link does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Link) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Link.link_id":
+		x.LinkId = ""
+	case "srdtrk.linkedpackets.v1.Link.owner":
+		x.Owner = ""
+	case "srdtrk.linkedpackets.v1.Link.status":
+		x.Status = 0
+	case "srdtrk.linkedpackets.v1.Link.packets":
+		x.Packets = nil
+	case "srdtrk.linkedpackets.v1.Link.compensations":
+		x.Compensations = nil
+	case "srdtrk.linkedpackets.v1.Link.compensation_packets":
+		x.CompensationPackets = nil
+	case "srdtrk.linkedpackets.v1.Link.open_time":
+		x.OpenTime = nil
+	case "srdtrk.linkedpackets.v1.Link.open_height":
+		x.OpenHeight = uint64(0)
+	case "srdtrk.linkedpackets.v1.Link.deposit":
+		x.Deposit = nil
+	case "srdtrk.linkedpackets.v1.Link.relayer_reward":
+		x.RelayerReward = nil
+	case "srdtrk.linkedpackets.v1.Link.src_callback_address":
+		x.SrcCallbackAddress = ""
+	case "srdtrk.linkedpackets.v1.Link.dest_callback_address":
+		x.DestCallbackAddress = ""
+	case "srdtrk.linkedpackets.v1.Link.atomic":
+		x.Atomic = false
+	case "srdtrk.linkedpackets.v1.Link.allowed_channels":
+		x.AllowedChannels = nil
+	case "srdtrk.linkedpackets.v1.Link.max_packets":
+		x.MaxPackets = uint64(0)
+	case "srdtrk.linkedpackets.v1.Link.resolve_height":
+		x.ResolveHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Link"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Link does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Link) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.Link.link_id":
+		value := x.LinkId
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.Link.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.Link.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.Link.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_Link_4_list{})
+		}
+		listValue := &_Link_4_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Link.compensations":
+		if len(x.Compensations) == 0 {
+			return protoreflect.ValueOfList(&_Link_5_list{})
+		}
+		listValue := &_Link_5_list{list: &x.Compensations}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Link.compensation_packets":
+		if len(x.CompensationPackets) == 0 {
+			return protoreflect.ValueOfList(&_Link_6_list{})
+		}
+		listValue := &_Link_6_list{list: &x.CompensationPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Link.open_time":
+		value := x.OpenTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.Link.open_height":
+		value := x.OpenHeight
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Link.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_Link_9_list{})
+		}
+		listValue := &_Link_9_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Link.relayer_reward":
+		if len(x.RelayerReward) == 0 {
+			return protoreflect.ValueOfList(&_Link_10_list{})
+		}
+		listValue := &_Link_10_list{list: &x.RelayerReward}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Link.src_callback_address":
+		value := x.SrcCallbackAddress
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.Link.dest_callback_address":
+		value := x.DestCallbackAddress
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.Link.atomic":
+		value := x.Atomic
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.Link.allowed_channels":
+		if len(x.AllowedChannels) == 0 {
+			return protoreflect.ValueOfList(&_Link_14_list{})
+		}
+		listValue := &_Link_14_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "srdtrk.linkedpackets.v1.Link.max_packets":
+		value := x.MaxPackets
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Link.resolve_height":
+		value := x.ResolveHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Link"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Link does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Link) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Link.link_id":
+		x.LinkId = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.Link.owner":
+		x.Owner = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.Link.status":
+		x.Status = (LinkStatus)(value.Enum())
+	case "srdtrk.linkedpackets.v1.Link.packets":
+		lv := value.List()
+		clv := lv.(*_Link_4_list)
+		x.Packets = *clv.list
+	case "srdtrk.linkedpackets.v1.Link.compensations":
+		lv := value.List()
+		clv := lv.(*_Link_5_list)
+		x.Compensations = *clv.list
+	case "srdtrk.linkedpackets.v1.Link.compensation_packets":
+		lv := value.List()
+		clv := lv.(*_Link_6_list)
+		x.CompensationPackets = *clv.list
+	case "srdtrk.linkedpackets.v1.Link.open_time":
+		x.OpenTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "srdtrk.linkedpackets.v1.Link.open_height":
+		x.OpenHeight = value.Uint()
+	case "srdtrk.linkedpackets.v1.Link.deposit":
+		lv := value.List()
+		clv := lv.(*_Link_9_list)
+		x.Deposit = *clv.list
+	case "srdtrk.linkedpackets.v1.Link.relayer_reward":
+		lv := value.List()
+		clv := lv.(*_Link_10_list)
+		x.RelayerReward = *clv.list
+	case "srdtrk.linkedpackets.v1.Link.src_callback_address":
+		x.SrcCallbackAddress = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.Link.dest_callback_address":
+		x.DestCallbackAddress = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.Link.atomic":
+		x.Atomic = value.Bool()
+	case "srdtrk.linkedpackets.v1.Link.allowed_channels":
+		lv := value.List()
+		clv := lv.(*_Link_14_list)
+		x.AllowedChannels = *clv.list
+	case "srdtrk.linkedpackets.v1.Link.max_packets":
+		x.MaxPackets = value.Uint()
+	case "srdtrk.linkedpackets.v1.Link.resolve_height":
+		x.ResolveHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Link"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Link does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Link) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Link.packets":
+		if x.Packets == nil {
+			x.Packets = []*LinkPacket{}
+		}
+		value := &_Link_4_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Link.compensations":
+		if x.Compensations == nil {
+			x.Compensations = []*Compensation{}
+		}
+		value := &_Link_5_list{list: &x.Compensations}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Link.compensation_packets":
+		if x.CompensationPackets == nil {
+			x.CompensationPackets = []*CompensationPacket{}
+		}
+		value := &_Link_6_list{list: &x.CompensationPackets}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Link.open_time":
+		if x.OpenTime == nil {
+			x.OpenTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.OpenTime.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.Link.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_Link_9_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Link.relayer_reward":
+		if x.RelayerReward == nil {
+			x.RelayerReward = []*v1beta1.Coin{}
+		}
+		value := &_Link_10_list{list: &x.RelayerReward}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Link.allowed_channels":
+		if x.AllowedChannels == nil {
+			x.AllowedChannels = []*ChannelIdentifier{}
+		}
+		value := &_Link_14_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Link.link_id":
+		panic(fmt.Errorf("field link_id of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.owner":
+		panic(fmt.Errorf("field owner of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.status":
+		panic(fmt.Errorf("field status of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.open_height":
+		panic(fmt.Errorf("field open_height of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.src_callback_address":
+		panic(fmt.Errorf("field src_callback_address of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.dest_callback_address":
+		panic(fmt.Errorf("field dest_callback_address of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.atomic":
+		panic(fmt.Errorf("field atomic of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.max_packets":
+		panic(fmt.Errorf("field max_packets of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	case "srdtrk.linkedpackets.v1.Link.resolve_height":
+		panic(fmt.Errorf("field resolve_height of message srdtrk.linkedpackets.v1.Link is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Link"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Link does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Link) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Link.link_id":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.Link.owner":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.Link.status":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.Link.packets":
+		list := []*LinkPacket{}
+		return protoreflect.ValueOfList(&_Link_4_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Link.compensations":
+		list := []*Compensation{}
+		return protoreflect.ValueOfList(&_Link_5_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Link.compensation_packets":
+		list := []*CompensationPacket{}
+		return protoreflect.ValueOfList(&_Link_6_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Link.open_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.Link.open_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Link.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Link_9_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Link.relayer_reward":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Link_10_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Link.src_callback_address":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.Link.dest_callback_address":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.Link.atomic":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.Link.allowed_channels":
+		list := []*ChannelIdentifier{}
+		return protoreflect.ValueOfList(&_Link_14_list{list: &list})
+	case "srdtrk.linkedpackets.v1.Link.max_packets":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Link.resolve_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Link"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Link does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Link) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.Link", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Link) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Link) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Link) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Link) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Link)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LinkId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Compensations) > 0 {
+			for _, e := range x.Compensations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CompensationPackets) > 0 {
+			for _, e := range x.CompensationPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OpenTime != nil {
+			l = options.Size(x.OpenTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OpenHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.OpenHeight))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RelayerReward) > 0 {
+			for _, e := range x.RelayerReward {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SrcCallbackAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestCallbackAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Atomic {
+			n += 2
+		}
+		if len(x.AllowedChannels) > 0 {
+			for _, e := range x.AllowedChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPackets != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPackets))
+		}
+		if x.ResolveHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.ResolveHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Link)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ResolveHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxPackets != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPackets))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.AllowedChannels) > 0 {
+			for iNdEx := len(x.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.Atomic {
+			i--
+			if x.Atomic {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.DestCallbackAddress) > 0 {
+			i -= len(x.DestCallbackAddress)
+			copy(dAtA[i:], x.DestCallbackAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestCallbackAddress)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.SrcCallbackAddress) > 0 {
+			i -= len(x.SrcCallbackAddress)
+			copy(dAtA[i:], x.SrcCallbackAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcCallbackAddress)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.RelayerReward) > 0 {
+			for iNdEx := len(x.RelayerReward) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RelayerReward[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.OpenHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpenHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.OpenTime != nil {
+			encoded, err := options.Marshal(x.OpenTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.CompensationPackets) > 0 {
+			for iNdEx := len(x.CompensationPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CompensationPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Compensations) > 0 {
+			for iNdEx := len(x.Compensations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Compensations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LinkId) > 0 {
+			i -= len(x.LinkId)
+			copy(dAtA[i:], x.LinkId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LinkId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Link)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Link: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= LinkStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Packets = append(x.Packets, &LinkPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Compensations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Compensations = append(x.Compensations, &Compensation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Compensations[len(x.Compensations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompensationPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompensationPackets = append(x.CompensationPackets, &CompensationPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompensationPackets[len(x.CompensationPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OpenTime == nil {
+					x.OpenTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OpenTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenHeight", wireType)
+				}
+				x.OpenHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OpenHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelayerReward = append(x.RelayerReward, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelayerReward[len(x.RelayerReward)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcCallbackAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SrcCallbackAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestCallbackAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestCallbackAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Atomic = bool(v != 0)
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedChannels = append(x.AllowedChannels, &ChannelIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedChannels[len(x.AllowedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+				}
+				x.MaxPackets = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPackets |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveHeight", wireType)
+				}
+				x.ResolveHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LinkPacket         protoreflect.MessageDescriptor
+	fd_LinkPacket_packet  protoreflect.FieldDescriptor
+	fd_LinkPacket_outcome protoreflect.FieldDescriptor
+	fd_LinkPacket_relayer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_LinkPacket = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("LinkPacket")
+	fd_LinkPacket_packet = md_LinkPacket.Fields().ByName("packet")
+	fd_LinkPacket_outcome = md_LinkPacket.Fields().ByName("outcome")
+	fd_LinkPacket_relayer = md_LinkPacket.Fields().ByName("relayer")
+}
+
+var _ protoreflect.Message = (*fastReflection_LinkPacket)(nil)
+
+type fastReflection_LinkPacket LinkPacket
+
+func (x *LinkPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinkPacket)(x)
+}
+
+func (x *LinkPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LinkPacket_messageType fastReflection_LinkPacket_messageType
+var _ protoreflect.MessageType = fastReflection_LinkPacket_messageType{}
+
+type fastReflection_LinkPacket_messageType struct{}
+
+func (x fastReflection_LinkPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinkPacket)(nil)
+}
+func (x fastReflection_LinkPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinkPacket)
+}
+func (x fastReflection_LinkPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LinkPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LinkPacket) Type() protoreflect.MessageType {
+	return _fastReflection_LinkPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LinkPacket) New() protoreflect.Message {
+	return new(fastReflection_LinkPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LinkPacket) Interface() protoreflect.ProtoMessage {
+	return (*LinkPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LinkPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_LinkPacket_packet, value) {
+			return
+		}
+	}
+	if x.Outcome != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Outcome))
+		if !f(fd_LinkPacket_outcome, value) {
+			return
+		}
+	}
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_LinkPacket_relayer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LinkPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkPacket.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.LinkPacket.outcome":
+		return x.Outcome != 0
+	case "srdtrk.linkedpackets.v1.LinkPacket.relayer":
+		return x.Relayer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkPacket.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.LinkPacket.outcome":
+		x.Outcome = 0
+	case "srdtrk.linkedpackets.v1.LinkPacket.relayer":
+		x.Relayer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LinkPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkPacket.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.LinkPacket.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.LinkPacket.relayer":
+		value := x.Relayer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkPacket.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.LinkPacket.outcome":
+		x.Outcome = (PacketOutcome)(value.Enum())
+	case "srdtrk.linkedpackets.v1.LinkPacket.relayer":
+		x.Relayer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkPacket.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.LinkPacket.outcome":
+		panic(fmt.Errorf("field outcome of message srdtrk.linkedpackets.v1.LinkPacket is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkPacket.relayer":
+		panic(fmt.Errorf("field relayer of message srdtrk.linkedpackets.v1.LinkPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LinkPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkPacket.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.LinkPacket.outcome":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.LinkPacket.relayer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LinkPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.LinkPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LinkPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LinkPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LinkPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LinkPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Outcome != 0 {
+			n += 1 + runtime.Sov(uint64(x.Outcome))
+		}
+		l = len(x.Relayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LinkPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Outcome != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Outcome))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LinkPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+				}
+				x.Outcome = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Outcome |= PacketOutcome(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Compensation_2_list)(nil)
+
+type _Compensation_2_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Compensation_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Compensation_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Compensation_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Compensation_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Compensation_2_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Compensation_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Compensation_2_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Compensation_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Compensation            protoreflect.MessageDescriptor
+	fd_Compensation_link_index protoreflect.FieldDescriptor
+	fd_Compensation_messages   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_Compensation = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("Compensation")
+	fd_Compensation_link_index = md_Compensation.Fields().ByName("link_index")
+	fd_Compensation_messages = md_Compensation.Fields().ByName("messages")
+}
+
+var _ protoreflect.Message = (*fastReflection_Compensation)(nil)
+
+type fastReflection_Compensation Compensation
+
+func (x *Compensation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Compensation)(x)
+}
+
+func (x *Compensation) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Compensation_messageType fastReflection_Compensation_messageType
+var _ protoreflect.MessageType = fastReflection_Compensation_messageType{}
+
+type fastReflection_Compensation_messageType struct{}
+
+func (x fastReflection_Compensation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Compensation)(nil)
+}
+func (x fastReflection_Compensation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Compensation)
+}
+func (x fastReflection_Compensation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Compensation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Compensation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Compensation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Compensation) Type() protoreflect.MessageType {
+	return _fastReflection_Compensation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Compensation) New() protoreflect.Message {
+	return new(fastReflection_Compensation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Compensation) Interface() protoreflect.ProtoMessage {
+	return (*Compensation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Compensation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_Compensation_link_index, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_Compensation_2_list{list: &x.Messages})
+		if !f(fd_Compensation_messages, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Compensation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Compensation.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.Compensation.messages":
+		return len(x.Messages) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Compensation"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Compensation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Compensation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Compensation.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.Compensation.messages":
+		x.Messages = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Compensation"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Compensation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Compensation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.Compensation.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.Compensation.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_Compensation_2_list{})
+		}
+		listValue := &_Compensation_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Compensation"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Compensation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Compensation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Compensation.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.Compensation.messages":
+		lv := value.List()
+		clv := lv.(*_Compensation_2_list)
+		x.Messages = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Compensation"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Compensation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Compensation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Compensation.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_Compensation_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "srdtrk.linkedpackets.v1.Compensation.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.Compensation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Compensation"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Compensation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Compensation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.Compensation.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.Compensation.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Compensation_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.Compensation"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.Compensation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Compensation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.Compensation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Compensation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Compensation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Compensation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Compensation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Compensation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Compensation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Compensation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Compensation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Compensation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CompensationPacket            protoreflect.MessageDescriptor
+	fd_CompensationPacket_link_index protoreflect.FieldDescriptor
+	fd_CompensationPacket_packet     protoreflect.FieldDescriptor
+	fd_CompensationPacket_outcome    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_CompensationPacket = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("CompensationPacket")
+	fd_CompensationPacket_link_index = md_CompensationPacket.Fields().ByName("link_index")
+	fd_CompensationPacket_packet = md_CompensationPacket.Fields().ByName("packet")
+	fd_CompensationPacket_outcome = md_CompensationPacket.Fields().ByName("outcome")
+}
+
+var _ protoreflect.Message = (*fastReflection_CompensationPacket)(nil)
+
+type fastReflection_CompensationPacket CompensationPacket
+
+func (x *CompensationPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CompensationPacket)(x)
+}
+
+func (x *CompensationPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CompensationPacket_messageType fastReflection_CompensationPacket_messageType
+var _ protoreflect.MessageType = fastReflection_CompensationPacket_messageType{}
+
+type fastReflection_CompensationPacket_messageType struct{}
+
+func (x fastReflection_CompensationPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CompensationPacket)(nil)
+}
+func (x fastReflection_CompensationPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_CompensationPacket)
+}
+func (x fastReflection_CompensationPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompensationPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CompensationPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompensationPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CompensationPacket) Type() protoreflect.MessageType {
+	return _fastReflection_CompensationPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CompensationPacket) New() protoreflect.Message {
+	return new(fastReflection_CompensationPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CompensationPacket) Interface() protoreflect.ProtoMessage {
+	return (*CompensationPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CompensationPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LinkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LinkIndex)
+		if !f(fd_CompensationPacket_link_index, value) {
+			return
+		}
+	}
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_CompensationPacket_packet, value) {
+			return
+		}
+	}
+	if x.Outcome != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Outcome))
+		if !f(fd_CompensationPacket_outcome, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CompensationPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.CompensationPacket.link_index":
+		return x.LinkIndex != uint64(0)
+	case "srdtrk.linkedpackets.v1.CompensationPacket.packet":
+		return x.Packet != nil
+	case "srdtrk.linkedpackets.v1.CompensationPacket.outcome":
+		return x.Outcome != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.CompensationPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.CompensationPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.CompensationPacket.link_index":
+		x.LinkIndex = uint64(0)
+	case "srdtrk.linkedpackets.v1.CompensationPacket.packet":
+		x.Packet = nil
+	case "srdtrk.linkedpackets.v1.CompensationPacket.outcome":
+		x.Outcome = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.CompensationPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.CompensationPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CompensationPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.CompensationPacket.link_index":
+		value := x.LinkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "srdtrk.linkedpackets.v1.CompensationPacket.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.CompensationPacket.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.CompensationPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.CompensationPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.CompensationPacket.link_index":
+		x.LinkIndex = value.Uint()
+	case "srdtrk.linkedpackets.v1.CompensationPacket.packet":
+		x.Packet = value.Message().Interface().(*PacketIdentifier)
+	case "srdtrk.linkedpackets.v1.CompensationPacket.outcome":
+		x.Outcome = (PacketOutcome)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.CompensationPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.CompensationPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.CompensationPacket.packet":
+		if x.Packet == nil {
+			x.Packet = new(PacketIdentifier)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.CompensationPacket.link_index":
+		panic(fmt.Errorf("field link_index of message srdtrk.linkedpackets.v1.CompensationPacket is not mutable"))
+	case "srdtrk.linkedpackets.v1.CompensationPacket.outcome":
+		panic(fmt.Errorf("field outcome of message srdtrk.linkedpackets.v1.CompensationPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.CompensationPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.CompensationPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CompensationPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.CompensationPacket.link_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "srdtrk.linkedpackets.v1.CompensationPacket.packet":
+		m := new(PacketIdentifier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "srdtrk.linkedpackets.v1.CompensationPacket.outcome":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.CompensationPacket"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.CompensationPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CompensationPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.CompensationPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CompensationPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CompensationPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CompensationPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CompensationPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LinkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LinkIndex))
+		}
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Outcome != 0 {
+			n += 1 + runtime.Sov(uint64(x.Outcome))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CompensationPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Outcome != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Outcome))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.LinkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LinkIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CompensationPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompensationPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompensationPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+				}
+				x.LinkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LinkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &PacketIdentifier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+				}
+				x.Outcome = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Outcome |= PacketOutcome(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LinkAcknowledgement                     protoreflect.MessageDescriptor
+	fd_LinkAcknowledgement_app_acknowledgement protoreflect.FieldDescriptor
+	fd_LinkAcknowledgement_app_success         protoreflect.FieldDescriptor
+	fd_LinkAcknowledgement_code                protoreflect.FieldDescriptor
+	fd_LinkAcknowledgement_reason              protoreflect.FieldDescriptor
+	fd_LinkAcknowledgement_relayer             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_srdtrk_linkedpackets_v1_types_proto_init()
+	md_LinkAcknowledgement = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("LinkAcknowledgement")
+	fd_LinkAcknowledgement_app_acknowledgement = md_LinkAcknowledgement.Fields().ByName("app_acknowledgement")
+	fd_LinkAcknowledgement_app_success = md_LinkAcknowledgement.Fields().ByName("app_success")
+	fd_LinkAcknowledgement_code = md_LinkAcknowledgement.Fields().ByName("code")
+	fd_LinkAcknowledgement_reason = md_LinkAcknowledgement.Fields().ByName("reason")
+	fd_LinkAcknowledgement_relayer = md_LinkAcknowledgement.Fields().ByName("relayer")
+}
+
+var _ protoreflect.Message = (*fastReflection_LinkAcknowledgement)(nil)
+
+type fastReflection_LinkAcknowledgement LinkAcknowledgement
+
+func (x *LinkAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinkAcknowledgement)(x)
+}
+
+func (x *LinkAcknowledgement) slowProtoReflect() protoreflect.Message {
+	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LinkAcknowledgement_messageType fastReflection_LinkAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_LinkAcknowledgement_messageType{}
+
+type fastReflection_LinkAcknowledgement_messageType struct{}
+
+func (x fastReflection_LinkAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinkAcknowledgement)(nil)
+}
+func (x fastReflection_LinkAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinkAcknowledgement)
+}
+func (x fastReflection_LinkAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkAcknowledgement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LinkAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkAcknowledgement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LinkAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_LinkAcknowledgement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LinkAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_LinkAcknowledgement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LinkAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*LinkAcknowledgement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LinkAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AppAcknowledgement) != 0 {
+		value := protoreflect.ValueOfBytes(x.AppAcknowledgement)
+		if !f(fd_LinkAcknowledgement_app_acknowledgement, value) {
+			return
+		}
+	}
+	if x.AppSuccess != false {
+		value := protoreflect.ValueOfBool(x.AppSuccess)
+		if !f(fd_LinkAcknowledgement_app_success, value) {
+			return
+		}
+	}
+	if x.Code != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Code))
+		if !f(fd_LinkAcknowledgement_code, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_LinkAcknowledgement_reason, value) {
+			return
+		}
+	}
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_LinkAcknowledgement_relayer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LinkAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_acknowledgement":
+		return len(x.AppAcknowledgement) != 0
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_success":
+		return x.AppSuccess != false
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.code":
+		return x.Code != 0
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.reason":
+		return x.Reason != ""
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.relayer":
+		return x.Relayer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_acknowledgement":
+		x.AppAcknowledgement = nil
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_success":
+		x.AppSuccess = false
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.code":
+		x.Code = 0
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.reason":
+		x.Reason = ""
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.relayer":
+		x.Relayer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LinkAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_acknowledgement":
+		value := x.AppAcknowledgement
+		return protoreflect.ValueOfBytes(value)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_success":
+		value := x.AppSuccess
+		return protoreflect.ValueOfBool(value)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.code":
+		value := x.Code
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.relayer":
+		value := x.Relayer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAcknowledgement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_acknowledgement":
+		x.AppAcknowledgement = value.Bytes()
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_success":
+		x.AppSuccess = value.Bool()
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.code":
+		x.Code = (LinkAckCode)(value.Enum())
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.reason":
+		x.Reason = value.Interface().(string)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.relayer":
+		x.Relayer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_acknowledgement":
+		panic(fmt.Errorf("field app_acknowledgement of message srdtrk.linkedpackets.v1.LinkAcknowledgement is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_success":
+		panic(fmt.Errorf("field app_success of message srdtrk.linkedpackets.v1.LinkAcknowledgement is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.code":
+		panic(fmt.Errorf("field code of message srdtrk.linkedpackets.v1.LinkAcknowledgement is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.reason":
+		panic(fmt.Errorf("field reason of message srdtrk.linkedpackets.v1.LinkAcknowledgement is not mutable"))
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.relayer":
+		panic(fmt.Errorf("field relayer of message srdtrk.linkedpackets.v1.LinkAcknowledgement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LinkAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_acknowledgement":
+		return protoreflect.ValueOfBytes(nil)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.app_success":
+		return protoreflect.ValueOfBool(false)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.code":
+		return protoreflect.ValueOfEnum(0)
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.reason":
+		return protoreflect.ValueOfString("")
+	case "srdtrk.linkedpackets.v1.LinkAcknowledgement.relayer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.LinkAcknowledgement"))
+		}
+		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.LinkAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LinkAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.LinkAcknowledgement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LinkAcknowledgement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinkAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LinkAcknowledgement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LinkAcknowledgement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LinkAcknowledgement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AppAcknowledgement)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AppSuccess {
+			n += 2
+		}
+		if x.Code != 0 {
+			n += 1 + runtime.Sov(uint64(x.Code))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Relayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LinkAcknowledgement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Code != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Code))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AppSuccess {
+			i--
+			if x.AppSuccess {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.AppAcknowledgement) > 0 {
+			i -= len(x.AppAcknowledgement)
+			copy(dAtA[i:], x.AppAcknowledgement)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppAcknowledgement)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LinkAcknowledgement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkAcknowledgement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppAcknowledgement", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppAcknowledgement = append(x.AppAcknowledgement[:0], dAtA[iNdEx:postIndex]...)
+				if x.AppAcknowledgement == nil {
+					x.AppAcknowledgement = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppSuccess", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AppSuccess = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				x.Code = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Code |= LinkAckCode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: srdtrk/linkedpackets/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExpiredDepositAction defines what happens to the deposit of a link which expires before it is closed.
+type ExpiredDepositAction int32
+
+const (
+	// EXPIRED_DEPOSIT_ACTION_BURN burns the deposit.
+	ExpiredDepositAction_EXPIRED_DEPOSIT_ACTION_BURN ExpiredDepositAction = 0
+	// EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL sends the deposit to the community
+	// pool.
+	ExpiredDepositAction_EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL ExpiredDepositAction = 1
+)
+
+// Enum value maps for ExpiredDepositAction.
+var (
+	ExpiredDepositAction_name = map[int32]string{
+		0: "EXPIRED_DEPOSIT_ACTION_BURN",
+		1: "EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL",
+	}
+	ExpiredDepositAction_value = map[string]int32{
+		"EXPIRED_DEPOSIT_ACTION_BURN":           0,
+		"EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL": 1,
+	}
+)
+
+func (x ExpiredDepositAction) Enum() *ExpiredDepositAction {
+	p := new(ExpiredDepositAction)
+	*p = x
+	return p
+}
+
+func (x ExpiredDepositAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpiredDepositAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_srdtrk_linkedpackets_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (ExpiredDepositAction) Type() protoreflect.EnumType {
+	return &file_srdtrk_linkedpackets_v1_types_proto_enumTypes[0]
+}
+
+func (x ExpiredDepositAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpiredDepositAction.Descriptor instead.
+func (ExpiredDepositAction) EnumDescriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// LinkStatus defines the lifecycle status of a link on the sending chain.
+type LinkStatus int32
+
+const (
+	// LINK_STATUS_UNSPECIFIED defines a no-op status.
+	LinkStatus_LINK_STATUS_UNSPECIFIED LinkStatus = 0
+	// LINK_STATUS_OPEN defines a link which is still accepting packets.
+	LinkStatus_LINK_STATUS_OPEN LinkStatus = 1
+	// LINK_STATUS_PENDING defines a closed link which is waiting for the outcome
+	// of one or more of its packets.
+	LinkStatus_LINK_STATUS_PENDING LinkStatus = 2
+	// LINK_STATUS_SUCCEEDED defines a link whose packets were all acknowledged
+	// successfully.
+	LinkStatus_LINK_STATUS_SUCCEEDED LinkStatus = 3
+	// LINK_STATUS_FAILED defines a link whose packets all failed, either with an
+	// error acknowledgement or a timeout.
+	LinkStatus_LINK_STATUS_FAILED LinkStatus = 4
+	// LINK_STATUS_PARTIALLY_FAILED defines a link where some packets succeeded
+	// and some failed.
+	LinkStatus_LINK_STATUS_PARTIALLY_FAILED LinkStatus = 5
+)
+
+// Enum value maps for LinkStatus.
+var (
+	LinkStatus_name = map[int32]string{
+		0: "LINK_STATUS_UNSPECIFIED",
+		1: "LINK_STATUS_OPEN",
+		2: "LINK_STATUS_PENDING",
+		3: "LINK_STATUS_SUCCEEDED",
+		4: "LINK_STATUS_FAILED",
+		5: "LINK_STATUS_PARTIALLY_FAILED",
+	}
+	LinkStatus_value = map[string]int32{
+		"LINK_STATUS_UNSPECIFIED":      0,
+		"LINK_STATUS_OPEN":             1,
+		"LINK_STATUS_PENDING":          2,
+		"LINK_STATUS_SUCCEEDED":        3,
+		"LINK_STATUS_FAILED":           4,
+		"LINK_STATUS_PARTIALLY_FAILED": 5,
+	}
+)
+
+func (x LinkStatus) Enum() *LinkStatus {
+	p := new(LinkStatus)
+	*p = x
+	return p
+}
+
+func (x LinkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_srdtrk_linkedpackets_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (LinkStatus) Type() protoreflect.EnumType {
+	return &file_srdtrk_linkedpackets_v1_types_proto_enumTypes[1]
+}
+
+func (x LinkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkStatus.Descriptor instead.
+func (LinkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// PacketOutcome defines the outcome of a single packet within a link.
+type PacketOutcome int32
+
+const (
+	// PACKET_OUTCOME_UNSPECIFIED defines a packet that has neither been
+	// acknowledged nor timed out yet.
+	PacketOutcome_PACKET_OUTCOME_UNSPECIFIED PacketOutcome = 0
+	// PACKET_OUTCOME_SUCCESS defines a packet acknowledged with a success
+	// acknowledgement.
+	PacketOutcome_PACKET_OUTCOME_SUCCESS PacketOutcome = 1
+	// PACKET_OUTCOME_ERROR defines a packet acknowledged with an error
+	// acknowledgement.
+	PacketOutcome_PACKET_OUTCOME_ERROR PacketOutcome = 2
+	// PACKET_OUTCOME_TIMEOUT defines a packet that timed out.
+	PacketOutcome_PACKET_OUTCOME_TIMEOUT PacketOutcome = 3
+	// PACKET_OUTCOME_LINK_ERROR defines a packet rejected by the link rules of
+	// the receiving chain.
+	PacketOutcome_PACKET_OUTCOME_LINK_ERROR PacketOutcome = 4
+	// PACKET_OUTCOME_FORCE_FAILED defines a packet whose link was force failed by
+	// the authority before the packet was acknowledged.
+	PacketOutcome_PACKET_OUTCOME_FORCE_FAILED PacketOutcome = 5
+)
+
+// Enum value maps for PacketOutcome.
+var (
+	PacketOutcome_name = map[int32]string{
+		0: "PACKET_OUTCOME_UNSPECIFIED",
+		1: "PACKET_OUTCOME_SUCCESS",
+		2: "PACKET_OUTCOME_ERROR",
+		3: "PACKET_OUTCOME_TIMEOUT",
+		4: "PACKET_OUTCOME_LINK_ERROR",
+		5: "PACKET_OUTCOME_FORCE_FAILED",
+	}
+	PacketOutcome_value = map[string]int32{
+		"PACKET_OUTCOME_UNSPECIFIED":  0,
+		"PACKET_OUTCOME_SUCCESS":      1,
+		"PACKET_OUTCOME_ERROR":        2,
+		"PACKET_OUTCOME_TIMEOUT":      3,
+		"PACKET_OUTCOME_LINK_ERROR":   4,
+		"PACKET_OUTCOME_FORCE_FAILED": 5,
+	}
+)
+
+func (x PacketOutcome) Enum() *PacketOutcome {
+	p := new(PacketOutcome)
+	*p = x
+	return p
+}
+
+func (x PacketOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PacketOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_srdtrk_linkedpackets_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (PacketOutcome) Type() protoreflect.EnumType {
+	return &file_srdtrk_linkedpackets_v1_types_proto_enumTypes[2]
+}
+
+func (x PacketOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PacketOutcome.Descriptor instead.
+func (PacketOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// LinkAckCode defines the result of the link rules applied by the receiving chain to a packet.
+type LinkAckCode int32
+
+const (
+	// LINK_ACK_CODE_UNSPECIFIED defines an unknown result.
+	LinkAckCode_LINK_ACK_CODE_UNSPECIFIED LinkAckCode = 0
+	// LINK_ACK_CODE_OK defines a packet accepted by the link rules and passed to
+	// the application.
+	LinkAckCode_LINK_ACK_CODE_OK LinkAckCode = 1
+	// LINK_ACK_CODE_BROKEN_CHAIN defines a linked packet whose previous packet
+	// was not received.
+	LinkAckCode_LINK_ACK_CODE_BROKEN_CHAIN LinkAckCode = 2
+	// LINK_ACK_CODE_EXPIRED defines a linked packet received after its link
+	// expired.
+	LinkAckCode_LINK_ACK_CODE_EXPIRED LinkAckCode = 3
+	// LINK_ACK_CODE_POLICY_VIOLATION defines a linked packet rejected by a
+	// policy of the receiving chain.
+	LinkAckCode_LINK_ACK_CODE_POLICY_VIOLATION LinkAckCode = 4
+	// LINK_ACK_CODE_EXECUTION_FAILED defines a linked packet executed atomically
+	// with the other packets of its link, one of which failed.
+	LinkAckCode_LINK_ACK_CODE_EXECUTION_FAILED LinkAckCode = 5
+	// LINK_ACK_CODE_FORCE_CLOSED defines a buffered linked packet whose link was
+	// force closed by the authority of the receiving chain before it was executed.
+	LinkAckCode_LINK_ACK_CODE_FORCE_CLOSED LinkAckCode = 6
+)
+
+// Enum value maps for LinkAckCode.
+var (
+	LinkAckCode_name = map[int32]string{
+		0: "LINK_ACK_CODE_UNSPECIFIED",
+		1: "LINK_ACK_CODE_OK",
+		2: "LINK_ACK_CODE_BROKEN_CHAIN",
+		3: "LINK_ACK_CODE_EXPIRED",
+		4: "LINK_ACK_CODE_POLICY_VIOLATION",
+		5: "LINK_ACK_CODE_EXECUTION_FAILED",
+		6: "LINK_ACK_CODE_FORCE_CLOSED",
+	}
+	LinkAckCode_value = map[string]int32{
+		"LINK_ACK_CODE_UNSPECIFIED":      0,
+		"LINK_ACK_CODE_OK":               1,
+		"LINK_ACK_CODE_BROKEN_CHAIN":     2,
+		"LINK_ACK_CODE_EXPIRED":          3,
+		"LINK_ACK_CODE_POLICY_VIOLATION": 4,
+		"LINK_ACK_CODE_EXECUTION_FAILED": 5,
+		"LINK_ACK_CODE_FORCE_CLOSED":     6,
+	}
+)
+
+func (x LinkAckCode) Enum() *LinkAckCode {
+	p := new(LinkAckCode)
+	*p = x
+	return p
+}
+
+func (x LinkAckCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkAckCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_srdtrk_linkedpackets_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (LinkAckCode) Type() protoreflect.EnumType {
+	return &file_srdtrk_linkedpackets_v1_types_proto_enumTypes[3]
+}
+
+func (x LinkAckCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkAckCode.Descriptor instead.
+func (LinkAckCode) EnumDescriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+// Params defines the parameters of the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// linking_enabled enables opening links and sending linked packets on this chain.
+	LinkingEnabled bool `protobuf:"varint,1,opt,name=linking_enabled,json=linkingEnabled,proto3" json:"linking_enabled,omitempty"`
+	// max_link_packets is the maximum number of packets sent as part of a link. Zero means no limit.
+	MaxLinkPackets uint64 `protobuf:"varint,2,opt,name=max_link_packets,json=maxLinkPackets,proto3" json:"max_link_packets,omitempty"`
+	// max_open_links_per_account is the maximum number of links an account can have open or pending at the
+	// same time. Zero means no limit.
+	MaxOpenLinksPerAccount uint64 `protobuf:"varint,3,opt,name=max_open_links_per_account,json=maxOpenLinksPerAccount,proto3" json:"max_open_links_per_account,omitempty"`
+	// link_expiry_blocks is the number of blocks after which an open link no longer accepts packets. Zero means
+	// links never expire.
+	LinkExpiryBlocks uint64 `protobuf:"varint,4,opt,name=link_expiry_blocks,json=linkExpiryBlocks,proto3" json:"link_expiry_blocks,omitempty"`
+	// allowed_packet_data_types are the packet data types which can be sent as part of a link.
+	AllowedPacketDataTypes []string `protobuf:"bytes,5,rep,name=allowed_packet_data_types,json=allowedPacketDataTypes,proto3" json:"allowed_packet_data_types,omitempty"`
+	// link_deposit is the refundable deposit escrowed from the owner when a link is opened. An empty deposit
+	// means opening a link is free.
+	LinkDeposit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=link_deposit,json=linkDeposit,proto3" json:"link_deposit,omitempty"`
+	// expired_deposit_action defines what happens to the deposit of a link which expires.
+	ExpiredDepositAction ExpiredDepositAction `protobuf:"varint,7,opt,name=expired_deposit_action,json=expiredDepositAction,proto3,enum=srdtrk.linkedpackets.v1.ExpiredDepositAction" json:"expired_deposit_action,omitempty"`
+	// rate_limit_window_blocks is the number of blocks of the windows over which the rate limits are counted. Zero
+	// disables the rate limits.
+	RateLimitWindowBlocks uint64 `protobuf:"varint,8,opt,name=rate_limit_window_blocks,json=rateLimitWindowBlocks,proto3" json:"rate_limit_window_blocks,omitempty"`
+	// max_links_per_account_window is the maximum number of links an account can open per rate limit window. Zero
+	// means no limit.
+	MaxLinksPerAccountWindow uint64 `protobuf:"varint,9,opt,name=max_links_per_account_window,json=maxLinksPerAccountWindow,proto3" json:"max_links_per_account_window,omitempty"`
+	// max_linked_packets_per_channel_window is the maximum number of linked packets sent on a channel per rate
+	// limit window. Zero means no limit.
+	MaxLinkedPacketsPerChannelWindow uint64 `protobuf:"varint,10,opt,name=max_linked_packets_per_channel_window,json=maxLinkedPacketsPerChannelWindow,proto3" json:"max_linked_packets_per_channel_window,omitempty"`
+	// resolved_link_retention_blocks is the number of blocks a resolved link is kept so that it can be queried
+	// before it is pruned. Zero means resolved links are pruned at the end of the block they are resolved in.
+	ResolvedLinkRetentionBlocks uint64 `protobuf:"varint,11,opt,name=resolved_link_retention_blocks,json=resolvedLinkRetentionBlocks,proto3" json:"resolved_link_retention_blocks,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetLinkingEnabled() bool {
+	if x != nil {
+		return x.LinkingEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMaxLinkPackets() uint64 {
+	if x != nil {
+		return x.MaxLinkPackets
+	}
+	return 0
+}
+
+func (x *Params) GetMaxOpenLinksPerAccount() uint64 {
+	if x != nil {
+		return x.MaxOpenLinksPerAccount
+	}
+	return 0
+}
+
+func (x *Params) GetLinkExpiryBlocks() uint64 {
+	if x != nil {
+		return x.LinkExpiryBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedPacketDataTypes() []string {
+	if x != nil {
+		return x.AllowedPacketDataTypes
+	}
+	return nil
+}
+
+func (x *Params) GetLinkDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.LinkDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetExpiredDepositAction() ExpiredDepositAction {
+	if x != nil {
+		return x.ExpiredDepositAction
+	}
+	return ExpiredDepositAction_EXPIRED_DEPOSIT_ACTION_BURN
+}
+
+func (x *Params) GetRateLimitWindowBlocks() uint64 {
+	if x != nil {
+		return x.RateLimitWindowBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxLinksPerAccountWindow() uint64 {
+	if x != nil {
+		return x.MaxLinksPerAccountWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxLinkedPacketsPerChannelWindow() uint64 {
+	if x != nil {
+		return x.MaxLinkedPacketsPerChannelWindow
+	}
+	return 0
+}
+
+func (x *Params) GetResolvedLinkRetentionBlocks() uint64 {
+	if x != nil {
+		return x.ResolvedLinkRetentionBlocks
+	}
+	return 0
+}
+
+// Metadata defines the linked-packets specific metadata encoded into the channel version bytestring
+// See ICS004: https://github.com/cosmos/ibc/tree/master/spec/core/ics-004-channel-and-packet-semantics#Versioning
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// linked_packets_version defines the linked-packets version
+	LinkedPacketsVersion string `protobuf:"bytes,1,opt,name=linked_packets_version,json=linkedPacketsVersion,proto3" json:"linked_packets_version,omitempty"`
+	// app_version defines the underlying application version, which may or may not be a JSON encoded bytestring
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Metadata) GetLinkedPacketsVersion() string {
+	if x != nil {
+		return x.LinkedPacketsVersion
+	}
+	return ""
+}
+
+func (x *Metadata) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params defines all the parameters of the module.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// link_enabled_channels are the channels on which packet linking is enabled.
+	LinkEnabledChannels []*ChannelIdentifier `protobuf:"bytes,3,rep,name=link_enabled_channels,json=linkEnabledChannels,proto3" json:"link_enabled_channels,omitempty"`
+	// links are the links sent by this chain.
+	Links []*Link `protobuf:"bytes,6,rep,name=links,proto3" json:"links,omitempty"`
+	// packet_links are the links of the sent packets.
+	PacketLinks []*PacketLink `protobuf:"bytes,7,rep,name=packet_links,json=packetLinks,proto3" json:"packet_links,omitempty"`
+	// link_sequence is the sequence used to generate link identifiers.
+	LinkSequence uint64 `protobuf:"varint,8,opt,name=link_sequence,json=linkSequence,proto3" json:"link_sequence,omitempty"`
+	// sessions are the links being sent.
+	Sessions []*LinkSession `protobuf:"bytes,10,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// forwarded_link_packets are the last hops forwarding the linked packets received by this chain.
+	ForwardedLinkPackets []*ForwardedLinkPacket `protobuf:"bytes,11,rep,name=forwarded_link_packets,json=forwardedLinkPackets,proto3" json:"forwarded_link_packets,omitempty"`
+	// buffered_links are the links whose received interchain accounts packets are buffered until their last packet.
+	BufferedLinks []*BufferedLink `protobuf:"bytes,12,rep,name=buffered_links,json=bufferedLinks,proto3" json:"buffered_links,omitempty"`
+	// linking_paused is true if linking is paused chain-wide by the authority.
+	LinkingPaused bool `protobuf:"varint,13,opt,name=linking_paused,json=linkingPaused,proto3" json:"linking_paused,omitempty"`
+	// paused_channels are the channels on which linking is paused by the authority.
+	PausedChannels []*ChannelIdentifier `protobuf:"bytes,14,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels,omitempty"`
+	// account_rate_limits are the links opened by accounts in their last rate limit window.
+	AccountRateLimits []*AccountRateLimit `protobuf:"bytes,15,rep,name=account_rate_limits,json=accountRateLimits,proto3" json:"account_rate_limits,omitempty"`
+	// channel_rate_limits are the linked packets sent on channels in their last rate limit window.
+	ChannelRateLimits []*ChannelRateLimit `protobuf:"bytes,16,rep,name=channel_rate_limits,json=channelRateLimits,proto3" json:"channel_rate_limits,omitempty"`
+	// received_link_packets are the last packets received by this chain of the links whose last packet has not
+	// been received yet.
+	ReceivedLinkPackets []*ReceivedLinkPacket `protobuf:"bytes,17,rep,name=received_link_packets,json=receivedLinkPackets,proto3" json:"received_link_packets,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *GenesisState) GetLinkEnabledChannels() []*ChannelIdentifier {
+	if x != nil {
+		return x.LinkEnabledChannels
 	}
+	return nil
 }
 
-var (
-	md_PacketIdentifier            protoreflect.MessageDescriptor
-	fd_PacketIdentifier_port_id    protoreflect.FieldDescriptor
-	fd_PacketIdentifier_channel_id protoreflect.FieldDescriptor
-	fd_PacketIdentifier_seq        protoreflect.FieldDescriptor
-)
+func (x *GenesisState) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
 
-func init() {
-	file_srdtrk_linkedpackets_v1_types_proto_init()
-	md_PacketIdentifier = File_srdtrk_linkedpackets_v1_types_proto.Messages().ByName("PacketIdentifier")
-	fd_PacketIdentifier_port_id = md_PacketIdentifier.Fields().ByName("port_id")
-	fd_PacketIdentifier_channel_id = md_PacketIdentifier.Fields().ByName("channel_id")
-	fd_PacketIdentifier_seq = md_PacketIdentifier.Fields().ByName("seq")
+func (x *GenesisState) GetPacketLinks() []*PacketLink {
+	if x != nil {
+		return x.PacketLinks
+	}
+	return nil
 }
 
-var _ protoreflect.Message = (*fastReflection_PacketIdentifier)(nil)
+func (x *GenesisState) GetLinkSequence() uint64 {
+	if x != nil {
+		return x.LinkSequence
+	}
+	return 0
+}
 
-type fastReflection_PacketIdentifier PacketIdentifier
+func (x *GenesisState) GetSessions() []*LinkSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
 
-func (x *PacketIdentifier) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PacketIdentifier)(x)
+func (x *GenesisState) GetForwardedLinkPackets() []*ForwardedLinkPacket {
+	if x != nil {
+		return x.ForwardedLinkPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBufferedLinks() []*BufferedLink {
+	if x != nil {
+		return x.BufferedLinks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLinkingPaused() bool {
+	if x != nil {
+		return x.LinkingPaused
+	}
+	return false
+}
+
+func (x *GenesisState) GetPausedChannels() []*ChannelIdentifier {
+	if x != nil {
+		return x.PausedChannels
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAccountRateLimits() []*AccountRateLimit {
+	if x != nil {
+		return x.AccountRateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelRateLimits() []*ChannelRateLimit {
+	if x != nil {
+		return x.ChannelRateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReceivedLinkPackets() []*ReceivedLinkPacket {
+	if x != nil {
+		return x.ReceivedLinkPackets
+	}
+	return nil
+}
+
+// RateLimitUsage defines how much of a rate limit is used in a rate limit window.
+type RateLimitUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the index of the rate limit window, the block height divided by the window length.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// used is the number of links or linked packets counted in the window.
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *RateLimitUsage) Reset() {
+	*x = RateLimitUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitUsage) ProtoMessage() {}
+
+// Deprecated: Use RateLimitUsage.ProtoReflect.Descriptor instead.
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RateLimitUsage) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *RateLimitUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+// AccountRateLimit defines the links opened by an account in its last rate limit window.
+type AccountRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account is the address of the link owner.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// usage is the number of links opened in the window.
+	Usage *RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *AccountRateLimit) Reset() {
+	*x = AccountRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRateLimit) ProtoMessage() {}
+
+// Deprecated: Use AccountRateLimit.ProtoReflect.Descriptor instead.
+func (*AccountRateLimit) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccountRateLimit) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountRateLimit) GetUsage() *RateLimitUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// ChannelRateLimit defines the linked packets sent on a channel in its last rate limit window.
+type ChannelRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the channel the linked packets are sent on.
+	Channel *ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// usage is the number of linked packets sent in the window.
+	Usage *RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *ChannelRateLimit) Reset() {
+	*x = ChannelRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRateLimit) ProtoMessage() {}
+
+// Deprecated: Use ChannelRateLimit.ProtoReflect.Descriptor instead.
+func (*ChannelRateLimit) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChannelRateLimit) GetChannel() *ChannelIdentifier {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ChannelRateLimit) GetUsage() *RateLimitUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// RateLimitQuota defines the remaining quota of a rate limit in the current rate limit window.
+type RateLimitQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is the maximum number of links or linked packets per window. Zero means no limit.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// remaining is the number of links or linked packets which can still be counted in the current window.
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// window_end_height is the height of the first block of the next window, at which the quota is reset. It
+	// is zero if there is no limit.
+	WindowEndHeight uint64 `protobuf:"varint,3,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+}
+
+func (x *RateLimitQuota) Reset() {
+	*x = RateLimitQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitQuota) ProtoMessage() {}
+
+// Deprecated: Use RateLimitQuota.ProtoReflect.Descriptor instead.
+func (*RateLimitQuota) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RateLimitQuota) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetRemaining() uint64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetWindowEndHeight() uint64 {
+	if x != nil {
+		return x.WindowEndHeight
+	}
+	return 0
+}
+
+// LinkSession defines a link being sent by its owner.
+type LinkSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the identifier of the link being sent.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// prev_packet is the last packet sent as part of the link. It is empty if no packet was sent yet.
+	PrevPacket *PacketIdentifier `protobuf:"bytes,2,opt,name=prev_packet,json=prevPacket,proto3" json:"prev_packet,omitempty"`
+	// owner is the address sending the link.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// link_index is the link index of the next packet sent as part of the link.
+	LinkIndex uint64 `protobuf:"varint,4,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+}
+
+func (x *LinkSession) Reset() {
+	*x = LinkSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSession) ProtoMessage() {}
+
+// Deprecated: Use LinkSession.ProtoReflect.Descriptor instead.
+func (*LinkSession) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LinkSession) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *LinkSession) GetPrevPacket() *PacketIdentifier {
+	if x != nil {
+		return x.PrevPacket
+	}
+	return nil
+}
+
+func (x *LinkSession) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LinkSession) GetLinkIndex() uint64 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+// PacketLink defines the link a sent packet is a member of.
+type PacketLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// packet is the identifier of the packet.
+	Packet *PacketIdentifier `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 }
 
-func (x *PacketIdentifier) slowProtoReflect() protoreflect.Message {
-	mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *PacketLink) Reset() {
+	*x = PacketLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_PacketIdentifier_messageType fastReflection_PacketIdentifier_messageType
-var _ protoreflect.MessageType = fastReflection_PacketIdentifier_messageType{}
+func (x *PacketLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_PacketIdentifier_messageType struct{}
+func (*PacketLink) ProtoMessage() {}
 
-func (x fastReflection_PacketIdentifier_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PacketIdentifier)(nil)
-}
-func (x fastReflection_PacketIdentifier_messageType) New() protoreflect.Message {
-	return new(fastReflection_PacketIdentifier)
-}
-func (x fastReflection_PacketIdentifier_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PacketIdentifier
+// Deprecated: Use PacketLink.ProtoReflect.Descriptor instead.
+func (*PacketLink) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{8}
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_PacketIdentifier) Descriptor() protoreflect.MessageDescriptor {
-	return md_PacketIdentifier
+func (x *PacketLink) GetPacket() *PacketIdentifier {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PacketIdentifier) Type() protoreflect.MessageType {
-	return _fastReflection_PacketIdentifier_messageType
+func (x *PacketLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PacketIdentifier) New() protoreflect.Message {
-	return new(fastReflection_PacketIdentifier)
-}
+// ReceivedLinkPacket defines the last packet of a link received on a channel, which the next packet of the link
+// received on the same channel must follow.
+type ReceivedLinkPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_PacketIdentifier) Interface() protoreflect.ProtoMessage {
-	return (*PacketIdentifier)(x)
+	// channel is the channel on which the packets of the link are received.
+	Channel *ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// sequence is the sequence of the last packet of the link received on the channel.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_PacketIdentifier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PortId != "" {
-		value := protoreflect.ValueOfString(x.PortId)
-		if !f(fd_PacketIdentifier_port_id, value) {
-			return
-		}
-	}
-	if x.ChannelId != "" {
-		value := protoreflect.ValueOfString(x.ChannelId)
-		if !f(fd_PacketIdentifier_channel_id, value) {
-			return
-		}
-	}
-	if x.Seq != "" {
-		value := protoreflect.ValueOfString(x.Seq)
-		if !f(fd_PacketIdentifier_seq, value) {
-			return
-		}
+func (x *ReceivedLinkPacket) Reset() {
+	*x = ReceivedLinkPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_PacketIdentifier) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
-		return x.PortId != ""
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
-		return x.ChannelId != ""
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
-		return x.Seq != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
-	}
+func (x *ReceivedLinkPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PacketIdentifier) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
-		x.PortId = ""
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
-		x.ChannelId = ""
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
-		x.Seq = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
-	}
+func (*ReceivedLinkPacket) ProtoMessage() {}
+
+// Deprecated: Use ReceivedLinkPacket.ProtoReflect.Descriptor instead.
+func (*ReceivedLinkPacket) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{9}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PacketIdentifier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
-		value := x.PortId
-		return protoreflect.ValueOfString(value)
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
-		value := x.ChannelId
-		return protoreflect.ValueOfString(value)
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
-		value := x.Seq
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", descriptor.FullName()))
+func (x *ReceivedLinkPacket) GetChannel() *ChannelIdentifier {
+	if x != nil {
+		return x.Channel
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PacketIdentifier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
-		x.PortId = value.Interface().(string)
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
-		x.ChannelId = value.Interface().(string)
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
-		x.Seq = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+func (x *ReceivedLinkPacket) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PacketIdentifier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
-		panic(fmt.Errorf("field port_id of message srdtrk.linkedpackets.v1.PacketIdentifier is not mutable"))
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
-		panic(fmt.Errorf("field channel_id of message srdtrk.linkedpackets.v1.PacketIdentifier is not mutable"))
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
-		panic(fmt.Errorf("field seq of message srdtrk.linkedpackets.v1.PacketIdentifier is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
+func (x *ReceivedLinkPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
 	}
+	return 0
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PacketIdentifier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.port_id":
-		return protoreflect.ValueOfString("")
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.channel_id":
-		return protoreflect.ValueOfString("")
-	case "srdtrk.linkedpackets.v1.PacketIdentifier.seq":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: srdtrk.linkedpackets.v1.PacketIdentifier"))
-		}
-		panic(fmt.Errorf("message srdtrk.linkedpackets.v1.PacketIdentifier does not contain field %s", fd.FullName()))
-	}
+// ForwardedLinkPacket defines the last hop sent to forward a packet of a link received on a channel, e.g. by
+// the packet forward middleware. The next hop of the link is linked to it.
+type ForwardedLinkPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the channel on which the packets of the link are received.
+	Channel *ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// last_hop is the last packet sent to forward a packet of the link.
+	LastHop *PacketIdentifier `protobuf:"bytes,3,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PacketIdentifier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in srdtrk.linkedpackets.v1.PacketIdentifier", d.FullName()))
+func (x *ForwardedLinkPacket) Reset() {
+	*x = ForwardedLinkPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	panic("unreachable")
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PacketIdentifier) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *ForwardedLinkPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PacketIdentifier) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
-}
+func (*ForwardedLinkPacket) ProtoMessage() {}
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_PacketIdentifier) IsValid() bool {
-	return x != nil
+// Deprecated: Use ForwardedLinkPacket.ProtoReflect.Descriptor instead.
+func (*ForwardedLinkPacket) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ForwardedLinkPacket) GetChannel() *ChannelIdentifier {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_PacketIdentifier) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PacketIdentifier)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.PortId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ChannelId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Seq)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *ForwardedLinkPacket) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
 	}
+	return ""
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PacketIdentifier)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Seq) > 0 {
-			i -= len(x.Seq)
-			copy(dAtA[i:], x.Seq)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seq)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.ChannelId) > 0 {
-			i -= len(x.ChannelId)
-			copy(dAtA[i:], x.ChannelId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.PortId) > 0 {
-			i -= len(x.PortId)
-			copy(dAtA[i:], x.PortId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *ForwardedLinkPacket) GetLastHop() *PacketIdentifier {
+	if x != nil {
+		return x.LastHop
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PacketIdentifier)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketIdentifier: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PortId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChannelId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Seq = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return nil
+}
+
+// BufferedLinkPacket defines a received linked packet whose execution is deferred until the last packet of its
+// link is received, so that the packets of the link are executed atomically.
+type BufferedLinkPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	// packet is the protobuf encoded ibc.core.channel.v1.Packet received.
+	Packet []byte `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	// relayer is the address of the relayer of the packet.
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (x *BufferedLinkPacket) Reset() {
+	*x = BufferedLinkPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+}
+
+func (x *BufferedLinkPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferedLinkPacket) ProtoMessage() {}
+
+// Deprecated: Use BufferedLinkPacket.ProtoReflect.Descriptor instead.
+func (*BufferedLinkPacket) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BufferedLinkPacket) GetPacket() []byte {
+	if x != nil {
+		return x.Packet
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: srdtrk/linkedpackets/v1/types.proto
+func (x *BufferedLinkPacket) GetRelayer() string {
+	if x != nil {
+		return x.Relayer
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+// BufferedLinkPackets defines the buffered packets of a link received on a channel, in link order.
+type BufferedLinkPackets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// Params defines the parameters of the module.
-type Params struct {
+	// packets are the buffered packets of the link.
+	Packets []*BufferedLinkPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+}
+
+func (x *BufferedLinkPackets) Reset() {
+	*x = BufferedLinkPackets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferedLinkPackets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferedLinkPackets) ProtoMessage() {}
+
+// Deprecated: Use BufferedLinkPackets.ProtoReflect.Descriptor instead.
+func (*BufferedLinkPackets) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BufferedLinkPackets) GetPackets() []*BufferedLinkPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+// BufferedLink defines the buffered packets of a link received on a channel.
+type BufferedLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// channel is the channel on which the packets of the link are received.
+	Channel *ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// packets are the buffered packets of the link, in link order.
+	Packets []*BufferedLinkPacket `protobuf:"bytes,3,rep,name=packets,proto3" json:"packets,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *BufferedLink) Reset() {
+	*x = BufferedLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[0]
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *BufferedLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferedLink) ProtoMessage() {}
+
+// Deprecated: Use BufferedLink.ProtoReflect.Descriptor instead.
+func (*BufferedLink) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BufferedLink) GetChannel() *ChannelIdentifier {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *BufferedLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *BufferedLink) GetPackets() []*BufferedLinkPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+// PacketIdentifier is the identifier for a packet.
+type PacketIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Seq       string `protobuf:"bytes,3,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *PacketIdentifier) Reset() {
+	*x = PacketIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketIdentifier) ProtoMessage() {}
+
+// Deprecated: Use PacketIdentifier.ProtoReflect.Descriptor instead.
+func (*PacketIdentifier) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PacketIdentifier) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *PacketIdentifier) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *PacketIdentifier) GetSeq() string {
+	if x != nil {
+		return x.Seq
+	}
+	return ""
+}
+
+// ChannelIdentifier defines the identifier of a channel end.
+type ChannelIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// port_id is the port identifier.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *ChannelIdentifier) Reset() {
+	*x = ChannelIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelIdentifier) ProtoMessage() {}
+
+// Deprecated: Use ChannelIdentifier.ProtoReflect.Descriptor instead.
+func (*ChannelIdentifier) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChannelIdentifier) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *ChannelIdentifier) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// Link defines the sending chain's record of a link and its packets.
+type Link struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// link_id is the link identifier.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address which opened the link.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// status is the current status of the link.
+	Status LinkStatus `protobuf:"varint,3,opt,name=status,proto3,enum=srdtrk.linkedpackets.v1.LinkStatus" json:"status,omitempty"`
+	// packets are the packets sent as part of the link, ordered by link index.
+	Packets []*LinkPacket `protobuf:"bytes,4,rep,name=packets,proto3" json:"packets,omitempty"`
+	// compensations are the compensating interchain account transactions of the link members.
+	Compensations []*Compensation `protobuf:"bytes,5,rep,name=compensations,proto3" json:"compensations,omitempty"`
+	// compensation_packets are the compensation packets sent after a member of the link failed,
+	// in the order they were sent.
+	CompensationPackets []*CompensationPacket `protobuf:"bytes,6,rep,name=compensation_packets,json=compensationPackets,proto3" json:"compensation_packets,omitempty"`
+	// open_time is the block time at which the link was opened.
+	OpenTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	// open_height is the block height at which the link was opened.
+	OpenHeight uint64 `protobuf:"varint,8,opt,name=open_height,json=openHeight,proto3" json:"open_height,omitempty"`
+	// deposit is the deposit escrowed when the link was opened. It is cleared once the deposit is refunded or
+	// forfeited.
+	Deposit []*v1beta1.Coin `protobuf:"bytes,9,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// relayer_reward is the reward escrowed when the link was opened and paid to the relayers of its packets
+	// once every packet is acknowledged. It is cleared once the reward is paid or refunded.
+	RelayerReward []*v1beta1.Coin `protobuf:"bytes,10,rep,name=relayer_reward,json=relayerReward,proto3" json:"relayer_reward,omitempty"`
+	// src_callback_address is the address of the contract called once the link is resolved, if any.
+	SrcCallbackAddress string `protobuf:"bytes,11,opt,name=src_callback_address,json=srcCallbackAddress,proto3" json:"src_callback_address,omitempty"`
+	// dest_callback_address is the address of the contract called on the receiving chain once the last
+	// packet of the link is received, if any.
+	DestCallbackAddress string `protobuf:"bytes,12,opt,name=dest_callback_address,json=destCallbackAddress,proto3" json:"dest_callback_address,omitempty"`
+	// atomic is true if the packets of the link are executed together by the interchain accounts host.
+	Atomic bool `protobuf:"varint,13,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// allowed_channels are the channels on which the packets of the link can be sent. Any link enabled channel
+	// can be used if empty.
+	AllowedChannels []*ChannelIdentifier `protobuf:"bytes,14,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// max_packets is the maximum number of packets sent as part of the link, if any.
+	MaxPackets uint64 `protobuf:"varint,15,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	// resolve_height is the block height at which the link was resolved. It is zero until the link is resolved.
+	ResolveHeight uint64 `protobuf:"varint,16,opt,name=resolve_height,json=resolveHeight,proto3" json:"resolve_height,omitempty"`
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Link) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*Link) ProtoMessage() {}
+
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Link) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *Link) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Link) GetStatus() LinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LinkStatus_LINK_STATUS_UNSPECIFIED
+}
+
+func (x *Link) GetPackets() []*LinkPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+func (x *Link) GetCompensations() []*Compensation {
+	if x != nil {
+		return x.Compensations
+	}
+	return nil
+}
+
+func (x *Link) GetCompensationPackets() []*CompensationPacket {
+	if x != nil {
+		return x.CompensationPackets
+	}
+	return nil
+}
+
+func (x *Link) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *Link) GetOpenHeight() uint64 {
+	if x != nil {
+		return x.OpenHeight
+	}
+	return 0
+}
+
+func (x *Link) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *Link) GetRelayerReward() []*v1beta1.Coin {
+	if x != nil {
+		return x.RelayerReward
+	}
+	return nil
+}
+
+func (x *Link) GetSrcCallbackAddress() string {
+	if x != nil {
+		return x.SrcCallbackAddress
+	}
+	return ""
+}
+
+func (x *Link) GetDestCallbackAddress() string {
+	if x != nil {
+		return x.DestCallbackAddress
+	}
+	return ""
+}
+
+func (x *Link) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *Link) GetAllowedChannels() []*ChannelIdentifier {
+	if x != nil {
+		return x.AllowedChannels
+	}
+	return nil
+}
+
+func (x *Link) GetMaxPackets() uint64 {
+	if x != nil {
+		return x.MaxPackets
+	}
+	return 0
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{0}
+func (x *Link) GetResolveHeight() uint64 {
+	if x != nil {
+		return x.ResolveHeight
+	}
+	return 0
 }
 
-// Metadata defines the linked-packets specific metadata encoded into the channel version bytestring
-// See ICS004: https://github.com/cosmos/ibc/tree/master/spec/core/ics-004-channel-and-packet-semantics#Versioning
-type Metadata struct {
+// LinkPacket defines a packet sent as part of a link and its outcome.
+type LinkPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// linked_packets_version defines the linked-packets version
-	LinkedPacketsVersion string `protobuf:"bytes,1,opt,name=linked_packets_version,json=linkedPacketsVersion,proto3" json:"linked_packets_version,omitempty"`
-	// app_version defines the underlying application version, which may or may not be a JSON encoded bytestring
-	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// packet is the identifier of the packet.
+	Packet *PacketIdentifier `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	// outcome is the outcome of the packet.
+	Outcome PacketOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=srdtrk.linkedpackets.v1.PacketOutcome" json:"outcome,omitempty"`
+	// relayer is the address of the relayer recorded in the acknowledgement of the packet. It may be encoded with
+	// the bech32 prefix of the receiving chain.
+	Relayer string `protobuf:"bytes,3,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
-func (x *Metadata) Reset() {
-	*x = Metadata{}
+func (x *LinkPacket) Reset() {
+	*x = LinkPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[1]
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Metadata) String() string {
+func (x *LinkPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadata) ProtoMessage() {}
+func (*LinkPacket) ProtoMessage() {}
 
-// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
-func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use LinkPacket.ProtoReflect.Descriptor instead.
+func (*LinkPacket) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Metadata) GetLinkedPacketsVersion() string {
+func (x *LinkPacket) GetPacket() *PacketIdentifier {
 	if x != nil {
-		return x.LinkedPacketsVersion
+		return x.Packet
 	}
-	return ""
+	return nil
 }
 
-func (x *Metadata) GetAppVersion() string {
+func (x *LinkPacket) GetOutcome() PacketOutcome {
 	if x != nil {
-		return x.AppVersion
+		return x.Outcome
+	}
+	return PacketOutcome_PACKET_OUTCOME_UNSPECIFIED
+}
+
+func (x *LinkPacket) GetRelayer() string {
+	if x != nil {
+		return x.Relayer
 	}
 	return ""
 }
 
-// Counter defines a counter object.
-// It is used only for genesis purposes. Collections does not need to use it.
-type Counter struct {
+// Compensation defines an interchain account transaction which undoes the effects of a link member.
+// It is sent on the channel of the member if the member succeeded and another member of the link failed.
+type Compensation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// count defines the count of the counter.
-	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// address defines the address that is associated with the count.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// link_index is the index of the compensated member in the link.
+	LinkIndex uint64 `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// messages are the messages executed by the interchain account, as in an ICA CosmosTx.
+	Messages []*anypb.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *Counter) Reset() {
-	*x = Counter{}
+func (x *Compensation) Reset() {
+	*x = Compensation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[2]
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Counter) String() string {
+func (x *Compensation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Counter) ProtoMessage() {}
+func (*Compensation) ProtoMessage() {}
 
-// Deprecated: Use Counter.ProtoReflect.Descriptor instead.
-func (*Counter) Descriptor() ([]byte, []int) {
-	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Compensation.ProtoReflect.Descriptor instead.
+func (*Compensation) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Counter) GetCount() uint64 {
+func (x *Compensation) GetLinkIndex() uint64 {
 	if x != nil {
-		return x.Count
+		return x.LinkIndex
 	}
 	return 0
 }
 
-func (x *Counter) GetAddress() string {
+func (x *Compensation) GetMessages() []*anypb.Any {
 	if x != nil {
-		return x.Address
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-// GenesisState is the state that must be provided at genesis.
-type GenesisState struct {
+// CompensationPacket defines a compensation packet sent for a link member and its outcome.
+type CompensationPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// counter defines the counter object.
-	Counters []*Counter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
-	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// link_index is the index of the compensated member in the link.
+	LinkIndex uint64 `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the compensation packet.
+	Packet *PacketIdentifier `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`
+	// outcome is the outcome of the compensation packet.
+	Outcome PacketOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=srdtrk.linkedpackets.v1.PacketOutcome" json:"outcome,omitempty"`
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
+func (x *CompensationPacket) Reset() {
+	*x = CompensationPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[3]
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenesisState) String() string {
+func (x *CompensationPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenesisState) ProtoMessage() {}
+func (*CompensationPacket) ProtoMessage() {}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CompensationPacket.ProtoReflect.Descriptor instead.
+func (*CompensationPacket) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GenesisState) GetCounters() []*Counter {
+func (x *CompensationPacket) GetLinkIndex() uint64 {
 	if x != nil {
-		return x.Counters
+		return x.LinkIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *GenesisState) GetParams() *Params {
+func (x *CompensationPacket) GetPacket() *PacketIdentifier {
 	if x != nil {
-		return x.Params
+		return x.Packet
 	}
 	return nil
 }
 
-// PacketIdentifier is the identifier for a packet.
-type PacketIdentifier struct {
+func (x *CompensationPacket) GetOutcome() PacketOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PacketOutcome_PACKET_OUTCOME_UNSPECIFIED
+}
+
+// LinkAcknowledgement is the acknowledgement written by the middleware on link enabled channels.
+// It wraps the acknowledgement of the underlying application with the result of the link rules.
+type LinkAcknowledgement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Seq       string `protobuf:"bytes,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	// app_acknowledgement is the acknowledgement of the underlying application.
+	AppAcknowledgement []byte `protobuf:"bytes,1,opt,name=app_acknowledgement,json=appAcknowledgement,proto3" json:"app_acknowledgement,omitempty"`
+	// app_success is true if the underlying application acknowledgement is a
+	// success acknowledgement.
+	AppSuccess bool `protobuf:"varint,2,opt,name=app_success,json=appSuccess,proto3" json:"app_success,omitempty"`
+	// code is the result of the link rules.
+	Code LinkAckCode `protobuf:"varint,3,opt,name=code,proto3,enum=srdtrk.linkedpackets.v1.LinkAckCode" json:"code,omitempty"`
+	// reason describes why the packet was rejected by the link rules, if it was.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// relayer is the address rewarded for relaying the packet. It is the forward relayer address registered
+	// with the fee middleware if there is one, otherwise the address of the relayer on the receiving chain.
+	Relayer string `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
-func (x *PacketIdentifier) Reset() {
-	*x = PacketIdentifier{}
+func (x *LinkAcknowledgement) Reset() {
+	*x = LinkAcknowledgement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[4]
+		mi := &file_srdtrk_linkedpackets_v1_types_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PacketIdentifier) String() string {
+func (x *LinkAcknowledgement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PacketIdentifier) ProtoMessage() {}
+func (*LinkAcknowledgement) ProtoMessage() {}
 
-// Deprecated: Use PacketIdentifier.ProtoReflect.Descriptor instead.
-func (*PacketIdentifier) Descriptor() ([]byte, []int) {
-	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LinkAcknowledgement.ProtoReflect.Descriptor instead.
+func (*LinkAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PacketIdentifier) GetPortId() string {
+func (x *LinkAcknowledgement) GetAppAcknowledgement() []byte {
 	if x != nil {
-		return x.PortId
+		return x.AppAcknowledgement
 	}
-	return ""
+	return nil
 }
 
-func (x *PacketIdentifier) GetChannelId() string {
+func (x *LinkAcknowledgement) GetAppSuccess() bool {
 	if x != nil {
-		return x.ChannelId
+		return x.AppSuccess
+	}
+	return false
+}
+
+func (x *LinkAcknowledgement) GetCode() LinkAckCode {
+	if x != nil {
+		return x.Code
+	}
+	return LinkAckCode_LINK_ACK_CODE_UNSPECIFIED
+}
+
+func (x *LinkAcknowledgement) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *PacketIdentifier) GetSeq() string {
+func (x *LinkAcknowledgement) GetRelayer() string {
 	if x != nil {
-		return x.Seq
+		return x.Relayer
 	}
 	return ""
 }
@@ -2687,7 +16046,60 @@ var file_srdtrk_linkedpackets_v1_types_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x20, 0x8a, 0xe7,
+	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d,
+	0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x1a,
+	0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x50, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x6e,
+	0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x63, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a,
+	0x18, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x61,
+	0x78, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4f, 0x0a, 0x25, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x20, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x43, 0x0a, 0x1e, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x20, 0x8a, 0xe7,
 	0xb0, 0x2a, 0x1b, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
 	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x61,
 	0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x69,
@@ -2696,46 +16108,394 @@ var file_srdtrk_linkedpackets_v1_types_proto_rawDesc = []byte{
 	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x76, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x73, 0x72, 0x64,
-	0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
+	0x6e, 0x22, 0xe1, 0x08, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x69, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x6c, 0x69,
+	0x6e, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x3e, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x51, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x69, 0x6e,
+	0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x72,
+	0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6d, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x0e, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64,
+	0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0d, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x5e, 0x0a, 0x0f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x64, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x13, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x6a, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4a, 0x04, 0x08,
+	0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a,
+	0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x3c, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x72,
+	0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4f, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
 	0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
-	0x72, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x73, 0x65, 0x71, 0x42, 0xf4, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x72,
+	0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x48, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x72,
 	0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31,
-	0x3b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x53, 0x4c, 0x58, 0xaa, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x53, 0x72, 0x64,
-	0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x19, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x3a, 0x3a, 0x4c, 0x69, 0x6e, 0x6b, 0x65,
-	0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x11,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x55, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x76, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69,
+	0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x73, 0x0a, 0x0a, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x4c, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x4f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x13, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x4f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x22, 0x60, 0x0a, 0x12,
+	0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x67,
+	0x0a, 0x13, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x4f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74,
+	0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b,
+	0x49, 0x64, 0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0x5c, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x22, 0x4b, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22,
+	0x99, 0x08, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70,
+	0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x14, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x72, 0x64, 0x74,
+	0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x46, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6f, 0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x76, 0x0a, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x72, 0x63, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x72, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x12, 0x5b, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x0a,
+	0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4c, 0x0a, 0x06, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x72, 0x64, 0x74,
+	0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x22, 0x5f, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x06, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x72,
+	0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x13,
+	0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x12, 0x61, 0x70, 0x70, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2a, 0xad, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20,
+	0x18, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x50, 0x0a, 0x25, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f,
+	0x4f, 0x4c, 0x10, 0x01, 0x1a, 0x25, 0x8a, 0x9d, 0x20, 0x21, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x2a, 0xc7, 0x02, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x36, 0x0a, 0x17, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x19, 0x8a,
+	0x9d, 0x20, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x1a, 0x12,
+	0x8a, 0x9d, 0x20, 0x0e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x70,
+	0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x15, 0x8a, 0x9d, 0x20,
+	0x11, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x17, 0x8a,
+	0x9d, 0x20, 0x13, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x14,
+	0x8a, 0x9d, 0x20, 0x10, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x1a, 0x1d, 0x8a, 0x9d, 0x20, 0x19, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xe7, 0x02, 0x0a, 0x0d,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x1a, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x18, 0x8a,
+	0x9d, 0x20, 0x14, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x34, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
+	0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x1a, 0x18, 0x8a, 0x9d, 0x20,
+	0x14, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x04, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x3d, 0x0a, 0x1b, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x05, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xaa, 0x03, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63,
+	0x6b, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x43,
+	0x4b, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63,
+	0x6b, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x10, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x01, 0x1a, 0x11, 0x8a, 0x9d, 0x20, 0x0d, 0x4c, 0x69, 0x6e, 0x6b,
+	0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x4f, 0x4b, 0x12, 0x3a, 0x0a, 0x1a, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x4c,
+	0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x43,
+	0x4b, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
+	0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1e, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x1a, 0x1e, 0x8a, 0x9d,
+	0x20, 0x1a, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1e,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x1a, 0x1e, 0x8a, 0x9d, 0x20, 0x1a, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x3a, 0x0a, 0x1a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x06,
+	0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x64,
+	0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xf4, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x72, 0x64, 0x74, 0x72,
+	0x6b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x72, 0x64,
+	0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x53, 0x4c, 0x58, 0xaa, 0x02, 0x17, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17,
+	0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x53, 0x72, 0x64, 0x74, 0x72, 0x6b,
+	0x5c, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19,
+	0x53, 0x72, 0x64, 0x74, 0x72, 0x6b, 0x3a, 0x3a, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2750,22 +16510,82 @@ func file_srdtrk_linkedpackets_v1_types_proto_rawDescGZIP() []byte {
 	return file_srdtrk_linkedpackets_v1_types_proto_rawDescData
 }
 
-var file_srdtrk_linkedpackets_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_srdtrk_linkedpackets_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_srdtrk_linkedpackets_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_srdtrk_linkedpackets_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),           // 0: srdtrk.linkedpackets.v1.Params
-	(*Metadata)(nil),         // 1: srdtrk.linkedpackets.v1.Metadata
-	(*Counter)(nil),          // 2: srdtrk.linkedpackets.v1.Counter
-	(*GenesisState)(nil),     // 3: srdtrk.linkedpackets.v1.GenesisState
-	(*PacketIdentifier)(nil), // 4: srdtrk.linkedpackets.v1.PacketIdentifier
+	(ExpiredDepositAction)(0),     // 0: srdtrk.linkedpackets.v1.ExpiredDepositAction
+	(LinkStatus)(0),               // 1: srdtrk.linkedpackets.v1.LinkStatus
+	(PacketOutcome)(0),            // 2: srdtrk.linkedpackets.v1.PacketOutcome
+	(LinkAckCode)(0),              // 3: srdtrk.linkedpackets.v1.LinkAckCode
+	(*Params)(nil),                // 4: srdtrk.linkedpackets.v1.Params
+	(*Metadata)(nil),              // 5: srdtrk.linkedpackets.v1.Metadata
+	(*GenesisState)(nil),          // 6: srdtrk.linkedpackets.v1.GenesisState
+	(*RateLimitUsage)(nil),        // 7: srdtrk.linkedpackets.v1.RateLimitUsage
+	(*AccountRateLimit)(nil),      // 8: srdtrk.linkedpackets.v1.AccountRateLimit
+	(*ChannelRateLimit)(nil),      // 9: srdtrk.linkedpackets.v1.ChannelRateLimit
+	(*RateLimitQuota)(nil),        // 10: srdtrk.linkedpackets.v1.RateLimitQuota
+	(*LinkSession)(nil),           // 11: srdtrk.linkedpackets.v1.LinkSession
+	(*PacketLink)(nil),            // 12: srdtrk.linkedpackets.v1.PacketLink
+	(*ReceivedLinkPacket)(nil),    // 13: srdtrk.linkedpackets.v1.ReceivedLinkPacket
+	(*ForwardedLinkPacket)(nil),   // 14: srdtrk.linkedpackets.v1.ForwardedLinkPacket
+	(*BufferedLinkPacket)(nil),    // 15: srdtrk.linkedpackets.v1.BufferedLinkPacket
+	(*BufferedLinkPackets)(nil),   // 16: srdtrk.linkedpackets.v1.BufferedLinkPackets
+	(*BufferedLink)(nil),          // 17: srdtrk.linkedpackets.v1.BufferedLink
+	(*PacketIdentifier)(nil),      // 18: srdtrk.linkedpackets.v1.PacketIdentifier
+	(*ChannelIdentifier)(nil),     // 19: srdtrk.linkedpackets.v1.ChannelIdentifier
+	(*Link)(nil),                  // 20: srdtrk.linkedpackets.v1.Link
+	(*LinkPacket)(nil),            // 21: srdtrk.linkedpackets.v1.LinkPacket
+	(*Compensation)(nil),          // 22: srdtrk.linkedpackets.v1.Compensation
+	(*CompensationPacket)(nil),    // 23: srdtrk.linkedpackets.v1.CompensationPacket
+	(*LinkAcknowledgement)(nil),   // 24: srdtrk.linkedpackets.v1.LinkAcknowledgement
+	(*v1beta1.Coin)(nil),          // 25: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 26: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 27: google.protobuf.Any
 }
 var file_srdtrk_linkedpackets_v1_types_proto_depIdxs = []int32{
-	2, // 0: srdtrk.linkedpackets.v1.GenesisState.counters:type_name -> srdtrk.linkedpackets.v1.Counter
-	0, // 1: srdtrk.linkedpackets.v1.GenesisState.params:type_name -> srdtrk.linkedpackets.v1.Params
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	25, // 0: srdtrk.linkedpackets.v1.Params.link_deposit:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 1: srdtrk.linkedpackets.v1.Params.expired_deposit_action:type_name -> srdtrk.linkedpackets.v1.ExpiredDepositAction
+	4,  // 2: srdtrk.linkedpackets.v1.GenesisState.params:type_name -> srdtrk.linkedpackets.v1.Params
+	19, // 3: srdtrk.linkedpackets.v1.GenesisState.link_enabled_channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	20, // 4: srdtrk.linkedpackets.v1.GenesisState.links:type_name -> srdtrk.linkedpackets.v1.Link
+	12, // 5: srdtrk.linkedpackets.v1.GenesisState.packet_links:type_name -> srdtrk.linkedpackets.v1.PacketLink
+	11, // 6: srdtrk.linkedpackets.v1.GenesisState.sessions:type_name -> srdtrk.linkedpackets.v1.LinkSession
+	14, // 7: srdtrk.linkedpackets.v1.GenesisState.forwarded_link_packets:type_name -> srdtrk.linkedpackets.v1.ForwardedLinkPacket
+	17, // 8: srdtrk.linkedpackets.v1.GenesisState.buffered_links:type_name -> srdtrk.linkedpackets.v1.BufferedLink
+	19, // 9: srdtrk.linkedpackets.v1.GenesisState.paused_channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	8,  // 10: srdtrk.linkedpackets.v1.GenesisState.account_rate_limits:type_name -> srdtrk.linkedpackets.v1.AccountRateLimit
+	9,  // 11: srdtrk.linkedpackets.v1.GenesisState.channel_rate_limits:type_name -> srdtrk.linkedpackets.v1.ChannelRateLimit
+	13, // 12: srdtrk.linkedpackets.v1.GenesisState.received_link_packets:type_name -> srdtrk.linkedpackets.v1.ReceivedLinkPacket
+	7,  // 13: srdtrk.linkedpackets.v1.AccountRateLimit.usage:type_name -> srdtrk.linkedpackets.v1.RateLimitUsage
+	19, // 14: srdtrk.linkedpackets.v1.ChannelRateLimit.channel:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	7,  // 15: srdtrk.linkedpackets.v1.ChannelRateLimit.usage:type_name -> srdtrk.linkedpackets.v1.RateLimitUsage
+	18, // 16: srdtrk.linkedpackets.v1.LinkSession.prev_packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	18, // 17: srdtrk.linkedpackets.v1.PacketLink.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	19, // 18: srdtrk.linkedpackets.v1.ReceivedLinkPacket.channel:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	19, // 19: srdtrk.linkedpackets.v1.ForwardedLinkPacket.channel:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	18, // 20: srdtrk.linkedpackets.v1.ForwardedLinkPacket.last_hop:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	15, // 21: srdtrk.linkedpackets.v1.BufferedLinkPackets.packets:type_name -> srdtrk.linkedpackets.v1.BufferedLinkPacket
+	19, // 22: srdtrk.linkedpackets.v1.BufferedLink.channel:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	15, // 23: srdtrk.linkedpackets.v1.BufferedLink.packets:type_name -> srdtrk.linkedpackets.v1.BufferedLinkPacket
+	1,  // 24: srdtrk.linkedpackets.v1.Link.status:type_name -> srdtrk.linkedpackets.v1.LinkStatus
+	21, // 25: srdtrk.linkedpackets.v1.Link.packets:type_name -> srdtrk.linkedpackets.v1.LinkPacket
+	22, // 26: srdtrk.linkedpackets.v1.Link.compensations:type_name -> srdtrk.linkedpackets.v1.Compensation
+	23, // 27: srdtrk.linkedpackets.v1.Link.compensation_packets:type_name -> srdtrk.linkedpackets.v1.CompensationPacket
+	26, // 28: srdtrk.linkedpackets.v1.Link.open_time:type_name -> google.protobuf.Timestamp
+	25, // 29: srdtrk.linkedpackets.v1.Link.deposit:type_name -> cosmos.base.v1beta1.Coin
+	25, // 30: srdtrk.linkedpackets.v1.Link.relayer_reward:type_name -> cosmos.base.v1beta1.Coin
+	19, // 31: srdtrk.linkedpackets.v1.Link.allowed_channels:type_name -> srdtrk.linkedpackets.v1.ChannelIdentifier
+	18, // 32: srdtrk.linkedpackets.v1.LinkPacket.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	2,  // 33: srdtrk.linkedpackets.v1.LinkPacket.outcome:type_name -> srdtrk.linkedpackets.v1.PacketOutcome
+	27, // 34: srdtrk.linkedpackets.v1.Compensation.messages:type_name -> google.protobuf.Any
+	18, // 35: srdtrk.linkedpackets.v1.CompensationPacket.packet:type_name -> srdtrk.linkedpackets.v1.PacketIdentifier
+	2,  // 36: srdtrk.linkedpackets.v1.CompensationPacket.outcome:type_name -> srdtrk.linkedpackets.v1.PacketOutcome
+	3,  // 37: srdtrk.linkedpackets.v1.LinkAcknowledgement.code:type_name -> srdtrk.linkedpackets.v1.LinkAckCode
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_srdtrk_linkedpackets_v1_types_proto_init() }
@@ -2799,7 +16619,7 @@ func file_srdtrk_linkedpackets_v1_types_proto_init() {
 			}
 		}
 		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Counter); i {
+			switch v := v.(*GenesisState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2811,7 +16631,7 @@ func file_srdtrk_linkedpackets_v1_types_proto_init() {
 			}
 		}
 		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenesisState); i {
+			switch v := v.(*RateLimitUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2823,6 +16643,126 @@ func file_srdtrk_linkedpackets_v1_types_proto_init() {
 			}
 		}
 		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedLinkPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardedLinkPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferedLinkPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferedLinkPackets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferedLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PacketIdentifier); i {
 			case 0:
 				return &v.state
@@ -2834,19 +16774,92 @@ func file_srdtrk_linkedpackets_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compensation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompensationPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_srdtrk_linkedpackets_v1_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkAcknowledgement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_srdtrk_linkedpackets_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_srdtrk_linkedpackets_v1_types_proto_goTypes,
 		DependencyIndexes: file_srdtrk_linkedpackets_v1_types_proto_depIdxs,
+		EnumInfos:         file_srdtrk_linkedpackets_v1_types_proto_enumTypes,
 		MessageInfos:      file_srdtrk_linkedpackets_v1_types_proto_msgTypes,
 	}.Build()
 	File_srdtrk_linkedpackets_v1_types_proto = out.File
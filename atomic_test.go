@@ -0,0 +1,147 @@
+package linkedpackets_test
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/testutil"
+)
+
+func (s *LinkedPacketsTestSuite) TestAtomicICALink() {
+	testCases := []struct {
+		name         string
+		lastAmount   sdkmath.Int
+		expStatus    linkedpackets.LinkStatus
+		expOutcome   linkedpackets.PacketOutcome
+		expDelegated bool
+	}{
+		{
+			"success: the transactions of the link are executed together",
+			sdkmath.NewInt(5000),
+			linkedpackets.LinkStatusSucceeded,
+			linkedpackets.PacketOutcomeSuccess,
+			true,
+		},
+		{
+			"success: the transactions of the link are reverted if the last one fails",
+			sdkmath.NewInt(1_000_000_000),
+			linkedpackets.LinkStatusFailed,
+			linkedpackets.PacketOutcomeLinkError,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			icaAddr := s.SetupICATest()
+
+			res, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{
+				Sender: s.chainA.SenderAccount.GetAddress().String(),
+				LinkId: "mylinkid",
+				Atomic: true,
+			})
+			s.Require().NoError(err)
+			s.Require().NotEmpty(res)
+
+			packets := []channeltypes.Packet{
+				s.sendICADelegate(icaAddr, sdkmath.NewInt(5000), ""),
+				s.sendICADelegate(icaAddr, tc.lastAmount, linkedpackets.LastLinkMemoKey),
+			}
+
+			// the first packet is buffered until the last packet of the link is received
+			s.Require().NoError(s.path.EndpointB.UpdateClient())
+			recvRes, err := s.path.EndpointB.RecvPacketWithResult(packets[0])
+			s.Require().NoError(err)
+			_, err = ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+			s.Require().Error(err)
+			s.typedEvent(recvRes.GetEvents(), &linkedpackets.EventLinkPacketBuffered{})
+
+			_, err = GetSimApp(s.chainB).StakingKeeper.GetDelegation(s.chainB.GetContext(), sdk.MustAccAddressFromBech32(icaAddr), s.validatorB())
+			s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+			// both packets are acknowledged once the last packet is received
+			s.Require().NoError(s.path.EndpointB.UpdateClient())
+			recvRes, err = s.path.EndpointB.RecvPacketWithResult(packets[1])
+			s.Require().NoError(err)
+			s.typedEvent(recvRes.GetEvents(), &linkedpackets.EventLinkExecuted{})
+
+			acks := testutil.AcknowledgementsFromEvents(recvRes.GetEvents())
+			s.Require().Len(acks, len(packets))
+			s.Require().NoError(s.path.EndpointA.UpdateClient())
+			for _, packet := range packets {
+				s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, acks[packet.Sequence]))
+			}
+
+			_, err = GetSimApp(s.chainB).StakingKeeper.GetDelegation(s.chainB.GetContext(), sdk.MustAccAddressFromBech32(icaAddr), s.validatorB())
+			if tc.expDelegated {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+			}
+
+			testutil.RequireLinkStatus(s.T(), s.chainA, "mylinkid", tc.expStatus)
+			testutil.RequirePacketOutcomes(s.T(), s.chainA, "mylinkid", tc.expOutcome, tc.expOutcome)
+		})
+	}
+}
+
+func (s *LinkedPacketsTestSuite) TestAtomicLinkRejectsTransfer() {
+	s.SetupLinkedPacketsTransferTest()
+
+	res, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{
+		Sender: s.chainA.SenderAccount.GetAddress().String(),
+		LinkId: "mylinkid",
+		Atomic: true,
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res)
+
+	// only interchain accounts packets can be sent on an atomic link
+	s.Require().Equal(transfertypes.FungibleTokenPacketData{}, s.ExecuteTransfer(linkedpackets.LastLinkMemoKey))
+
+	link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Empty(link.Packets)
+}
+
+// sendICADelegate sends an interchain accounts transaction delegating the given amount to chainB's validator and
+// returns its packet.
+func (s *LinkedPacketsTestSuite) sendICADelegate(icaAddr string, amount sdkmath.Int, memo string) channeltypes.Packet {
+	packetData := s.buildICAMsgDelegatePacketData(icaAddr, memo)
+	data, err := icatypes.SerializeCosmosTx(GetSimApp(s.chainA).AppCodec(), []proto.Message{&stakingtypes.MsgDelegate{
+		DelegatorAddress: icaAddr,
+		ValidatorAddress: s.validatorB().String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, amount),
+	}}, icatypes.EncodingProtobuf)
+	s.Require().NoError(err)
+	packetData.Data = data
+
+	timeoutTimestamp := uint64(s.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	msg := icacontrollertypes.NewMsgSendTx(s.chainA.SenderAccount.GetAddress().String(), s.path.EndpointA.ConnectionID, timeoutTimestamp, packetData)
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	return packet
+}
+
+// validatorB returns the address of chainB's validator.
+func (s *LinkedPacketsTestSuite) validatorB() sdk.ValAddress {
+	return sdk.ValAddress(s.chainB.Vals.Validators[0].Address)
+}
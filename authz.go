@@ -0,0 +1,106 @@
+package linkedpackets
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = (*LinkAuthorization)(nil)
+
+// NewLinkAuthorization creates a new LinkAuthorization for the given message type. The channel and link length
+// restrictions only apply to MsgInitLink.
+func NewLinkAuthorization(authzType LinkAuthorizationType, allowedChannels []ChannelIdentifier, maxLinkPackets, uses uint64) *LinkAuthorization {
+	return &LinkAuthorization{
+		AuthorizationType: authzType,
+		AllowedChannels:   allowedChannels,
+		MaxLinkPackets:    maxLinkPackets,
+		RemainingUses:     uses,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization.MsgTypeURL.
+func (a LinkAuthorization) MsgTypeURL() string {
+	switch a.AuthorizationType {
+	case LinkAuthorizationTypeInitLink:
+		return sdk.MsgTypeURL(&MsgInitLink{})
+	case LinkAuthorizationTypeStopLink:
+		return sdk.MsgTypeURL(&MsgStopLink{})
+	default:
+		return ""
+	}
+}
+
+// Accept implements authz.Authorization.Accept. A MsgInitLink is accepted if the link it opens is restricted to
+// the allowed channels and link length of the authorization. The grantee cannot spend the funds of the granter
+// through the link, so relayer rewards and compensations are rejected.
+func (a LinkAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeURL() {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("%s is not authorized", sdk.MsgTypeURL(msg))
+	}
+
+	if msg, ok := msg.(*MsgInitLink); ok {
+		if err := a.acceptInitLink(msg); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
+	switch a.RemainingUses {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		a.RemainingUses--
+		return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+	}
+}
+
+// acceptInitLink checks that the link opened by msg complies with the authorization.
+func (a LinkAuthorization) acceptInitLink(msg *MsgInitLink) error {
+	if !msg.RelayerReward.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "relayer rewards cannot be escrowed on behalf of the granter")
+	}
+	if len(msg.Compensations) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "compensations cannot be sent on behalf of the granter")
+	}
+
+	if len(a.AllowedChannels) > 0 {
+		if len(msg.AllowedChannels) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "link must be restricted to the allowed channels")
+		}
+
+		for _, c := range msg.AllowedChannels {
+			if !slices.Contains(a.AllowedChannels, c) {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "channel %s/%s is not allowed", c.PortId, c.ChannelId)
+			}
+		}
+	}
+
+	if a.MaxLinkPackets > 0 && (msg.MaxPackets == 0 || msg.MaxPackets > a.MaxLinkPackets) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "link must be restricted to at most %d packets", a.MaxLinkPackets)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements authz.Authorization.ValidateBasic.
+func (a LinkAuthorization) ValidateBasic() error {
+	switch a.AuthorizationType {
+	case LinkAuthorizationTypeInitLink:
+		return ValidateChannels(a.AllowedChannels)
+	case LinkAuthorizationTypeStopLink:
+		if len(a.AllowedChannels) > 0 || a.MaxLinkPackets > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "link restrictions only apply to init link authorizations")
+		}
+
+		return nil
+	default:
+		return authz.ErrUnknownAuthorizationType
+	}
+}
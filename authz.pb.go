@@ -0,0 +1,498 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: srdtrk/linkedpackets/v1/authz.proto
+
+package linkedpackets
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LinkAuthorizationType defines the message type a LinkAuthorization allows.
+type LinkAuthorizationType int32
+
+const (
+	// LINK_AUTHORIZATION_TYPE_UNSPECIFIED defines an invalid authorization type.
+	LinkAuthorizationTypeUnspecified LinkAuthorizationType = 0
+	// LINK_AUTHORIZATION_TYPE_INIT_LINK allows the grantee to send MsgInitLink.
+	LinkAuthorizationTypeInitLink LinkAuthorizationType = 1
+	// LINK_AUTHORIZATION_TYPE_STOP_LINK allows the grantee to send MsgStopLink.
+	LinkAuthorizationTypeStopLink LinkAuthorizationType = 2
+)
+
+var LinkAuthorizationType_name = map[int32]string{
+	0: "LINK_AUTHORIZATION_TYPE_UNSPECIFIED",
+	1: "LINK_AUTHORIZATION_TYPE_INIT_LINK",
+	2: "LINK_AUTHORIZATION_TYPE_STOP_LINK",
+}
+
+var LinkAuthorizationType_value = map[string]int32{
+	"LINK_AUTHORIZATION_TYPE_UNSPECIFIED": 0,
+	"LINK_AUTHORIZATION_TYPE_INIT_LINK":   1,
+	"LINK_AUTHORIZATION_TYPE_STOP_LINK":   2,
+}
+
+func (x LinkAuthorizationType) String() string {
+	return proto.EnumName(LinkAuthorizationType_name, int32(x))
+}
+
+func (LinkAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b28157ec109a626c, []int{0}
+}
+
+// LinkAuthorization allows the grantee to open or stop the links of the granter. The links opened by the
+// grantee are owned by the granter.
+type LinkAuthorization struct {
+	// authorization_type is the message type the grantee is allowed to send.
+	AuthorizationType LinkAuthorizationType `protobuf:"varint,1,opt,name=authorization_type,json=authorizationType,proto3,enum=srdtrk.linkedpackets.v1.LinkAuthorizationType" json:"authorization_type,omitempty"`
+	// allowed_channels are the channels on which the packets of the links opened by the grantee can be sent.
+	// Any channel is allowed if empty. Only applies to MsgInitLink.
+	AllowedChannels []ChannelIdentifier `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	// max_link_packets is the maximum number of packets of the links opened by the grantee. Zero means no limit.
+	// Only applies to MsgInitLink.
+	MaxLinkPackets uint64 `protobuf:"varint,3,opt,name=max_link_packets,json=maxLinkPackets,proto3" json:"max_link_packets,omitempty"`
+	// remaining_uses is the number of times the grantee can still use the authorization. Zero means no limit.
+	// The authorization is removed once it is used for the last time.
+	RemainingUses uint64 `protobuf:"varint,4,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
+}
+
+func (m *LinkAuthorization) Reset()         { *m = LinkAuthorization{} }
+func (m *LinkAuthorization) String() string { return proto.CompactTextString(m) }
+func (*LinkAuthorization) ProtoMessage()    {}
+func (*LinkAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b28157ec109a626c, []int{0}
+}
+func (m *LinkAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkAuthorization.Merge(m, src)
+}
+func (m *LinkAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkAuthorization proto.InternalMessageInfo
+
+func (m *LinkAuthorization) GetAuthorizationType() LinkAuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return LinkAuthorizationTypeUnspecified
+}
+
+func (m *LinkAuthorization) GetAllowedChannels() []ChannelIdentifier {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *LinkAuthorization) GetMaxLinkPackets() uint64 {
+	if m != nil {
+		return m.MaxLinkPackets
+	}
+	return 0
+}
+
+func (m *LinkAuthorization) GetRemainingUses() uint64 {
+	if m != nil {
+		return m.RemainingUses
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("srdtrk.linkedpackets.v1.LinkAuthorizationType", LinkAuthorizationType_name, LinkAuthorizationType_value)
+	proto.RegisterType((*LinkAuthorization)(nil), "srdtrk.linkedpackets.v1.LinkAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("srdtrk/linkedpackets/v1/authz.proto", fileDescriptor_b28157ec109a626c)
+}
+
+var fileDescriptor_b28157ec109a626c = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x8e, 0xd2, 0x40,
+	0x1c, 0xc6, 0x5b, 0x96, 0x78, 0x18, 0x23, 0x42, 0xa3, 0x11, 0x9b, 0x58, 0xba, 0xbb, 0x9a, 0x10,
+	0x12, 0xdb, 0xb0, 0x9e, 0xf4, 0xc6, 0xae, 0x98, 0x1d, 0x5d, 0x81, 0x40, 0x39, 0xb8, 0xc6, 0x4c,
+	0x86, 0x76, 0x84, 0x09, 0x74, 0xa6, 0xe9, 0x0c, 0xb8, 0xbb, 0x27, 0x8f, 0x86, 0x93, 0x2f, 0xb0,
+	0x27, 0x5f, 0xc0, 0x83, 0x0f, 0xb1, 0xf1, 0xb4, 0x47, 0x4f, 0xc6, 0xc0, 0xc1, 0x8b, 0x0f, 0x61,
+	0xca, 0x34, 0x46, 0x76, 0x81, 0x4b, 0xd3, 0x7e, 0xdf, 0xf7, 0xff, 0xe5, 0xdf, 0x6f, 0x06, 0xec,
+	0x8a, 0x38, 0x90, 0xf1, 0xd0, 0x1d, 0x51, 0x36, 0x24, 0x41, 0x84, 0xfd, 0x21, 0x91, 0xc2, 0x9d,
+	0x54, 0x5d, 0x3c, 0x96, 0x83, 0x33, 0x27, 0x8a, 0xb9, 0xe4, 0xc6, 0x3d, 0x15, 0x72, 0x96, 0x42,
+	0xce, 0xa4, 0x6a, 0xde, 0xf7, 0xb9, 0x08, 0xb9, 0x40, 0x8b, 0x98, 0xab, 0x3e, 0xd4, 0x8c, 0x79,
+	0xa7, 0xcf, 0xfb, 0x5c, 0xe9, 0xc9, 0x5b, 0xaa, 0x16, 0x70, 0x48, 0x19, 0x77, 0x17, 0xcf, 0x54,
+	0x5a, 0xbb, 0x81, 0x3c, 0x8d, 0x48, 0x4a, 0xdb, 0xf9, 0x93, 0x01, 0x85, 0x23, 0xca, 0x86, 0xb5,
+	0xb1, 0x1c, 0xf0, 0x98, 0x9e, 0x61, 0x49, 0x39, 0x33, 0xde, 0x01, 0x03, 0xff, 0x2f, 0xa0, 0x64,
+	0xa4, 0xa8, 0xdb, 0x7a, 0x39, 0xb7, 0xe7, 0x38, 0x6b, 0x96, 0x76, 0xae, 0x71, 0xbc, 0xd3, 0x88,
+	0xb4, 0x0b, 0xf8, 0xaa, 0x64, 0xbc, 0x05, 0x79, 0x3c, 0x1a, 0xf1, 0x0f, 0x24, 0x40, 0xfe, 0x00,
+	0x33, 0x46, 0x46, 0xa2, 0x98, 0xb1, 0xb7, 0xca, 0x37, 0xf7, 0x2a, 0x6b, 0xe1, 0x07, 0x2a, 0x08,
+	0x03, 0xc2, 0x24, 0x7d, 0x4f, 0x49, 0xbc, 0x9f, 0xbd, 0xf8, 0x59, 0xd2, 0xda, 0xb7, 0x53, 0x52,
+	0xea, 0x0b, 0xa3, 0x0c, 0xf2, 0x21, 0x3e, 0x41, 0x09, 0x00, 0xa5, 0xf3, 0xc5, 0x2d, 0x5b, 0x2f,
+	0x67, 0xdb, 0xb9, 0x10, 0x9f, 0x24, 0x3b, 0xb6, 0x94, 0x6a, 0x3c, 0x02, 0xb9, 0x98, 0x84, 0x98,
+	0x32, 0xca, 0xfa, 0x68, 0x2c, 0x88, 0x28, 0x66, 0x17, 0xb9, 0x5b, 0xff, 0xd4, 0xae, 0x20, 0xe2,
+	0xd9, 0xcb, 0xef, 0xdf, 0x1e, 0xef, 0xa4, 0x47, 0xa0, 0x0e, 0x6f, 0x52, 0xed, 0x11, 0x89, 0xab,
+	0xce, 0xd2, 0xcf, 0x4e, 0x7f, 0x7f, 0xad, 0x94, 0x96, 0xbb, 0xbe, 0x56, 0x48, 0xe5, 0x63, 0x06,
+	0xdc, 0x5d, 0x59, 0x93, 0xf1, 0x1a, 0xec, 0x1e, 0xc1, 0xc6, 0x2b, 0x54, 0xeb, 0x7a, 0x87, 0xcd,
+	0x36, 0x3c, 0xae, 0x79, 0xb0, 0xd9, 0x40, 0xde, 0x9b, 0x56, 0x1d, 0x75, 0x1b, 0x9d, 0x56, 0xfd,
+	0x00, 0xbe, 0x80, 0xf5, 0xe7, 0x79, 0xcd, 0x7c, 0x38, 0x3d, 0xb7, 0xed, 0x95, 0x8c, 0x2e, 0x13,
+	0x11, 0xf1, 0x93, 0x72, 0x02, 0xe3, 0x10, 0x6c, 0xaf, 0xc3, 0xc1, 0x06, 0xf4, 0x50, 0x62, 0xe6,
+	0x75, 0x73, 0x7b, 0x7a, 0x6e, 0x3f, 0x58, 0x09, 0x83, 0x8c, 0xca, 0xc4, 0xd8, 0x44, 0xea, 0x78,
+	0xcd, 0x96, 0x22, 0x65, 0x36, 0x90, 0x3a, 0x92, 0x47, 0x89, 0x61, 0x66, 0x3f, 0x7d, 0xb1, 0xb4,
+	0xfd, 0xa7, 0x17, 0x33, 0x4b, 0xbf, 0x9c, 0x59, 0xfa, 0xaf, 0x99, 0xa5, 0x7f, 0x9e, 0x5b, 0xda,
+	0xe5, 0xdc, 0xd2, 0x7e, 0xcc, 0x2d, 0xed, 0xb8, 0xd4, 0xa7, 0x72, 0x30, 0xee, 0x39, 0x3e, 0x0f,
+	0xdd, 0x55, 0x77, 0xb7, 0x77, 0x63, 0x71, 0x67, 0x9f, 0xfc, 0x1d, 0x00, 0x25, 0x7b, 0x4a, 0xab,
+	0x5c, 0x03, 0x00, 0x00,
+}
+
+func (m *LinkAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingUses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxLinkPackets != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxLinkPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LinkAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxLinkPackets != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxLinkPackets))
+	}
+	if m.RemainingUses != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingUses))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LinkAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= LinkAuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, ChannelIdentifier{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLinkPackets", wireType)
+			}
+			m.MaxLinkPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLinkPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+			}
+			m.RemainingUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,242 @@
+package linkedpackets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestLinkAuthorizationAccept(t *testing.T) {
+	allowedChannel := linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-0"}
+	otherChannel := linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-1"}
+
+	testCases := []struct {
+		name          string
+		authorization *linkedpackets.LinkAuthorization
+		msg           sdk.Msg
+		expResponse   authz.AcceptResponse
+		expErr        error
+	}{
+		{
+			"success: unrestricted init link",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, nil, 0, 0),
+			&linkedpackets.MsgInitLink{},
+			authz.AcceptResponse{Accept: true},
+			nil,
+		},
+		{
+			"success: restricted init link",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, []linkedpackets.ChannelIdentifier{allowedChannel}, 2, 0),
+			&linkedpackets.MsgInitLink{AllowedChannels: []linkedpackets.ChannelIdentifier{allowedChannel}, MaxPackets: 1},
+			authz.AcceptResponse{Accept: true},
+			nil,
+		},
+		{
+			"success: stop link",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeStopLink, nil, 0, 0),
+			&linkedpackets.MsgStopLink{},
+			authz.AcceptResponse{Accept: true},
+			nil,
+		},
+		{
+			"success: uses decremented",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeStopLink, nil, 0, 2),
+			&linkedpackets.MsgStopLink{},
+			authz.AcceptResponse{
+				Accept:  true,
+				Updated: linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeStopLink, nil, 0, 1),
+			},
+			nil,
+		},
+		{
+			"success: deleted after its last use",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeStopLink, nil, 0, 1),
+			&linkedpackets.MsgStopLink{},
+			authz.AcceptResponse{Accept: true, Delete: true},
+			nil,
+		},
+		{
+			"failure: other message type",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, nil, 0, 0),
+			&linkedpackets.MsgStopLink{},
+			authz.AcceptResponse{},
+			sdkerrors.ErrInvalidType,
+		},
+		{
+			"failure: link not restricted to the allowed channels",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, []linkedpackets.ChannelIdentifier{allowedChannel}, 0, 0),
+			&linkedpackets.MsgInitLink{},
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not allowed",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, []linkedpackets.ChannelIdentifier{allowedChannel}, 0, 0),
+			&linkedpackets.MsgInitLink{AllowedChannels: []linkedpackets.ChannelIdentifier{allowedChannel, otherChannel}},
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: link longer than allowed",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, nil, 2, 0),
+			&linkedpackets.MsgInitLink{MaxPackets: 3},
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: link length not restricted",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, nil, 2, 0),
+			&linkedpackets.MsgInitLink{},
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: relayer reward",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, nil, 0, 0),
+			&linkedpackets.MsgInitLink{RelayerReward: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: compensations",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, nil, 0, 0),
+			&linkedpackets.MsgInitLink{Compensations: []linkedpackets.Compensation{{LinkIndex: 0}}},
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.authorization.Accept(sdk.Context{}, tc.msg)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResponse, res)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestLinkAuthorizationValidateBasic(t *testing.T) {
+	channel := linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-0"}
+
+	testCases := []struct {
+		name          string
+		authorization *linkedpackets.LinkAuthorization
+		expErr        error
+	}{
+		{
+			"success: init link",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, []linkedpackets.ChannelIdentifier{channel}, 2, 1),
+			nil,
+		},
+		{
+			"success: stop link",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeStopLink, nil, 0, 1),
+			nil,
+		},
+		{
+			"failure: unspecified type",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeUnspecified, nil, 0, 0),
+			authz.ErrUnknownAuthorizationType,
+		},
+		{
+			"failure: duplicate channel",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, []linkedpackets.ChannelIdentifier{channel, channel}, 0, 0),
+			sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: link restrictions on stop link",
+			linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeStopLink, nil, 2, 0),
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *LinkedPacketsTestSuite) TestLinkAuthorization() {
+	s.SetupLinkedPacketsTransferTest()
+
+	app := GetSimApp(s.chainA)
+	granter := s.chainA.SenderAccount.GetAddress()
+	grantee := s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	allowedChannels := []linkedpackets.ChannelIdentifier{
+		{PortId: s.path.EndpointA.ChannelConfig.PortID, ChannelId: s.path.EndpointA.ChannelID},
+	}
+	authorization := linkedpackets.NewLinkAuthorization(linkedpackets.LinkAuthorizationTypeInitLink, allowedChannels, 2, 1)
+	grant, err := authz.NewMsgGrant(granter, grantee, authorization, nil)
+	s.Require().NoError(err)
+	_, err = s.chainA.SendMsgs(grant)
+	s.Require().NoError(err)
+
+	initLink := &linkedpackets.MsgInitLink{
+		Sender:          granter.String(),
+		LinkId:          "mylinkid",
+		AllowedChannels: allowedChannels,
+		MaxPackets:      2,
+	}
+
+	// the link must comply with the authorization
+	unrestricted := *initLink
+	unrestricted.MaxPackets = 0
+	err = s.execAsGrantee(&unrestricted)
+	s.Require().ErrorContains(err, "unauthorized")
+
+	err = s.execAsGrantee(initLink)
+	s.Require().NoError(err)
+
+	// the link is owned by the granter
+	link, err := app.LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Equal(granter.String(), link.Owner)
+	s.Require().Equal(allowedChannels, link.AllowedChannels)
+
+	// the authorization is removed after its last use
+	stored, _ := app.AuthzKeeper.GetAuthorization(s.chainA.GetContext(), grantee, granter, sdk.MsgTypeURL(initLink))
+	s.Require().Nil(stored)
+
+	// the packets of the granter are linked up to the maximum number of packets of the link
+	s.Require().NotEmpty(s.ExecuteTransfer(""))
+	s.Require().NotEmpty(s.ExecuteTransfer(""))
+	s.Require().Empty(s.ExecuteTransfer(linkedpackets.LastLinkMemoKey))
+}
+
+// execAsGrantee executes msg on chainA through authz, signed by the second sender account of chainA.
+func (s *LinkedPacketsTestSuite) execAsGrantee(msg sdk.Msg) error {
+	granter, granterKey := s.chainA.SenderAccount, s.chainA.SenderPrivKey
+	grantee := s.chainA.SenderAccounts[1]
+	s.chainA.SenderAccount, s.chainA.SenderPrivKey = grantee.SenderAccount, grantee.SenderPrivKey
+	defer func() {
+		s.chainA.SenderAccount, s.chainA.SenderPrivKey = granter, granterKey
+	}()
+
+	exec := authz.NewMsgExec(grantee.SenderAccount.GetAddress(), []sdk.Msg{msg})
+	if _, err := s.chainA.SendMsgs(&exec); err != nil {
+		// the sequence of the grantee is incremented even though the execution failed
+		s.Require().NoError(grantee.SenderAccount.SetSequence(grantee.SenderAccount.GetSequence() + 1))
+		return err
+	}
+
+	return nil
+}
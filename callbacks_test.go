@@ -0,0 +1,105 @@
+package linkedpackets_test
+
+import (
+	"encoding/json"
+
+	callbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/simapp"
+)
+
+func (s *LinkedPacketsTestSuite) TestLinkCallbacks() {
+	const packetCallbacksMemo = `{"src_callback":{"address":"src_packet_contract"},"dest_callback":{"address":"dest_packet_contract"}`
+
+	testCases := []struct {
+		name          string
+		srcContract   string
+		expCallbacksA []simapp.MockCallback
+		expCallbacksB []simapp.MockCallback
+	}{
+		{
+			"success: link callbacks fired along with the packet callbacks",
+			"src_link_contract",
+			[]simapp.MockCallback{
+				{Type: string(callbacktypes.CallbackTypeSendPacket), ContractAddress: "src_packet_contract"},
+				{Type: string(callbacktypes.CallbackTypeAcknowledgementPacket), ContractAddress: "src_packet_contract"},
+				{Type: string(callbacktypes.CallbackTypeSendPacket), ContractAddress: "src_packet_contract"},
+				{Type: linkedpackets.CallbackTypeLinkResolved, ContractAddress: "src_link_contract", LinkID: "mylinkid"},
+				{Type: string(callbacktypes.CallbackTypeAcknowledgementPacket), ContractAddress: "src_packet_contract"},
+			},
+			[]simapp.MockCallback{
+				{Type: string(callbacktypes.CallbackTypeReceivePacket), ContractAddress: "dest_packet_contract"},
+				{Type: linkedpackets.CallbackTypeLinkReceived, ContractAddress: "dest_link_contract", LinkID: "mylinkid"},
+				{Type: string(callbacktypes.CallbackTypeReceivePacket), ContractAddress: "dest_packet_contract"},
+			},
+		},
+		{
+			"success: link is resolved if its source callback fails",
+			simapp.MockFailingContract,
+			[]simapp.MockCallback{
+				{Type: string(callbacktypes.CallbackTypeSendPacket), ContractAddress: "src_packet_contract"},
+				{Type: string(callbacktypes.CallbackTypeAcknowledgementPacket), ContractAddress: "src_packet_contract"},
+				{Type: string(callbacktypes.CallbackTypeSendPacket), ContractAddress: "src_packet_contract"},
+				{Type: string(callbacktypes.CallbackTypeAcknowledgementPacket), ContractAddress: "src_packet_contract"},
+			},
+			[]simapp.MockCallback{
+				{Type: string(callbacktypes.CallbackTypeReceivePacket), ContractAddress: "dest_packet_contract"},
+				{Type: linkedpackets.CallbackTypeLinkReceived, ContractAddress: "dest_link_contract", LinkID: "mylinkid"},
+				{Type: string(callbacktypes.CallbackTypeReceivePacket), ContractAddress: "dest_packet_contract"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupLinkedPacketsTransferTest()
+
+			contractKeeperA := GetMockContractKeeper(s.chainA)
+			contractKeeperB := GetMockContractKeeper(s.chainB)
+			contractKeeperA.Callbacks, contractKeeperB.Callbacks = nil, nil
+
+			res, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{
+				Sender:              s.chainA.SenderAccount.GetAddress().String(),
+				LinkId:              "mylinkid",
+				SrcCallbackAddress:  tc.srcContract,
+				DestCallbackAddress: "dest_link_contract",
+			})
+			s.Require().NoError(err)
+			s.Require().NotEmpty(res)
+
+			packetData := s.ExecuteTransfer(packetCallbacksMemo + "}")
+			s.Require().Equal("0", s.memoField(packetData.Memo, "link_index"))
+
+			packetData = s.ExecuteTransfer(packetCallbacksMemo + `,"last_link":true}`)
+
+			// the memo keeps the keys of the callbacks middleware along with the link data
+			s.Require().Equal(`{"address":"src_packet_contract"}`, s.memoField(packetData.Memo, "src_callback"))
+			s.Require().Equal(`{"address":"dest_packet_contract"}`, s.memoField(packetData.Memo, "dest_callback"))
+			s.Require().Equal("mylinkid", s.memoField(packetData.Memo, "link_id"))
+			s.Require().Equal("true", s.memoField(packetData.Memo, "last_packet"))
+			s.Require().Empty(s.memoField(packetData.Memo, linkedpackets.LastLinkMemoKey))
+
+			link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+			s.Require().NoError(err)
+			s.Require().Equal(linkedpackets.LinkStatusSucceeded, link.Status)
+
+			s.Require().Equal(tc.expCallbacksA, contractKeeperA.Callbacks)
+			s.Require().Equal(tc.expCallbacksB, contractKeeperB.Callbacks)
+		})
+	}
+}
+
+// memoField returns the raw JSON value of a key of the memo, unquoted if it is a string.
+func (s *LinkedPacketsTestSuite) memoField(memo, key string) string {
+	var fields map[string]json.RawMessage
+	s.Require().NoError(json.Unmarshal([]byte(memo), &fields))
+
+	var str string
+	if err := json.Unmarshal(fields[key], &str); err == nil {
+		return str
+	}
+
+	return string(fields[key])
+}
@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// DefaultBundleTimeout is the relative timeout used for the packets of a bundle which doesn't set one.
+const DefaultBundleTimeout = 10 * time.Minute
+
+// Bundle describes the packets of a link sent in a single transaction.
+type Bundle struct {
+	// LinkID is the link identifier. If empty, a link identifier is generated.
+	LinkID string `json:"link_id,omitempty"`
+	// Timeout is the timeout of every packet of the bundle relative to the time the bundle is built, e.g. "10m".
+	Timeout string `json:"timeout,omitempty"`
+	// RelayerReward is the reward paid to the relayers of the link once every packet is acknowledged, e.g. "100stake".
+	RelayerReward string `json:"relayer_reward,omitempty"`
+	// Packets are the packets of the link in link index order.
+	Packets []BundlePacket `json:"packets"`
+}
+
+// BundlePacket describes a single packet of a bundle. Exactly one of its fields must be set.
+type BundlePacket struct {
+	Transfer *BundleTransfer `json:"transfer,omitempty"`
+	ICATx    *BundleICATx    `json:"ica_tx,omitempty"`
+}
+
+// BundleTransfer describes an ICS20 transfer of a bundle.
+type BundleTransfer struct {
+	// SourcePort is the transfer port, it defaults to the transfer module port.
+	SourcePort    string `json:"source_port,omitempty"`
+	SourceChannel string `json:"source_channel"`
+	// Token is the amount to transfer, e.g. "100stake".
+	Token    string `json:"token"`
+	Receiver string `json:"receiver"`
+}
+
+// BundleICATx describes an interchain account transaction of a bundle. It is sent on the
+// interchain account of the bundle signer.
+type BundleICATx struct {
+	ConnectionID string `json:"connection_id"`
+	// Encoding is the encoding of the interchain account channel, it defaults to proto3.
+	Encoding string `json:"encoding,omitempty"`
+	// Messages are the JSON encoded messages to execute, each with its "@type".
+	Messages []json.RawMessage `json:"messages"`
+}
+
+// ParseBundleFile reads a bundle from a JSON or YAML file.
+func ParseBundleFile(path string) (Bundle, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Bundle{}, err
+	}
+
+	return ParseBundle(bz)
+}
+
+// ParseBundle parses a JSON or YAML encoded bundle.
+func ParseBundle(bz []byte) (Bundle, error) {
+	jsonBz, err := yaml.YAMLToJSON(bz)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("failed to parse bundle: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBz))
+	decoder.DisallowUnknownFields()
+
+	var bundle Bundle
+	if err := decoder.Decode(&bundle); err != nil {
+		return Bundle{}, fmt.Errorf("failed to parse bundle: %w", err)
+	}
+
+	return bundle, bundle.Validate()
+}
+
+// Validate performs a stateless validation of the bundle.
+func (b Bundle) Validate() error {
+	if len(b.Packets) == 0 {
+		return errors.New("bundle has no packets")
+	}
+
+	if _, err := b.timeout(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ParseCoinsNormalized(b.RelayerReward); err != nil {
+		return fmt.Errorf("invalid relayer reward: %w", err)
+	}
+
+	for i, p := range b.Packets {
+		if (p.Transfer == nil) == (p.ICATx == nil) {
+			return fmt.Errorf("packet %d must set exactly one of transfer or ica_tx", i)
+		}
+
+		if p.ICATx != nil && len(p.ICATx.Messages) == 0 {
+			return fmt.Errorf("packet %d has no interchain account messages", i)
+		}
+	}
+
+	return nil
+}
+
+// Msgs returns the messages which open the link, send every packet of the bundle on behalf of
+// sender and close the link with the last packet.
+func (b Bundle) Msgs(cdc codec.Codec, sender string, now time.Time) ([]sdk.Msg, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	timeout, err := b.timeout()
+	if err != nil {
+		return nil, err
+	}
+
+	relayerReward, err := sdk.ParseCoinsNormalized(b.RelayerReward)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []sdk.Msg{&linkedpackets.MsgInitLink{Sender: sender, LinkId: b.LinkID, RelayerReward: relayerReward}}
+	for i, p := range b.Packets {
+		var memo string
+		if i == len(b.Packets)-1 {
+			memo = linkedpackets.LastLinkMemoKey
+		}
+
+		var msg sdk.Msg
+		if p.Transfer != nil {
+			msg, err = p.Transfer.msg(sender, memo, uint64(now.Add(timeout).UnixNano()))
+		} else {
+			msg, err = p.ICATx.msg(cdc, sender, memo, uint64(timeout.Nanoseconds()))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("packet %d: %w", i, err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func (b Bundle) timeout() (time.Duration, error) {
+	if b.Timeout == "" {
+		return DefaultBundleTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(b.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bundle timeout: %w", err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("bundle timeout must be positive: %s", b.Timeout)
+	}
+
+	return timeout, nil
+}
+
+func (t BundleTransfer) msg(sender, memo string, timeoutTimestamp uint64) (sdk.Msg, error) {
+	token, err := sdk.ParseCoinNormalized(t.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePort := t.SourcePort
+	if sourcePort == "" {
+		sourcePort = transfertypes.PortID
+	}
+
+	return transfertypes.NewMsgTransfer(
+		sourcePort, t.SourceChannel, token, sender, t.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	), nil
+}
+
+func (t BundleICATx) msg(cdc codec.Codec, owner, memo string, relativeTimeout uint64) (sdk.Msg, error) {
+	msgs := make([]proto.Message, len(t.Messages))
+	for i, msgBz := range t.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(msgBz, &msg); err != nil {
+			return nil, fmt.Errorf("invalid interchain account message %d: %w", i, err)
+		}
+		msgs[i] = msg
+	}
+
+	encoding := t.Encoding
+	if encoding == "" {
+		encoding = icatypes.EncodingProtobuf
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	return icacontrollertypes.NewMsgSendTx(owner, t.ConnectionID, relativeTimeout, packetData), nil
+}
@@ -0,0 +1,127 @@
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/client/cli"
+)
+
+const (
+	sender   = "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"
+	receiver = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+)
+
+const yamlBundle = `
+link_id: mylinkid
+timeout: 1m
+relayer_reward: 10stake
+packets:
+  - transfer:
+      source_channel: channel-0
+      token: 100stake
+      receiver: ` + receiver + `
+  - ica_tx:
+      connection_id: connection-0
+      messages:
+        - "@type": /cosmos.bank.v1beta1.MsgSend
+          from_address: ` + receiver + `
+          to_address: ` + sender + `
+          amount: [{denom: stake, amount: "100"}]
+`
+
+const jsonBundle = `{
+  "packets": [
+    {"transfer": {"source_port": "transfer", "source_channel": "channel-1", "token": "1stake", "receiver": "` + receiver + `"}}
+  ]
+}`
+
+func TestParseBundle(t *testing.T) {
+	testCases := []struct {
+		name      string
+		bundle    string
+		expErrMsg string
+	}{
+		{"yaml bundle", yamlBundle, ""},
+		{"json bundle", jsonBundle, ""},
+		{"no packets", `link_id: mylinkid`, "bundle has no packets"},
+		{"unknown field", `{"packets": [{"transfer": {"channel": "channel-0"}}]}`, "unknown field"},
+		{"empty packet", `{"packets": [{}]}`, "exactly one of transfer or ica_tx"},
+		{"no ica messages", `{"packets": [{"ica_tx": {"connection_id": "connection-0"}}]}`, "no interchain account messages"},
+		{"invalid timeout", `{"timeout": "soon", "packets": [{"transfer": {}}]}`, "invalid bundle timeout"},
+		{"negative timeout", `{"timeout": "-1m", "packets": [{"transfer": {}}]}`, "bundle timeout must be positive"},
+		{"invalid relayer reward", `{"relayer_reward": "ten", "packets": [{"transfer": {}}]}`, "invalid relayer reward"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := cli.ParseBundle([]byte(tc.bundle))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBundleMsgs(t *testing.T) {
+	require := require.New(t)
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bundle, err := cli.ParseBundle([]byte(yamlBundle))
+	require.NoError(err)
+
+	now := time.Unix(1_700_000_000, 0)
+	msgs, err := bundle.Msgs(cdc, sender, now)
+	require.NoError(err)
+	require.Len(msgs, 3)
+
+	require.Equal(&linkedpackets.MsgInitLink{
+		Sender:        sender,
+		LinkId:        "mylinkid",
+		RelayerReward: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}, msgs[0])
+
+	transfer, ok := msgs[1].(*transfertypes.MsgTransfer)
+	require.True(ok)
+	require.Equal(transfertypes.PortID, transfer.SourcePort)
+	require.Equal("channel-0", transfer.SourceChannel)
+	require.Equal(sender, transfer.Sender)
+	require.Equal(receiver, transfer.Receiver)
+	require.Equal("100stake", transfer.Token.String())
+	require.Equal(uint64(now.Add(time.Minute).UnixNano()), transfer.TimeoutTimestamp)
+	require.Empty(transfer.Memo)
+
+	sendTx, ok := msgs[2].(*icacontrollertypes.MsgSendTx)
+	require.True(ok)
+	require.Equal(sender, sendTx.Owner)
+	require.Equal("connection-0", sendTx.ConnectionId)
+	require.Equal(uint64(time.Minute.Nanoseconds()), sendTx.RelativeTimeout)
+	require.Equal(linkedpackets.LastLinkMemoKey, sendTx.PacketData.Memo)
+
+	icaMsgs, err := icatypes.DeserializeCosmosTx(cdc, sendTx.PacketData.Data, icatypes.EncodingProtobuf)
+	require.NoError(err)
+	require.Len(icaMsgs, 1)
+	require.IsType(&banktypes.MsgSend{}, icaMsgs[0])
+
+	// unregistered interchain account messages cannot be encoded
+	_, err = bundle.Msgs(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), sender, now)
+	require.ErrorContains(err, "invalid interchain account message 0")
+}
@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// FlagWaitTimeout is the flag setting how long send-bundle waits for its transaction to be included in a block.
+const FlagWaitTimeout = "wait-timeout"
+
+// BundleResult is the output of the send-bundle command.
+type BundleResult struct {
+	TxHash  string                           `json:"txhash"`
+	LinkID  string                           `json:"link_id"`
+	Packets []linkedpackets.PacketIdentifier `json:"packets"`
+}
+
+// GetTxCmd returns the custom transaction commands of the linkedpackets module. The commands generated
+// from the module's AutoCLI options are added to it by AutoCLI.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        linkedpackets.ModuleName,
+		Short:                      fmt.Sprintf("Transactions commands for the %s module", linkedpackets.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewSendBundleCmd())
+
+	return cmd
+}
+
+// NewSendBundleCmd returns the command which sends a whole link from a bundle file in a single transaction.
+func NewSendBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-bundle [file]",
+		Short: "Send the transfers and interchain account txs of a bundle file as a single link",
+		Long: `Send the transfers and interchain account txs of a JSON or YAML bundle file as a single link.
+The command builds one transaction which opens the link, sends every packet of the bundle in order and
+closes the link with the last packet. Once the transaction is included in a block, the link identifier
+and the packet sequences are printed.`,
+		Example: `send-bundle bundle.yaml --from mykey
+
+where bundle.yaml contains:
+
+link_id: mylink
+timeout: 10m
+packets:
+  - transfer:
+      source_channel: channel-0
+      token: 100stake
+      receiver: cosmos1...
+  - ica_tx:
+      connection_id: connection-0
+      messages:
+        - "@type": /cosmos.bank.v1beta1.MsgSend
+          from_address: cosmos1...
+          to_address: cosmos1...
+          amount: [{denom: stake, amount: "100"}]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := ParseBundleFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgs, err := bundle.Msgs(clientCtx.Codec, clientCtx.GetFromAddress().String(), time.Now())
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly || clientCtx.Simulate {
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+			}
+
+			waitTimeout, err := cmd.Flags().GetDuration(FlagWaitTimeout)
+			if err != nil {
+				return err
+			}
+
+			res, err := sendBundle(cmd, clientCtx, msgs)
+			if err != nil {
+				return err
+			}
+			if res.Code != 0 {
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err = waitForTx(cmd, clientCtx, res.TxHash, waitTimeout)
+			if err != nil {
+				return err
+			}
+			if res.Code != 0 {
+				return clientCtx.PrintProto(res)
+			}
+
+			result, err := bundleResult(clientCtx, res)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().Duration(FlagWaitTimeout, time.Minute, "How long to wait for the transaction to be included in a block")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sendBundle signs and broadcasts the bundle messages in a single transaction.
+func sendBundle(cmd *cobra.Command, clientCtx client.Context, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Sign(cmd.Context(), txf, clientCtx.FromName, builder, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+// waitForTx polls the node until the transaction is included in a block or the timeout expires.
+func waitForTx(cmd *cobra.Command, clientCtx client.Context, txHash string, timeout time.Duration) (*sdk.TxResponse, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		res, err := authtx.QueryTx(clientCtx, txHash)
+		if err == nil {
+			return res, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("transaction %s was not included in a block after %s: %w", txHash, timeout, err)
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return nil, cmd.Context().Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// bundleResult reads the link identifier and the sent packets from the result of a bundle transaction.
+func bundleResult(clientCtx client.Context, res *sdk.TxResponse) (BundleResult, error) {
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return BundleResult{}, err
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := clientCtx.Codec.Unmarshal(data, &txMsgData); err != nil {
+		return BundleResult{}, err
+	}
+	if len(txMsgData.MsgResponses) == 0 {
+		return BundleResult{}, errors.New("bundle transaction has no message responses")
+	}
+
+	var initLinkRes linkedpackets.MsgInitLinkResponse
+	if err := clientCtx.Codec.Unmarshal(txMsgData.MsgResponses[0].Value, &initLinkRes); err != nil {
+		return BundleResult{}, err
+	}
+
+	result := BundleResult{TxHash: res.TxHash, LinkID: initLinkRes.LinkId, Packets: []linkedpackets.PacketIdentifier{}}
+	for _, event := range res.Events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		var packet linkedpackets.PacketIdentifier
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case channeltypes.AttributeKeySrcPort:
+				packet.PortId = attr.Value
+			case channeltypes.AttributeKeySrcChannel:
+				packet.ChannelId = attr.Value
+			case channeltypes.AttributeKeySequence:
+				if _, err := strconv.ParseUint(attr.Value, 10, 64); err != nil {
+					return BundleResult{}, fmt.Errorf("invalid packet sequence %s: %w", attr.Value, err)
+				}
+				packet.Seq = attr.Value
+			}
+		}
+
+		result.Packets = append(result.Packets, packet)
+	}
+
+	return result, nil
+}
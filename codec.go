@@ -6,13 +6,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+// ModuleCdc is the codec used for the JSON encoding of the middleware acknowledgements.
+var ModuleCdc = codec.NewProtoCodec(types.NewInterfaceRegistry())
+
 // RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "linkedpackets/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgInitLink{}, "linkedpackets/MsgInitLink")
+	legacy.RegisterAminoMsg(cdc, &MsgForceCloseLink{}, "linkedpackets/MsgForceCloseLink")
+	legacy.RegisterAminoMsg(cdc, &MsgForceFailLink{}, "linkedpackets/MsgForceFailLink")
+	legacy.RegisterAminoMsg(cdc, &MsgSetLinkingPaused{}, "linkedpackets/MsgSetLinkingPaused")
+	cdc.RegisterConcrete(&LinkAuthorization{}, "linkedpackets/LinkAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -20,6 +28,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgInitLink{},
+		&MsgForceCloseLink{},
+		&MsgForceFailLink{},
+		&MsgSetLinkingPaused{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&LinkAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
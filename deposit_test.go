@@ -0,0 +1,70 @@
+package linkedpackets_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func (s *LinkedPacketsTestSuite) TestLinkDeposit() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expRefund bool
+	}{
+		{
+			"success: deposit refunded when the link closes",
+			func() {
+				s.ExecuteTransfer(linkedpackets.LastLinkMemoKey)
+			},
+			true,
+		},
+		{
+			"success: deposit burned when the link expires",
+			func() {
+				s.chainA.NextBlock()
+				s.chainA.NextBlock()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupLinkedPacketsTransferTest()
+
+			app := GetSimApp(s.chainA)
+			deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			params := linkedpackets.DefaultParams()
+			params.LinkDeposit = deposit
+			params.LinkExpiryBlocks = 2
+			s.Require().NoError(app.LinkedPacketsKeeper.Params.Set(s.chainA.GetContext(), params))
+
+			owner := s.chainA.SenderAccount.GetAddress()
+			moduleAddr := authtypes.NewModuleAddress(linkedpackets.ModuleName)
+			balance := app.BankKeeper.GetBalance(s.chainA.GetContext(), owner, sdk.DefaultBondDenom)
+
+			s.ExecuteInitLink("mylinkid")
+			s.Require().Equal(deposit, app.BankKeeper.GetAllBalances(s.chainA.GetContext(), moduleAddr))
+			s.Require().Equal(balance.Sub(deposit[0]), app.BankKeeper.GetBalance(s.chainA.GetContext(), owner, sdk.DefaultBondDenom))
+
+			tc.malleate()
+
+			s.Require().True(app.BankKeeper.GetAllBalances(s.chainA.GetContext(), moduleAddr).IsZero())
+
+			hasSession, err := app.LinkedPacketsKeeper.Sessions.Has(s.chainA.GetContext(), owner.String())
+			s.Require().NoError(err)
+			s.Require().False(hasSession)
+
+			// the transfer in the refund case moves the transferred coins to the escrow account
+			refunded := app.BankKeeper.GetBalance(s.chainA.GetContext(), owner, sdk.DefaultBondDenom)
+			if tc.expRefund {
+				s.Require().Equal(balance.Sub(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), refunded)
+			} else {
+				s.Require().Equal(balance.Sub(deposit[0]), refunded)
+			}
+		})
+	}
+}
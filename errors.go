@@ -5,7 +5,36 @@ import errorsmod "cosmossdk.io/errors"
 var (
 	// ErrInvalidVersion error if the channel version is invalid
 	ErrInvalidVersion = errorsmod.Register(ModuleName, 2, "invalid linked packets middleware version")
-	// ErrDuplicateAddress error if there is a duplicate address
-	ErrDuplicateAddress  = errorsmod.Register(ModuleName, 3, "duplicate address")
+	// NOTE: code 3 was used by ErrDuplicateAddress and must not be reused.
 	ErrInvalidPacketData = errorsmod.Register(ModuleName, 4, "invalid packet data")
+	// ErrLinkExists error if a link with the same identifier already exists
+	ErrLinkExists = errorsmod.Register(ModuleName, 5, "link already exists")
+	// ErrLinkNotFound error if the link does not exist
+	ErrLinkNotFound = errorsmod.Register(ModuleName, 6, "link not found")
+	// ErrLinkInProgress error if a link is started while another link is in progress
+	ErrLinkInProgress = errorsmod.Register(ModuleName, 7, "link already in progress")
+	// ErrInvalidCompensation error if a compensation is invalid
+	ErrInvalidCompensation = errorsmod.Register(ModuleName, 8, "invalid compensation")
+	// ErrBrokenLink error if the previous packet of a linked packet was not received
+	ErrBrokenLink = errorsmod.Register(ModuleName, 9, "broken link chain")
+	// ErrInvalidParams error if the module parameters are invalid
+	ErrInvalidParams = errorsmod.Register(ModuleName, 10, "invalid params")
+	// ErrLinkingDisabled error if linking is disabled by the module parameters
+	ErrLinkingDisabled = errorsmod.Register(ModuleName, 11, "linking is disabled")
+	// ErrLinkLimitExceeded error if a link limit of the module parameters is exceeded
+	ErrLinkLimitExceeded = errorsmod.Register(ModuleName, 12, "link limit exceeded")
+	// ErrLinkExpired error if a packet is sent on a link which expired
+	ErrLinkExpired = errorsmod.Register(ModuleName, 13, "link expired")
+	// ErrLinkCallbackFailed error if a link callback of a contract fails
+	ErrLinkCallbackFailed = errorsmod.Register(ModuleName, 14, "link callback failed")
+	// ErrLinkExecutionFailed error if a packet of a link executed atomically fails
+	ErrLinkExecutionFailed = errorsmod.Register(ModuleName, 15, "link execution failed")
+	// ErrLinkForceClosed error if a link is force closed by the authority
+	ErrLinkForceClosed = errorsmod.Register(ModuleName, 16, "link force closed")
+	// ErrLinkingPaused error if linking is paused by the authority
+	ErrLinkingPaused = errorsmod.Register(ModuleName, 17, "linking is paused")
+	// ErrRateLimitExceeded error if a rate limit of the module parameters is exceeded
+	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 18, "rate limit exceeded")
+	// ErrInvalidGenesis error if the genesis state is invalid
+	ErrInvalidGenesis = errorsmod.Register(ModuleName, 19, "invalid genesis state")
 )
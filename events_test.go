@@ -0,0 +1,70 @@
+package linkedpackets_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func (s *LinkedPacketsTestSuite) TestLinkEvents() {
+	s.SetupLinkedPacketsTransferTest()
+
+	owner := s.chainA.SenderAccount.GetAddress().String()
+	res, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{Sender: owner, LinkId: "mylinkid"})
+	s.Require().NoError(err)
+	s.Require().Equal(&linkedpackets.EventLinkOpened{LinkId: "mylinkid", Owner: owner}, s.typedEvent(res.Events, &linkedpackets.EventLinkOpened{}))
+
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, ibctesting.TestCoin,
+		owner, s.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 100), 0, linkedpackets.LastLinkMemoKey,
+	)
+	res, err = s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	sentPacket := linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "1"}
+	s.Require().Equal(&linkedpackets.EventLinkPacketSent{
+		LinkId:     "mylinkid",
+		Packet:     sentPacket,
+		LastPacket: true,
+	}, s.typedEvent(res.Events, &linkedpackets.EventLinkPacketSent{}))
+	s.Require().Equal(&linkedpackets.EventLinkClosed{
+		LinkId:      "mylinkid",
+		Owner:       owner,
+		PacketCount: 1,
+	}, s.typedEvent(res.Events, &linkedpackets.EventLinkClosed{}))
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	recvRes, err := s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+	s.Require().Equal(&linkedpackets.EventLinkPacketReceived{
+		LinkId:     "mylinkid",
+		Packet:     linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "1"},
+		LastPacket: true,
+	}, s.typedEvent(recvRes.Events, &linkedpackets.EventLinkPacketReceived{}))
+}
+
+// typedEvent returns the typed event of the same type as msg found in the events. The test fails if there is none.
+func (s *LinkedPacketsTestSuite) typedEvent(events []abci.Event, msg proto.Message) proto.Message {
+	for _, event := range events {
+		if event.Type != proto.MessageName(msg) {
+			continue
+		}
+
+		parsed, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err)
+		return parsed
+	}
+
+	s.FailNow("typed event not found", proto.MessageName(msg))
+	return nil
+}
@@ -0,0 +1,72 @@
+package linkedpackets
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
+	WriteAcknowledgement(
+		ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement,
+	) error
+}
+
+// FeeKeeper defines the expected ICS29 fee keeper used to incentivize the acknowledgements written by the keeper
+type FeeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	GetCounterpartyPayeeAddress(ctx sdk.Context, address, channelID string) (string, bool)
+}
+
+// ICAControllerKeeper defines the expected interchain accounts controller keeper
+type ICAControllerKeeper interface {
+	SendTx(
+		ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string,
+		icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64,
+	) (uint64, error)
+}
+
+// AccountKeeper defines the expected account keeper used for simulations
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the expected bank keeper used to escrow link deposits
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	// SpendableCoins is only used for simulations
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DistributionKeeper defines the expected distribution keeper used to send forfeited link deposits to the
+// community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// ContractKeeper defines the expected contract keeper called back with the lifecycle of links. It complements
+// the packet-level callbacks of the ibc-go callbacks middleware, and is usually implemented by the same keeper.
+// The contract is responsible for authorizing the callbacks it receives, e.g. by checking the link owner.
+type ContractKeeper interface {
+	// IBCOnLinkResolvedCallback is called on the sending chain once every packet of a link has an outcome.
+	IBCOnLinkResolvedCallback(cachedCtx sdk.Context, link Link, contractAddress string) error
+	// IBCOnLinkReceivedCallback is called on the receiving chain once the last packet of a link is received.
+	IBCOnLinkReceivedCallback(cachedCtx sdk.Context, packet channeltypes.Packet, linkData LinkData, contractAddress string) error
+}
+
+// CircuitBreaker defines the expected circuit breaker, such as the x/circuit keeper, whose message filter pauses
+// linking when it disables MsgInitLink.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
@@ -0,0 +1,115 @@
+package linkedpackets_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+)
+
+func (s *LinkedPacketsTestSuite) TestForceCloseBufferedLink() {
+	testCases := []struct {
+		name       string
+		setup      func() string
+		expRelayer func() string
+	}{
+		{
+			"success: the buffered packets are rejected",
+			s.SetupICATest,
+			func() string { return s.chainB.SenderAccount.GetAddress().String() },
+		},
+		{
+			"success: the rejections of the buffered packets are incentivized on a fee enabled channel",
+			func() string {
+				icaAddr := s.SetupFeeICATest()
+
+				GetSimApp(s.chainB).IBCFeeKeeper.SetCounterpartyPayeeAddress(
+					s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress().String(),
+					s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), s.path.EndpointB.ChannelID,
+				)
+
+				return icaAddr
+			},
+			func() string { return s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String() },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			icaAddr := tc.setup()
+			s.forceCloseBufferedLink(icaAddr, tc.expRelayer())
+		})
+	}
+}
+
+// forceCloseBufferedLink force closes an atomic link with a packet buffered on chainB, and checks that its
+// packets are rejected with the given relayer recorded in their acknowledgements.
+func (s *LinkedPacketsTestSuite) forceCloseBufferedLink(icaAddr, expRelayer string) {
+	_, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{
+		Sender: s.chainA.SenderAccount.GetAddress().String(),
+		LinkId: "mylinkid",
+		Atomic: true,
+	})
+	s.Require().NoError(err)
+
+	packets := []channeltypes.Packet{
+		s.sendICADelegate(icaAddr, sdkmath.NewInt(5000), ""),
+		s.sendICADelegate(icaAddr, sdkmath.NewInt(5000), linkedpackets.LastLinkMemoKey),
+	}
+
+	// the first packet is buffered on chainB
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	s.Require().NoError(s.path.EndpointB.RecvPacket(packets[0]))
+
+	// the authority of chainB force closes the link, the buffered packet is given an error acknowledgement
+	app := GetSimApp(s.chainB)
+	ctx := s.chainB.GetContext()
+	_, err = keeper.NewMsgServerImpl(app.LinkedPacketsKeeper).ForceCloseLink(ctx, &linkedpackets.MsgForceCloseLink{
+		Authority: app.LinkedPacketsKeeper.GetAuthority(),
+		LinkId:    "mylinkid",
+		PortId:    s.path.EndpointB.ChannelConfig.PortID,
+		ChannelId: s.path.EndpointB.ChannelID,
+	})
+	s.Require().NoError(err)
+
+	events := ctx.EventManager().Events().ToABCIEvents()
+	event := s.typedEvent(events, &linkedpackets.EventLinkForceClosed{}).(*linkedpackets.EventLinkForceClosed)
+	s.Require().False(event.Failed)
+	s.Require().Len(event.RejectedPackets, 1)
+
+	ack, err := ibctesting.ParseAckFromEvents(events)
+	s.Require().NoError(err)
+	linkAck, err := linkedpackets.LinkAcknowledgementFromBytes(ack)
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkAckCodeForceClosed, linkAck.Code)
+	s.Require().Equal(expRelayer, linkAck.Relayer)
+
+	// the acknowledgement is handled by the fee middleware of chainA if fees are enabled on the channel
+	s.coordinator.CommitBlock(s.chainB)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packets[0], ack))
+
+	// the last packet of the link is rejected since its previous packet is no longer received
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err := s.path.EndpointB.RecvPacketWithResult(packets[1])
+	s.Require().NoError(err)
+	ack, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	linkAck, err = linkedpackets.LinkAcknowledgementFromBytes(ack)
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkAckCodeBrokenChain, linkAck.Code)
+
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packets[1], ack))
+
+	link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkStatusFailed, link.Status)
+	for _, packet := range link.Packets {
+		s.Require().Equal(linkedpackets.PacketOutcomeLinkError, packet.Outcome)
+	}
+}
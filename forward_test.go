@@ -0,0 +1,211 @@
+package linkedpackets_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// SetupLinkedPacketsForwardTest sets up link enabled transfer channels between chainA and chainB, and between
+// chainB and a third chain to which chainB forwards packets. It returns the third chain and its path with chainB.
+func (s *LinkedPacketsTestSuite) SetupLinkedPacketsForwardTest() (*ibctesting.TestChain, *ibctesting.Path) {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 3)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+	chainC := s.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	byteVersion, err := json.Marshal(linkedpackets.Metadata{LinkedPacketsVersion: linkedpackets.Version, AppVersion: transfertypes.Version})
+	s.Require().NoError(err)
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	pathBC := ibctesting.NewPath(s.chainB, chainC)
+	for _, path := range []*ibctesting.Path{s.path, pathBC} {
+		path.EndpointA.ChannelConfig.Version = string(byteVersion)
+		path.EndpointB.ChannelConfig.Version = string(byteVersion)
+		path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+		path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+
+		s.coordinator.Setup(path)
+	}
+
+	return chainC, pathBC
+}
+
+func (s *LinkedPacketsTestSuite) TestLinkForwarded() {
+	chainC, pathBC := s.SetupLinkedPacketsForwardTest()
+
+	s.ExecuteInitLink("mylinkid")
+
+	forwardMemo := fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}`,
+		chainC.SenderAccount.GetAddress(), pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID,
+	)
+
+	packetOne := s.sendForwardTransfer(forwardMemo + "}")
+	packetTwo := s.sendForwardTransfer(forwardMemo + `,"last_link":true}`)
+
+	hopOne := s.relayForwardedPacket(pathBC, packetOne)
+	hopTwo := s.relayForwardedPacket(pathBC, packetTwo)
+
+	// the hops carry the link to chainC, linked to each other
+	s.Require().Equal("mylinkid", s.memoField(hopOne.Memo, "link_id"))
+	s.Require().Equal("0", s.memoField(hopOne.Memo, "link_index"))
+	s.Require().Equal("true", s.memoField(hopOne.Memo, "initial_packet"))
+	s.Require().Equal("{}", s.memoField(hopOne.Memo, "prev_packet"))
+
+	s.Require().Equal("mylinkid", s.memoField(hopTwo.Memo, "link_id"))
+	s.Require().Equal("1", s.memoField(hopTwo.Memo, "link_index"))
+	s.Require().Equal("true", s.memoField(hopTwo.Memo, "last_packet"))
+	s.Require().Equal(
+		fmt.Sprintf(`{"port_id":"%s","channel_id":"%s","seq":"1"}`, pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID),
+		s.memoField(hopTwo.Memo, "prev_packet"),
+	)
+
+	// the hops of the link are no longer recorded once its last packet is forwarded
+	found, err := GetSimApp(s.chainB).LinkedPacketsKeeper.ForwardedLinkPackets.Has(
+		s.chainB.GetContext(),
+		collections.Join3(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, "mylinkid"),
+	)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	// the link is resolved on chainA from the acknowledgements of chainC
+	link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkStatusSucceeded, link.Status)
+
+	// the receiver on chainC holds the vouchers of both packets
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		pathBC.EndpointB.ChannelConfig.PortID, pathBC.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	)).IBCDenom()
+	balance := GetSimApp(chainC).BankKeeper.GetBalance(chainC.GetContext(), chainC.SenderAccount.GetAddress(), voucherDenom)
+	s.Require().Equal(ibctesting.TestCoin.Amount.MulRaw(2), balance.Amount)
+}
+
+func (s *LinkedPacketsTestSuite) TestBrokenLinkForwarded() {
+	chainC, pathBC := s.SetupLinkedPacketsForwardTest()
+
+	s.ExecuteInitLink("mylinkid")
+
+	forwardMemo := fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}`,
+		chainC.SenderAccount.GetAddress(), pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID,
+	)
+
+	packets := []channeltypes.Packet{
+		s.sendForwardTransfer(forwardMemo + "}"),
+		s.sendForwardTransfer(forwardMemo + `,"last_link":true}`),
+	}
+
+	// forward both packets in order to chainC
+	var hops []channeltypes.Packet
+	for _, packet := range packets {
+		s.Require().NoError(s.path.EndpointB.UpdateClient())
+		res, err := s.path.EndpointB.RecvPacketWithResult(packet)
+		s.Require().NoError(err)
+
+		hop, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		s.Require().NoError(err)
+		hops = append(hops, hop)
+	}
+
+	// relay the last hop of the link before its previous hop, chainC enforces the link
+	for i := len(hops) - 1; i >= 0; i-- {
+		ack := s.relayHop(pathBC, hops[i])
+		s.Require().NoError(s.path.EndpointA.UpdateClient())
+		s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packets[i], ack))
+	}
+
+	link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkStatusPartiallyFailed, link.Status)
+	s.Require().Equal(linkedpackets.PacketOutcomeSuccess, link.Packets[0].Outcome)
+	// the packet forward middleware relays the rejection of the hop as an error acknowledgement
+	s.Require().Equal(linkedpackets.PacketOutcomeError, link.Packets[1].Outcome)
+}
+
+// sendForwardTransfer sends a transfer of ibctesting.TestCoin from chainA to chainB with the given memo and
+// returns its packet.
+func (s *LinkedPacketsTestSuite) sendForwardTransfer(memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		ibctesting.TestCoin,
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 100), 0, memo,
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	return packet
+}
+
+// relayForwardedPacket relays a packet from chainA to chainB, the hop forwarding it from chainB to chainC, and
+// their acknowledgements. It returns the packet data of the hop.
+func (s *LinkedPacketsTestSuite) relayForwardedPacket(pathBC *ibctesting.Path, packet channeltypes.Packet) transfertypes.FungibleTokenPacketData {
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err := s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	// the acknowledgement of a forwarded packet is written once its hop is acknowledged
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().Error(err)
+
+	hop, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	event := s.typedEvent(res.GetEvents(), &linkedpackets.EventLinkPacketForwarded{}).(*linkedpackets.EventLinkPacketForwarded)
+	s.Require().Equal("mylinkid", event.LinkId)
+	s.Require().Equal(strconv.FormatUint(packet.Sequence, 10), event.Packet.Seq)
+	s.Require().Equal(strconv.FormatUint(hop.Sequence, 10), event.Hop.Seq)
+	s.Require().Equal(hop.SourceChannel, event.Hop.ChannelId)
+
+	ack := s.relayHop(pathBC, hop)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ack))
+
+	hopData, err := transfer.IBCModule{}.UnmarshalPacketData(hop.Data)
+	s.Require().NoError(err)
+
+	return hopData.(transfertypes.FungibleTokenPacketData)
+}
+
+// relayHop relays a hop from chainB to chainC and its acknowledgement back to chainB. It returns the
+// acknowledgement then written by chainB for the forwarded packet.
+func (s *LinkedPacketsTestSuite) relayHop(pathBC *ibctesting.Path, hop channeltypes.Packet) []byte {
+	s.Require().NoError(pathBC.EndpointB.UpdateClient())
+	res, err := pathBC.EndpointB.RecvPacketWithResult(hop)
+	s.Require().NoError(err)
+
+	hopAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	s.Require().NoError(pathBC.EndpointA.UpdateClient())
+	proof, proofHeight := pathBC.EndpointB.QueryProof(host.PacketAcknowledgementKey(hop.DestinationPort, hop.DestinationChannel, hop.Sequence))
+	res, err = s.chainB.SendMsgs(channeltypes.NewMsgAcknowledgement(hop, hopAck, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String()))
+	s.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	return ack
+}
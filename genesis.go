@@ -1,5 +1,17 @@
 package linkedpackets
 
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
 	return &GenesisState{
@@ -7,20 +19,268 @@ func NewGenesisState() *GenesisState {
 	}
 }
 
+// channelLink identifies a link received on a channel.
+type channelLink struct {
+	channel ChannelIdentifier
+	linkID  string
+}
+
 // Validate performs basic genesis state validation returning an error upon any
+// failure.
 func (gs *GenesisState) Validate() error {
-	uniq := make(map[string]bool)
-	for _, counter := range gs.Counters {
-		if _, ok := uniq[counter.Address]; ok {
-			return ErrDuplicateAddress
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	channels := make(map[ChannelIdentifier]bool)
+	for _, channel := range gs.LinkEnabledChannels {
+		if err := validateChannelIdentifier(channel.PortId, channel.ChannelId); err != nil {
+			return err
+		}
+		if channels[channel] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate link enabled channel %s/%s", channel.PortId, channel.ChannelId)
 		}
+		channels[channel] = true
+	}
 
-		uniq[counter.Address] = true
+	pausedChannels := make(map[ChannelIdentifier]bool)
+	for _, channel := range gs.PausedChannels {
+		if err := validateChannelIdentifier(channel.PortId, channel.ChannelId); err != nil {
+			return err
+		}
+		if pausedChannels[channel] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate paused channel %s/%s", channel.PortId, channel.ChannelId)
+		}
+		pausedChannels[channel] = true
 	}
 
-	if err := gs.Params.Validate(); err != nil {
+	rateLimitedAccounts := make(map[string]bool)
+	for _, rateLimit := range gs.AccountRateLimits {
+		if _, err := sdk.AccAddressFromBech32(rateLimit.Account); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid rate limited account %s: %s", rateLimit.Account, err)
+		}
+		if rateLimitedAccounts[rateLimit.Account] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate rate limit of account %s", rateLimit.Account)
+		}
+		rateLimitedAccounts[rateLimit.Account] = true
+	}
+
+	rateLimitedChannels := make(map[ChannelIdentifier]bool)
+	for _, rateLimit := range gs.ChannelRateLimits {
+		if err := validateChannelIdentifier(rateLimit.Channel.PortId, rateLimit.Channel.ChannelId); err != nil {
+			return err
+		}
+		if rateLimitedChannels[rateLimit.Channel] {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesis, "duplicate rate limit of channel %s/%s", rateLimit.Channel.PortId, rateLimit.Channel.ChannelId,
+			)
+		}
+		rateLimitedChannels[rateLimit.Channel] = true
+	}
+
+	links := make(map[string]Link)
+	for _, link := range gs.Links {
+		if err := validateLink(link); err != nil {
+			return err
+		}
+		if _, ok := links[link.LinkId]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate link %s", link.LinkId)
+		}
+		links[link.LinkId] = link
+	}
+
+	owners := make(map[string]bool)
+	for _, session := range gs.Sessions {
+		if _, err := sdk.AccAddressFromBech32(session.Owner); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid session owner %s: %s", session.Owner, err)
+		}
+		if owners[session.Owner] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate session of owner %s", session.Owner)
+		}
+		owners[session.Owner] = true
+
+		link, ok := links[session.LinkId]
+		if !ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "session link %s not found", session.LinkId)
+		}
+		if link.Status != LinkStatusOpen {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "session link %s is not open", session.LinkId)
+		}
+		if link.Owner != session.Owner {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "session link %s is not owned by %s", session.LinkId, session.Owner)
+		}
+		if session.PrevPacket != (PacketIdentifier{}) {
+			if err := validatePacketIdentifier(session.PrevPacket); err != nil {
+				return err
+			}
+		}
+	}
+
+	packets := make(map[PacketIdentifier]bool)
+	for _, packetLink := range gs.PacketLinks {
+		if err := validatePacketIdentifier(packetLink.Packet); err != nil {
+			return err
+		}
+		if _, ok := links[packetLink.LinkId]; !ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "packet link of unknown link %s", packetLink.LinkId)
+		}
+		if packets[packetLink.Packet] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate packet link %s", packetLink.Packet)
+		}
+		packets[packetLink.Packet] = true
+	}
+
+	received := make(map[channelLink]bool)
+	for _, r := range gs.ReceivedLinkPackets {
+		if err := validateChannelIdentifier(r.Channel.PortId, r.Channel.ChannelId); err != nil {
+			return err
+		}
+		if r.LinkId == "" {
+			return errorsmod.Wrap(ErrInvalidGenesis, "received link identifier cannot be empty")
+		}
+		key := channelLink{channel: r.Channel, linkID: r.LinkId}
+		if received[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate received link %s on %s/%s", r.LinkId, r.Channel.PortId, r.Channel.ChannelId)
+		}
+		received[key] = true
+	}
+
+	forwarded := make(map[channelLink]bool)
+	for _, f := range gs.ForwardedLinkPackets {
+		if err := validateChannelIdentifier(f.Channel.PortId, f.Channel.ChannelId); err != nil {
+			return err
+		}
+		if f.LinkId == "" {
+			return errorsmod.Wrap(ErrInvalidGenesis, "forwarded link identifier cannot be empty")
+		}
+		if err := validatePacketIdentifier(f.LastHop); err != nil {
+			return err
+		}
+		key := channelLink{channel: f.Channel, linkID: f.LinkId}
+		if forwarded[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate forwarded link %s on %s/%s", f.LinkId, f.Channel.PortId, f.Channel.ChannelId)
+		}
+		forwarded[key] = true
+	}
+
+	buffered := make(map[channelLink]bool)
+	for _, b := range gs.BufferedLinks {
+		if err := validateBufferedLink(b); err != nil {
+			return err
+		}
+		key := channelLink{channel: b.Channel, linkID: b.LinkId}
+		if buffered[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate buffered link %s on %s/%s", b.LinkId, b.Channel.PortId, b.Channel.ChannelId)
+		}
+		buffered[key] = true
+	}
+
+	return nil
+}
+
+// validateBufferedLink checks that the buffered packets of a link were received on its channel.
+func validateBufferedLink(b BufferedLink) error {
+	if err := validateChannelIdentifier(b.Channel.PortId, b.Channel.ChannelId); err != nil {
 		return err
 	}
+	if b.LinkId == "" {
+		return errorsmod.Wrap(ErrInvalidGenesis, "buffered link identifier cannot be empty")
+	}
+	if len(b.Packets) == 0 {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "buffered link %s has no packets", b.LinkId)
+	}
+
+	for _, p := range b.Packets {
+		var packet channeltypes.Packet
+		if err := packet.Unmarshal(p.Packet); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid buffered packet of link %s: %s", b.LinkId, err)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid buffered packet of link %s: %s", b.LinkId, err)
+		}
+		if packet.DestinationPort != b.Channel.PortId || packet.DestinationChannel != b.Channel.ChannelId {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "buffered packet %d of link %s was not received on its channel", packet.Sequence, b.LinkId)
+		}
+		if p.Relayer != "" {
+			if _, err := sdk.AccAddressFromBech32(p.Relayer); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid relayer of buffered packet %d: %s", packet.Sequence, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, link := range gs.Links {
+		if err := link.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateLink performs a stateless validation of a link record.
+func validateLink(link Link) error {
+	if link.LinkId == "" {
+		return errorsmod.Wrap(ErrInvalidGenesis, "link identifier cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(link.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid owner of link %s: %s", link.LinkId, err)
+	}
+	if _, ok := LinkStatus_name[int32(link.Status)]; !ok || link.Status == LinkStatusUnspecified {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid status of link %s: %s", link.LinkId, link.Status)
+	}
+
+	for _, p := range link.Packets {
+		if err := validatePacketIdentifier(p.Packet); err != nil {
+			return err
+		}
+	}
+
+	if err := link.Deposit.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid deposit of link %s: %s", link.LinkId, err)
+	}
+
+	if err := link.RelayerReward.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid relayer reward of link %s: %s", link.LinkId, err)
+	}
+
+	if err := ValidateCompensations(link.Compensations); err != nil {
+		return err
+	}
+
+	for _, c := range link.CompensationPackets {
+		if err := validatePacketIdentifier(c.Packet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePacketIdentifier checks that the port, channel and sequence of a packet identifier are valid.
+func validatePacketIdentifier(packet PacketIdentifier) error {
+	if err := validateChannelIdentifier(packet.PortId, packet.ChannelId); err != nil {
+		return err
+	}
+	if _, err := strconv.ParseUint(packet.Seq, 10, 64); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid sequence of packet %s: %s", packet, err)
+	}
+
+	return nil
+}
+
+// validateChannelIdentifier checks that the port and channel identifiers are valid.
+func validateChannelIdentifier(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+	}
 
 	return nil
 }
@@ -0,0 +1,268 @@
+package linkedpackets_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/simapp"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	const (
+		owner      = "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"
+		otherOwner = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	)
+
+	packet := linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "1"}
+	link := linkedpackets.Link{
+		LinkId:  "mylinkid",
+		Owner:   owner,
+		Status:  linkedpackets.LinkStatusOpen,
+		Packets: []linkedpackets.LinkPacket{{Packet: packet}},
+	}
+
+	icaPacket := channeltypes.NewPacket(
+		[]byte("data"), 1, "icacontroller-owner", "channel-0", "icahost", "channel-0", clienttypes.ZeroHeight(), 1,
+	)
+	bufferedPacket, err := icaPacket.Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		malleate  func(gs *linkedpackets.GenesisState)
+		expErrMsg string
+	}{
+		{"default genesis", func(gs *linkedpackets.GenesisState) { *gs = *linkedpackets.NewGenesisState() }, ""},
+		{"valid genesis", func(*linkedpackets.GenesisState) {}, ""},
+		{
+			"invalid link enabled channel",
+			func(gs *linkedpackets.GenesisState) {
+				gs.LinkEnabledChannels = append(gs.LinkEnabledChannels, linkedpackets.ChannelIdentifier{PortId: "transfer"})
+			},
+			"invalid genesis state",
+		},
+		{
+			"duplicate link enabled channel",
+			func(gs *linkedpackets.GenesisState) {
+				gs.LinkEnabledChannels = append(gs.LinkEnabledChannels, gs.LinkEnabledChannels[0])
+			},
+			"duplicate link enabled channel",
+		},
+		{
+			"invalid paused channel",
+			func(gs *linkedpackets.GenesisState) {
+				gs.PausedChannels = append(gs.PausedChannels, linkedpackets.ChannelIdentifier{PortId: "transfer"})
+			},
+			"invalid genesis state",
+		},
+		{
+			"duplicate paused channel",
+			func(gs *linkedpackets.GenesisState) {
+				gs.PausedChannels = append(gs.PausedChannels, gs.PausedChannels[0])
+			},
+			"duplicate paused channel",
+		},
+		{
+			"invalid rate limited account",
+			func(gs *linkedpackets.GenesisState) { gs.AccountRateLimits[0].Account = "foo" },
+			"invalid rate limited account",
+		},
+		{
+			"duplicate account rate limit",
+			func(gs *linkedpackets.GenesisState) {
+				gs.AccountRateLimits = append(gs.AccountRateLimits, gs.AccountRateLimits[0])
+			},
+			"duplicate rate limit of account",
+		},
+		{
+			"duplicate channel rate limit",
+			func(gs *linkedpackets.GenesisState) {
+				gs.ChannelRateLimits = append(gs.ChannelRateLimits, gs.ChannelRateLimits[0])
+			},
+			"duplicate rate limit of channel transfer/channel-0",
+		},
+		{"duplicate link", func(gs *linkedpackets.GenesisState) { gs.Links = append(gs.Links, link) }, "duplicate link mylinkid"},
+		{"invalid link owner", func(gs *linkedpackets.GenesisState) { gs.Links[0].Owner = "foo" }, "invalid owner"},
+		{
+			"invalid link status",
+			func(gs *linkedpackets.GenesisState) { gs.Links[0].Status = linkedpackets.LinkStatusUnspecified },
+			"invalid status",
+		},
+		{"invalid link packet", func(gs *linkedpackets.GenesisState) { gs.Links[0].Packets[0].Packet.Seq = "foo" }, "invalid sequence"},
+		{"unknown session link", func(gs *linkedpackets.GenesisState) { gs.Sessions[0].LinkId = "otherlinkid" }, "session link otherlinkid not found"},
+		{
+			"closed session link",
+			func(gs *linkedpackets.GenesisState) { gs.Links[0].Status = linkedpackets.LinkStatusPending },
+			"session link mylinkid is not open",
+		},
+		{"invalid session owner", func(gs *linkedpackets.GenesisState) { gs.Sessions[0].Owner = "foo" }, "invalid session owner"},
+		{
+			"session owner is not the link owner",
+			func(gs *linkedpackets.GenesisState) { gs.Sessions[0].Owner = otherOwner },
+			"session link mylinkid is not owned by",
+		},
+		{
+			"duplicate session",
+			func(gs *linkedpackets.GenesisState) { gs.Sessions = append(gs.Sessions, gs.Sessions[0]) },
+			"duplicate session of owner",
+		},
+		{"empty session prev packet", func(gs *linkedpackets.GenesisState) { gs.Sessions[0].PrevPacket = linkedpackets.PacketIdentifier{} }, ""},
+		{
+			"unknown packet link link",
+			func(gs *linkedpackets.GenesisState) { gs.PacketLinks[0].LinkId = "otherlinkid" },
+			"packet link of unknown link",
+		},
+		{
+			"duplicate packet link",
+			func(gs *linkedpackets.GenesisState) { gs.PacketLinks = append(gs.PacketLinks, gs.PacketLinks[0]) },
+			"duplicate packet link",
+		},
+		{
+			"duplicate received link packet",
+			func(gs *linkedpackets.GenesisState) {
+				gs.ReceivedLinkPackets = append(gs.ReceivedLinkPackets, gs.ReceivedLinkPackets[0])
+			},
+			"duplicate received link mylinkid",
+		},
+		{
+			"invalid received link packet",
+			func(gs *linkedpackets.GenesisState) { gs.ReceivedLinkPackets[0].LinkId = "" },
+			"received link identifier cannot be empty",
+		},
+		{
+			"invalid forwarded link packet",
+			func(gs *linkedpackets.GenesisState) { gs.ForwardedLinkPackets[0].LinkId = "" },
+			"forwarded link identifier cannot be empty",
+		},
+		{
+			"duplicate forwarded link packet",
+			func(gs *linkedpackets.GenesisState) {
+				gs.ForwardedLinkPackets = append(gs.ForwardedLinkPackets, gs.ForwardedLinkPackets[0])
+			},
+			"duplicate forwarded link mylinkid",
+		},
+		{
+			"invalid buffered link packet",
+			func(gs *linkedpackets.GenesisState) { gs.BufferedLinks[0].Packets[0].Packet = []byte("foo") },
+			"invalid buffered packet of link mylinkid",
+		},
+		{
+			"buffered link packet received on another channel",
+			func(gs *linkedpackets.GenesisState) { gs.BufferedLinks[0].Channel.ChannelId = "channel-1" },
+			"was not received on its channel",
+		},
+		{
+			"duplicate buffered link",
+			func(gs *linkedpackets.GenesisState) { gs.BufferedLinks = append(gs.BufferedLinks, gs.BufferedLinks[0]) },
+			"duplicate buffered link mylinkid",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := &linkedpackets.GenesisState{
+				Params:              linkedpackets.DefaultParams(),
+				LinkEnabledChannels: []linkedpackets.ChannelIdentifier{{PortId: "transfer", ChannelId: "channel-0"}},
+				PausedChannels:      []linkedpackets.ChannelIdentifier{{PortId: "transfer", ChannelId: "channel-1"}},
+				AccountRateLimits:   []linkedpackets.AccountRateLimit{{Account: owner, Usage: linkedpackets.RateLimitUsage{Window: 1, Used: 1}}},
+				ChannelRateLimits: []linkedpackets.ChannelRateLimit{{
+					Channel: linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-0"},
+					Usage:   linkedpackets.RateLimitUsage{Window: 1, Used: 1},
+				}},
+				Links:        []linkedpackets.Link{link},
+				PacketLinks:  []linkedpackets.PacketLink{{Packet: packet, LinkId: "mylinkid"}},
+				LinkSequence: 1,
+				ReceivedLinkPackets: []linkedpackets.ReceivedLinkPacket{{
+					Channel:  linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-1"},
+					LinkId:   "mylinkid",
+					Sequence: 1,
+				}},
+				Sessions: []linkedpackets.LinkSession{{LinkId: "mylinkid", PrevPacket: packet, Owner: owner, LinkIndex: 1}},
+				ForwardedLinkPackets: []linkedpackets.ForwardedLinkPacket{
+					{Channel: linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-1"}, LinkId: "mylinkid", LastHop: packet},
+				},
+				BufferedLinks: []linkedpackets.BufferedLink{{
+					Channel: linkedpackets.ChannelIdentifier{PortId: "icahost", ChannelId: "channel-0"},
+					LinkId:  "mylinkid",
+					Packets: []linkedpackets.BufferedLinkPacket{{Packet: bufferedPacket, Relayer: owner}},
+				}},
+			}
+			// the link packets are copied so that the test cases don't share them
+			gs.Links[0].Packets = []linkedpackets.LinkPacket{{Packet: packet}}
+
+			tc.malleate(gs)
+
+			err := gs.Validate()
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *LinkedPacketsTestSuite) TestExportImportGenesis() {
+	s.SetupLinkedPacketsTransferTest()
+
+	s.ExecuteInitLink("mylinkid")
+	s.ExecuteTransfer("1")
+
+	for _, chain := range []*ibctesting.TestChain{s.chainA, s.chainB} {
+		exported := s.exportGenesis(chain)
+		s.Require().NoError(exported.Validate())
+		s.Require().Equal([]linkedpackets.ChannelIdentifier{{PortId: "transfer", ChannelId: "channel-0"}}, exported.LinkEnabledChannels)
+
+		// import the exported state into a new chain and export it again
+		app := simapp.Setup(s.T(), false)
+		ctx := app.BaseApp.NewContext(false)
+		s.Require().NoError(app.LinkedPacketsKeeper.InitGenesis(ctx, exported))
+
+		reexported, err := app.LinkedPacketsKeeper.ExportGenesis(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(app.AppCodec().MustMarshalJSON(exported), app.AppCodec().MustMarshalJSON(reexported))
+	}
+
+	exportedA := s.exportGenesis(s.chainA)
+	s.Require().Equal([]linkedpackets.LinkSession{{
+		LinkId:     "mylinkid",
+		PrevPacket: linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "1"},
+		Owner:      s.chainA.SenderAccount.GetAddress().String(),
+		LinkIndex:  1,
+	}}, exportedA.Sessions)
+	s.Require().Len(exportedA.Links, 1)
+	s.Require().Len(exportedA.PacketLinks, 1)
+
+	exportedB := s.exportGenesis(s.chainB)
+	s.Require().Empty(exportedB.Sessions)
+	s.Require().Empty(exportedB.Links)
+	s.Require().Equal([]linkedpackets.ReceivedLinkPacket{{
+		Channel:  linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-0"},
+		LinkId:   "mylinkid",
+		Sequence: 1,
+	}}, exportedB.ReceivedLinkPackets)
+}
+
+// exportGenesis exports the linkedpackets genesis state of the chain through the simapp export.
+func (s *LinkedPacketsTestSuite) exportGenesis(chain *ibctesting.TestChain) *linkedpackets.GenesisState {
+	app := GetSimApp(chain)
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, []string{linkedpackets.ModuleName})
+	s.Require().NoError(err)
+
+	var appState map[string]json.RawMessage
+	s.Require().NoError(json.Unmarshal(exported.AppState, &appState))
+
+	var gs linkedpackets.GenesisState
+	s.Require().NoError(app.AppCodec().UnmarshalJSON(appState[linkedpackets.ModuleName], &gs))
+
+	return &gs
+}
@@ -0,0 +1,76 @@
+package linkedpackets
+
+import "context"
+
+// LinkHooks defines the hooks other modules can implement to react to the lifecycle of links.
+type LinkHooks interface {
+	// AfterLinkInit is called after a link is opened on the sending chain.
+	AfterLinkInit(ctx context.Context, link Link) error
+	// AfterLinkPacketSent is called after a packet is sent as part of a link on the sending chain.
+	AfterLinkPacketSent(ctx context.Context, linkID string, packet PacketIdentifier, linkIndex uint64) error
+	// AfterLinkPacketReceived is called after a linked packet is successfully received on the receiving chain.
+	AfterLinkPacketReceived(ctx context.Context, packet PacketIdentifier, linkData LinkData) error
+	// AfterLinkCompleted is called after every packet of a link is successfully acknowledged on the sending chain.
+	AfterLinkCompleted(ctx context.Context, link Link) error
+	// AfterLinkFailed is called after a link is resolved on the sending chain with one or more failed packets.
+	AfterLinkFailed(ctx context.Context, link Link) error
+}
+
+// combine multiple link hooks, all hook functions are run in array sequence
+var _ LinkHooks = &MultiLinkHooks{}
+
+type MultiLinkHooks []LinkHooks
+
+func NewMultiLinkHooks(hooks ...LinkHooks) MultiLinkHooks {
+	return hooks
+}
+
+func (h MultiLinkHooks) AfterLinkInit(ctx context.Context, link Link) error {
+	for i := range h {
+		if err := h[i].AfterLinkInit(ctx, link); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiLinkHooks) AfterLinkPacketSent(ctx context.Context, linkID string, packet PacketIdentifier, linkIndex uint64) error {
+	for i := range h {
+		if err := h[i].AfterLinkPacketSent(ctx, linkID, packet, linkIndex); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiLinkHooks) AfterLinkPacketReceived(ctx context.Context, packet PacketIdentifier, linkData LinkData) error {
+	for i := range h {
+		if err := h[i].AfterLinkPacketReceived(ctx, packet, linkData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiLinkHooks) AfterLinkCompleted(ctx context.Context, link Link) error {
+	for i := range h {
+		if err := h[i].AfterLinkCompleted(ctx, link); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiLinkHooks) AfterLinkFailed(ctx context.Context, link Link) error {
+	for i := range h {
+		if err := h[i].AfterLinkFailed(ctx, link); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
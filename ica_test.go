@@ -1,12 +1,14 @@
 package linkedpackets_test
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
 	sdkmath "cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -14,6 +16,8 @@ import (
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
 )
 
 func (s *LinkedPacketsTestSuite) TestICACallbacks() {
@@ -66,6 +70,67 @@ func (s *LinkedPacketsTestSuite) TestICATimeoutCallbacks() {
 	}
 }
 
+func (s *LinkedPacketsTestSuite) TestICACompensation() {
+	icaAddr := s.SetupICATest()
+
+	validatorAddr := (sdk.ValAddress)(s.chainB.Vals.Validators[0].Address)
+	msgUndelegate, err := codectypes.NewAnyWithValue(&stakingtypes.MsgUndelegate{
+		DelegatorAddress: icaAddr,
+		ValidatorAddress: validatorAddr.String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
+	})
+	s.Require().NoError(err)
+
+	res, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{
+		Sender: s.chainA.SenderAccount.GetAddress().String(),
+		LinkId: "mylinkid",
+		Compensations: []linkedpackets.Compensation{
+			{LinkIndex: 0, Messages: []*codectypes.Any{msgUndelegate}},
+		},
+	})
+	s.Require().NotEmpty(res)
+	s.Require().NoError(err)
+
+	// the first member succeeds
+	s.ExecuteICATx(icaAddr, "")
+
+	// the second member fails on the host since the interchain account does not have enough funds
+	packetData := s.buildICAMsgDelegatePacketData(icaAddr, linkedpackets.LastLinkMemoKey)
+	data, err := icatypes.SerializeCosmosTx(GetSimApp(s.chainA).AppCodec(), []proto.Message{&stakingtypes.MsgDelegate{
+		DelegatorAddress: icaAddr,
+		ValidatorAddress: validatorAddr.String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000)),
+	}}, icatypes.EncodingProtobuf)
+	s.Require().NoError(err)
+	packetData.Data = data
+
+	timeoutTimestamp := uint64(s.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	msg := icacontrollertypes.NewMsgSendTx(s.chainA.SenderAccount.GetAddress().String(), s.path.EndpointA.ConnectionID, timeoutTimestamp, packetData)
+	res, err = s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkStatusPartiallyFailed, link.Status)
+	s.Require().Len(link.CompensationPackets, 1)
+
+	compensation := link.CompensationPackets[0]
+	s.Require().Equal(uint64(0), compensation.LinkIndex)
+	s.Require().Equal(linkedpackets.PacketOutcomePending, compensation.Outcome)
+	s.Require().Equal(s.path.EndpointA.ChannelID, compensation.Packet.ChannelId)
+
+	seq, err := strconv.ParseUint(compensation.Packet.Seq, 10, 64)
+	s.Require().NoError(err)
+	commitment := GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.GetPacketCommitment(
+		s.chainA.GetContext(), compensation.Packet.PortId, compensation.Packet.ChannelId, seq,
+	)
+	s.Require().NotEmpty(commitment)
+}
+
 // ExecuteICATx executes a stakingtypes.MsgDelegate on chainB by sending a packet containing the msg to chainB
 func (s *LinkedPacketsTestSuite) ExecuteICATx(icaAddress, memo string) {
 	timeoutTimestamp := uint64(s.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
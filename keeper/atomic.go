@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// BufferedPacket is a received linked packet whose execution is deferred until the last packet of its link is
+// received.
+type BufferedPacket struct {
+	Packet  channeltypes.Packet
+	Relayer sdk.AccAddress
+}
+
+// IsAtomicExecutionEnabled returns true if the packets of atomic links received on a port can be executed together.
+// Only the packets received on the interchain accounts host port can, if atomic execution is enabled with
+// SetICAHostChannelKeeper.
+func (k Keeper) IsAtomicExecutionEnabled(portID string) bool {
+	return k.atomicICAHost && portID == icatypes.HostPortID
+}
+
+// BufferLinkPacket defers the execution of a received linked packet until the last packet of its link is received.
+// The packet is acknowledged once it is executed.
+func (k Keeper) BufferLinkPacket(ctx context.Context, bufferedPacket BufferedPacket, linkData linkedpackets.LinkData) error {
+	linkIndex, err := strconv.ParseUint(linkData.LinkIndex, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	packet := bufferedPacket.Packet
+	key := collections.Join3(packet.DestinationPort, packet.DestinationChannel, linkData.LinkID)
+	buffered, err := k.BufferedLinkPackets.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&packet)
+	if err != nil {
+		return err
+	}
+
+	var relayer string
+	if len(bufferedPacket.Relayer) != 0 {
+		if relayer, err = k.addressCodec.BytesToString(bufferedPacket.Relayer); err != nil {
+			return err
+		}
+	}
+
+	buffered.Packets = append(buffered.Packets, linkedpackets.BufferedLinkPacket{Packet: bz, Relayer: relayer})
+	if err := k.BufferedLinkPackets.Set(ctx, key, buffered); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkPacketBuffered{
+		LinkId:    linkData.LinkID,
+		LinkIndex: linkIndex,
+		Packet: linkedpackets.PacketIdentifier{
+			PortId:    packet.DestinationPort,
+			ChannelId: packet.DestinationChannel,
+			Seq:       strconv.FormatUint(packet.Sequence, 10),
+		},
+	})
+}
+
+// TakeBufferedLinkPackets removes and returns the buffered packets of a link received on a channel, in link order.
+func (k Keeper) TakeBufferedLinkPackets(ctx context.Context, portID, channelID, linkID string) ([]BufferedPacket, error) {
+	key := collections.Join3(portID, channelID, linkID)
+	buffered, err := k.BufferedLinkPackets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	packets := make([]BufferedPacket, len(buffered.Packets))
+	for i, bufferedPacket := range buffered.Packets {
+		if err := k.cdc.Unmarshal(bufferedPacket.Packet, &packets[i].Packet); err != nil {
+			return nil, err
+		}
+
+		if bufferedPacket.Relayer != "" {
+			if packets[i].Relayer, err = k.addressCodec.StringToBytes(bufferedPacket.Relayer); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return packets, k.BufferedLinkPackets.Remove(ctx, key)
+}
+
+// WriteBufferedAcknowledgement writes the acknowledgement of a buffered packet once it is executed. The
+// acknowledgement is written as is, it must already be wrapped like the acknowledgements returned by the
+// middleware.
+func (k Keeper) WriteBufferedAcknowledgement(ctx context.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement) error {
+	if k.channelKeeper == nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "channel keeper is not set")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(sdkCtx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return err
+	}
+
+	return k.channelKeeper.WriteAcknowledgement(sdkCtx, chanCap, packet, ack)
+}
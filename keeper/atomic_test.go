@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+)
+
+func TestBufferedLinkPackets(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	newPacket := func(seq uint64) channeltypes.Packet {
+		return channeltypes.Packet{
+			Sequence:           seq,
+			SourcePort:         "icacontroller-owner",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "icahost",
+			DestinationChannel: "channel-1",
+			Data:               []byte("data"),
+		}
+	}
+
+	require.False(f.k.IsAtomicExecutionEnabled("icahost"))
+
+	packets, err := f.k.TakeBufferedLinkPackets(f.ctx, "icahost", "channel-1", "mylinkid")
+	require.NoError(err)
+	require.Empty(packets)
+
+	expPackets := []keeper.BufferedPacket{
+		{Packet: newPacket(1), Relayer: f.addrs[1]},
+		{Packet: newPacket(2)},
+	}
+	for i, p := range expPackets {
+		linkData := linkedpackets.LinkData{LinkID: "mylinkid", LinkIndex: strconv.Itoa(i)}
+		require.NoError(f.k.BufferLinkPacket(f.ctx, p, linkData))
+	}
+
+	// the packets of other links are buffered separately
+	require.NoError(f.k.BufferLinkPacket(f.ctx, keeper.BufferedPacket{Packet: newPacket(3)}, linkedpackets.LinkData{
+		LinkID: "otherlinkid", LinkIndex: "0",
+	}))
+
+	packets, err = f.k.TakeBufferedLinkPackets(f.ctx, "icahost", "channel-1", "mylinkid")
+	require.NoError(err)
+	require.Equal(expPackets, packets)
+
+	// the packets are no longer buffered once taken
+	found, err := f.k.BufferedLinkPackets.Has(f.ctx, collections.Join3("icahost", "channel-1", "mylinkid"))
+	require.NoError(err)
+	require.False(found)
+
+	found, err = f.k.BufferedLinkPackets.Has(f.ctx, collections.Join3("icahost", "channel-1", "otherlinkid"))
+	require.NoError(err)
+	require.True(found)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// contractCallbacks holds the contract keeper called back when links are resolved or received.
+type contractCallbacks struct {
+	contractKeeper linkedpackets.ContractKeeper
+	// maxCallbackGas is the maximum amount of gas a link callback of the contract keeper can consume.
+	maxCallbackGas uint64
+}
+
+// ExecuteLinkReceivedCallback calls the destination callback contract of a link once its last packet is
+// received. It is a no-op if the packet is not the last packet of its link, if the link has no destination
+// callback or if no contract keeper is set. The state changes of the contract are discarded if it fails.
+func (k Keeper) ExecuteLinkReceivedCallback(ctx sdk.Context, packet channeltypes.Packet, linkData linkedpackets.LinkData) error {
+	if !linkData.IsLastPacket || linkData.DestCallbackAddress == "" || k.callbacks.contractKeeper == nil {
+		return nil
+	}
+
+	return k.processLinkCallback(
+		ctx, linkData.LinkID, linkedpackets.CallbackTypeLinkReceived, linkData.DestCallbackAddress,
+		func(cachedCtx sdk.Context) error {
+			return k.callbacks.contractKeeper.IBCOnLinkReceivedCallback(cachedCtx, packet, linkData, linkData.DestCallbackAddress)
+		},
+	)
+}
+
+// executeLinkResolvedCallback calls the source callback contract of a resolved link. A failing contract does not
+// revert the resolution of the link, its state changes are discarded and the error is only logged.
+func (k Keeper) executeLinkResolvedCallback(ctx sdk.Context, link linkedpackets.Link) {
+	if link.SrcCallbackAddress == "" || k.callbacks.contractKeeper == nil {
+		return
+	}
+
+	err := k.processLinkCallback(
+		ctx, link.LinkId, linkedpackets.CallbackTypeLinkResolved, link.SrcCallbackAddress,
+		func(cachedCtx sdk.Context) error {
+			return k.callbacks.contractKeeper.IBCOnLinkResolvedCallback(cachedCtx, link, link.SrcCallbackAddress)
+		},
+	)
+	if err != nil {
+		k.Logger(ctx).Error("link callback failed", "link_id", link.LinkId, "contract", link.SrcCallbackAddress, "error", err)
+	}
+}
+
+// processLinkCallback executes the callback in a cached context limited to the maximum callback gas, and only
+// writes its state changes if it succeeds. Panics of the callback, including running out of gas, are returned
+// as errors. The gas consumed by the callback is charged to the given context.
+func (k Keeper) processLinkCallback(
+	ctx sdk.Context, linkID, callbackType, contractAddress string, callbackExecutor func(sdk.Context) error,
+) (err error) {
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(storetypes.NewGasMeter(k.callbacks.maxCallbackGas))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("linked packets %s callback", callbackType))
+
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(linkedpackets.ErrLinkCallbackFailed, "%s callback panicked with: %v", callbackType, r)
+		}
+		if cachedCtx.GasMeter().IsPastLimit() {
+			err = errorsmod.Wrapf(linkedpackets.ErrLinkCallbackFailed, "%s callback out of gas", callbackType)
+		}
+
+		var errMsg string
+		if err != nil {
+			errMsg = err.Error()
+		}
+		if emitErr := ctx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkCallback{
+			LinkId:          linkID,
+			CallbackType:    callbackType,
+			ContractAddress: contractAddress,
+			Error:           errMsg,
+		}); emitErr != nil && err == nil {
+			err = emitErr
+		}
+	}()
+
+	if err := callbackExecutor(cachedCtx); err != nil {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkCallbackFailed, "%s callback: %v", callbackType, err)
+	}
+
+	writeFn()
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// mockContractKeeper records the link callbacks it receives and runs the given contract on each of them.
+type mockContractKeeper struct {
+	contract  func(ctx sdk.Context) error
+	callbacks []string
+}
+
+func (c *mockContractKeeper) IBCOnLinkResolvedCallback(ctx sdk.Context, link linkedpackets.Link, contractAddress string) error {
+	c.callbacks = append(c.callbacks, linkedpackets.CallbackTypeLinkResolved+":"+link.LinkId+":"+contractAddress)
+	return c.contract(ctx)
+}
+
+func (c *mockContractKeeper) IBCOnLinkReceivedCallback(
+	ctx sdk.Context, _ channeltypes.Packet, linkData linkedpackets.LinkData, contractAddress string,
+) error {
+	c.callbacks = append(c.callbacks, linkedpackets.CallbackTypeLinkReceived+":"+linkData.LinkID+":"+contractAddress)
+	return c.contract(ctx)
+}
+
+func TestLinkCallbacks(t *testing.T) {
+	contractKey := collections.Join("transfer", "channel-contract")
+
+	testCases := []struct {
+		name         string
+		contract     func(k *testFixture) func(ctx sdk.Context) error
+		expCallbacks []string
+		expWritten   bool
+		expError     string
+	}{
+		{
+			"success: contract state written",
+			func(f *testFixture) func(ctx sdk.Context) error {
+				return func(ctx sdk.Context) error { return f.k.LinkEnabled.Set(ctx, contractKey) }
+			},
+			[]string{"link_resolved:mylinkid:src_contract", "link_received:mylinkid:dest_contract"},
+			true,
+			"",
+		},
+		{
+			"failure: contract state discarded if the contract fails",
+			func(f *testFixture) func(ctx sdk.Context) error {
+				return func(ctx sdk.Context) error {
+					if err := f.k.LinkEnabled.Set(ctx, contractKey); err != nil {
+						return err
+					}
+					return errors.New("contract error")
+				}
+			},
+			[]string{"link_resolved:mylinkid:src_contract", "link_received:mylinkid:dest_contract"},
+			false,
+			"contract error",
+		},
+		{
+			"failure: contract runs out of gas",
+			func(*testFixture) func(ctx sdk.Context) error {
+				return func(ctx sdk.Context) error {
+					ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "contract")
+					return nil
+				}
+			},
+			[]string{"link_resolved:mylinkid:src_contract", "link_received:mylinkid:dest_contract"},
+			false,
+			"out of gas",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			contractKeeper := &mockContractKeeper{contract: tc.contract(f)}
+			f.k.SetContractKeeper(contractKeeper, 100_000)
+
+			owner := f.addrs[1].String()
+			_, err := f.k.StartLink(f.ctx, owner, linkedpackets.LinkOptions{
+				LinkID:              "mylinkid",
+				SrcCallbackAddress:  "src_contract",
+				DestCallbackAddress: "dest_contract",
+			})
+			require.NoError(err)
+			require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+			require.NoError(f.k.EndLink(f.ctx, owner))
+
+			// the link is resolved even if its source callback fails
+			require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+			link, err := f.k.Links.Get(f.ctx, "mylinkid")
+			require.NoError(err)
+			require.Equal(linkedpackets.LinkStatusSucceeded, link.Status)
+			require.Equal("dest_contract", link.DestCallbackAddress)
+
+			written, err := f.k.LinkEnabled.Has(f.ctx, contractKey)
+			require.NoError(err)
+			require.Equal(tc.expWritten, written)
+			require.NoError(f.k.LinkEnabled.Remove(f.ctx, contractKey))
+
+			linkData := linkedpackets.LinkData{LinkID: "mylinkid", DestCallbackAddress: "dest_contract"}
+			require.NoError(f.k.ExecuteLinkReceivedCallback(f.ctx, channeltypes.Packet{}, linkData))
+
+			linkData.IsLastPacket = true
+			err = f.k.ExecuteLinkReceivedCallback(f.ctx, channeltypes.Packet{}, linkData)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.ErrorIs(err, linkedpackets.ErrLinkCallbackFailed)
+				require.ErrorContains(err, tc.expError)
+			}
+
+			written, err = f.k.LinkEnabled.Has(f.ctx, contractKey)
+			require.NoError(err)
+			require.Equal(tc.expWritten, written)
+
+			require.Equal(tc.expCallbacks, contractKeeper.callbacks)
+
+			var callbackEvents int
+			for _, event := range f.ctx.EventManager().Events() {
+				if event.Type == "srdtrk.linkedpackets.v1.EventLinkCallback" {
+					callbackEvents++
+				}
+			}
+			require.Equal(2, callbackEvents)
+		})
+	}
+}
+
+func TestLinkCallbacksNotSupported(t *testing.T) {
+	f := initFixture(t)
+
+	_, err := f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{SrcCallbackAddress: "src_contract"})
+	require.ErrorContains(t, err, "link callbacks are not supported")
+
+	// destination callbacks are made by the receiving chain
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{DestCallbackAddress: "dest_contract"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// skipLinkingKey is the context key used to send packets outside of the link in progress.
+type skipLinkingKey struct{}
+
+// IsLinkingSkipped returns true if packets sent with the given context must not be linked.
+func IsLinkingSkipped(ctx context.Context) bool {
+	skip, ok := ctx.Value(skipLinkingKey{}).(bool)
+	return ok && skip
+}
+
+// sendCompensations sends the compensations of the link members that succeeded in reverse link index order.
+// A compensation is only sent on the interchain account channel of its member if that interchain account
+// is owned by the link owner. Compensations which cannot be sent are logged and skipped so that they cannot
+// block the resolution of the link.
+func (k Keeper) sendCompensations(ctx context.Context, link *linkedpackets.Link) error {
+	if k.icaControllerKeeper == nil || k.channelKeeper == nil || len(link.Compensations) == 0 {
+		return nil
+	}
+
+	compensations := make([]linkedpackets.Compensation, len(link.Compensations))
+	copy(compensations, link.Compensations)
+	sort.SliceStable(compensations, func(i, j int) bool {
+		return compensations[i].LinkIndex > compensations[j].LinkIndex
+	})
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithValue(skipLinkingKey{}, true)
+	for _, c := range compensations {
+		if c.LinkIndex >= uint64(len(link.Packets)) || link.Packets[c.LinkIndex].Outcome != linkedpackets.PacketOutcomeSuccess {
+			continue
+		}
+
+		member := link.Packets[c.LinkIndex].Packet
+		cacheCtx, writeFn := sdkCtx.CacheContext()
+		seq, err := k.sendCompensation(cacheCtx, link.Owner, member, c)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send link compensation", "link_id", link.LinkId, "link_index", c.LinkIndex, "error", err)
+			continue
+		}
+		writeFn()
+
+		if err := k.PacketLinks.Set(ctx, collections.Join3(member.PortId, member.ChannelId, seq), link.LinkId); err != nil {
+			return err
+		}
+
+		packet := linkedpackets.PacketIdentifier{
+			PortId:    member.PortId,
+			ChannelId: member.ChannelId,
+			Seq:       strconv.FormatUint(seq, 10),
+		}
+		link.CompensationPackets = append(link.CompensationPackets, linkedpackets.CompensationPacket{
+			LinkIndex: c.LinkIndex,
+			Packet:    packet,
+		})
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkCompensationSent{
+			LinkId:    link.LinkId,
+			LinkIndex: c.LinkIndex,
+			Packet:    packet,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendCompensation sends the compensation of a link member on the member's interchain account channel.
+func (k Keeper) sendCompensation(
+	ctx sdk.Context, owner string, member linkedpackets.PacketIdentifier, compensation linkedpackets.Compensation,
+) (uint64, error) {
+	ownerPortID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return 0, err
+	}
+	if member.PortId != ownerPortID {
+		return 0, errorsmod.Wrapf(linkedpackets.ErrInvalidCompensation, "port %s is not owned by %s", member.PortId, owner)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, member.PortId, member.ChannelId)
+	if !found {
+		return 0, errorsmod.Wrapf(linkedpackets.ErrInvalidCompensation, "channel %s/%s not found", member.PortId, member.ChannelId)
+	}
+
+	appVersion := channel.Version
+	if metadata, err := linkedpackets.MetadataFromVersion(channel.Version); err == nil {
+		appVersion = metadata.AppVersion
+	}
+
+	var icaMetadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &icaMetadata); err != nil {
+		return 0, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "failed to unmarshal ICS27 metadata: %v", err)
+	}
+
+	cdc, ok := k.cdc.(*codec.ProtoCodec)
+	if !ok {
+		return 0, errors.New("compensations require a proto codec")
+	}
+
+	msgs := make([]proto.Message, len(compensation.Messages))
+	for i, msgAny := range compensation.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnpackAny(msgAny, &msg); err != nil {
+			return 0, err
+		}
+		msgs[i] = msg
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs, icaMetadata.Encoding)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + icatypes.DefaultRelativePacketTimeoutTimestamp
+
+	return k.icaControllerKeeper.SendTx(ctx, nil, channel.ConnectionHops[0], member.PortId, packetData, timeoutTimestamp)
+}
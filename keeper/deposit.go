@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// escrowDeposit escrows the link deposit of the params from the owner to the module account and records it
+// in the link.
+func (k Keeper) escrowDeposit(ctx context.Context, params linkedpackets.Params, link *linkedpackets.Link) error {
+	if params.LinkDeposit.IsZero() {
+		return nil
+	}
+
+	owner, err := k.addressCodec.StringToBytes(link.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, linkedpackets.ModuleName, params.LinkDeposit); err != nil {
+		return err
+	}
+
+	link.Deposit = params.LinkDeposit
+
+	return nil
+}
+
+// refundDeposit refunds the deposit of a link to its owner and clears it from the link.
+func (k Keeper) refundDeposit(ctx context.Context, link *linkedpackets.Link) error {
+	if link.Deposit.IsZero() {
+		return nil
+	}
+
+	owner, err := k.addressCodec.StringToBytes(link.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, linkedpackets.ModuleName, owner, link.Deposit); err != nil {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkDepositRefunded{
+		LinkId: link.LinkId,
+		Owner:  link.Owner,
+		Amount: link.Deposit,
+	}); err != nil {
+		return err
+	}
+
+	link.Deposit = nil
+
+	return nil
+}
+
+// forfeitDeposit burns the deposit of an expired link or sends it to the community pool, according to the
+// params, and clears it from the link. Deposits are burned if no distribution keeper is set.
+func (k Keeper) forfeitDeposit(
+	ctx context.Context, params linkedpackets.Params, link *linkedpackets.Link,
+) (linkedpackets.ExpiredDepositAction, error) {
+	action := params.ExpiredDepositAction
+	if action == linkedpackets.ExpiredDepositActionCommunityPool && k.distrKeeper == nil {
+		action = linkedpackets.ExpiredDepositActionBurn
+	}
+
+	if link.Deposit.IsZero() {
+		return action, nil
+	}
+
+	var err error
+	switch action {
+	case linkedpackets.ExpiredDepositActionCommunityPool:
+		err = k.distrKeeper.FundCommunityPool(ctx, link.Deposit, authtypes.NewModuleAddress(linkedpackets.ModuleName))
+	default:
+		err = k.bankKeeper.BurnCoins(ctx, linkedpackets.ModuleName, link.Deposit)
+	}
+	if err != nil {
+		return action, err
+	}
+
+	link.Deposit = nil
+
+	return action, nil
+}
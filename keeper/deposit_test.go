@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// mockDistributionKeeper records the funds sent to the community pool.
+type mockDistributionKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+func (d *mockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := d.bankKeeper.subtract(sender, amount); err != nil {
+		return err
+	}
+
+	d.communityPool = d.communityPool.Add(amount...)
+
+	return nil
+}
+
+func TestLinkDeposit(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	moduleAddr := authtypes.NewModuleAddress(linkedpackets.ModuleName).String()
+
+	testCases := []struct {
+		name       string
+		malleate   func(f *testFixture)
+		expRefund  bool
+		expBurned  sdk.Coins
+		expCPFunds sdk.Coins
+	}{
+		{
+			"success: deposit refunded when an empty link is stopped",
+			func(f *testFixture) {
+				_, err := f.msgServer.StopLink(f.ctx, &linkedpackets.MsgStopLink{Sender: f.addrs[1].String()})
+				require.NoError(t, err)
+			},
+			true, nil, nil,
+		},
+		{
+			"success: deposit refunded when a link closes with its last packet",
+			func(f *testFixture) {
+				require.NoError(t, f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+				require.NoError(t, f.k.EndLink(f.ctx, f.addrs[1].String()))
+			},
+			true, nil, nil,
+		},
+		{
+			"success: deposit burned when the link expires",
+			func(f *testFixture) {
+				require.NoError(t, f.k.ExpireLinks(f.ctx.WithBlockHeight(15)))
+			},
+			false, deposit, nil,
+		},
+		{
+			"success: deposit sent to the community pool when the link expires",
+			func(f *testFixture) {
+				params, err := f.k.Params.Get(f.ctx)
+				require.NoError(t, err)
+				params.ExpiredDepositAction = linkedpackets.ExpiredDepositActionCommunityPool
+				require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+				require.NoError(t, f.k.ExpireLinks(f.ctx.WithBlockHeight(15)))
+			},
+			false, nil, deposit,
+		},
+		{
+			"success: link is not expired before the expiry height",
+			func(f *testFixture) {
+				require.NoError(t, f.k.ExpireLinks(f.ctx.WithBlockHeight(14)))
+
+				hasSession, err := f.k.Sessions.Has(f.ctx, f.addrs[1].String())
+				require.NoError(t, err)
+				require.True(t, hasSession)
+
+				require.NoError(t, f.k.EndLink(f.ctx, f.addrs[1].String()))
+			},
+			true, nil, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			distrKeeper := &mockDistributionKeeper{bankKeeper: f.bankKeeper}
+			f.k.SetDistributionKeeper(distrKeeper)
+
+			params := linkedpackets.DefaultParams()
+			params.LinkDeposit = deposit
+			params.LinkExpiryBlocks = 5
+			require.NoError(f.k.Params.Set(f.ctx, params))
+
+			owner := f.addrs[1].String()
+			f.bankKeeper.balances[owner] = deposit
+
+			_, err := f.k.StartLink(f.ctx.WithBlockHeight(10), owner, linkedpackets.LinkOptions{LinkID: "mylinkid"})
+			require.NoError(err)
+			require.True(f.bankKeeper.balances[owner].IsZero())
+			require.Equal(deposit, f.bankKeeper.balances[moduleAddr])
+
+			tc.malleate(f)
+
+			hasSession, err := f.k.Sessions.Has(f.ctx, owner)
+			require.NoError(err)
+			require.False(hasSession)
+
+			if tc.expRefund {
+				require.Equal(deposit, f.bankKeeper.balances[owner])
+			} else {
+				require.True(f.bankKeeper.balances[owner].IsZero())
+			}
+			require.True(f.bankKeeper.balances[moduleAddr].IsZero())
+			require.Equal(tc.expBurned, f.bankKeeper.burned)
+			require.Equal(tc.expCPFunds, distrKeeper.communityPool)
+
+			// the deposit is cleared from a link which waits for the outcome of its packets
+			link, err := f.k.Links.Get(f.ctx, "mylinkid")
+			if err == nil {
+				require.True(link.Deposit.IsZero())
+			} else {
+				require.ErrorIs(err, collections.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestLinkDepositInsufficientFunds(t *testing.T) {
+	f := initFixture(t)
+
+	params := linkedpackets.DefaultParams()
+	params.LinkDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	_, err := f.msgServer.InitLink(f.ctx, &linkedpackets.MsgInitLink{Sender: f.addrs[1].String(), LinkId: "mylinkid"})
+	require.ErrorContains(t, err, "insufficient funds")
+}
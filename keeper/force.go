@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// ForceCloseLink closes a stuck link on behalf of the authority. If no channel is given, the link is the one sent by
+// this chain: it stops accepting packets as if its owner stopped it and, if fail is true, its packets without an
+// outcome are failed so that the link is resolved right away. Otherwise, the link is the one received on the given
+// channel of this chain: its buffered packets are given an error acknowledgement, and it is no longer recorded as
+// received nor forwarded, so that its next packets are rejected as breaking the link. The links received on other
+// channels with the same identifier are left untouched.
+func (k Keeper) ForceCloseLink(ctx context.Context, portID, channelID, linkID string, fail bool) error {
+	event := &linkedpackets.EventLinkForceClosed{LinkId: linkID, Failed: fail, PortId: portID, ChannelId: channelID}
+
+	var found bool
+	var err error
+	if portID == "" && channelID == "" {
+		found, err = k.forceCloseSentLink(ctx, linkID, fail, event)
+	} else {
+		found, err = k.forceCloseReceivedLink(ctx, collections.Join3(portID, channelID, linkID), event)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkNotFound, "link id: %s", linkID)
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event)
+}
+
+// forceCloseSentLink closes a link sent by this chain and, if fail is true, fails its pending packets. The failed
+// packets are added to the event. It returns false if the link is not found.
+func (k Keeper) forceCloseSentLink(ctx context.Context, linkID string, fail bool, event *linkedpackets.EventLinkForceClosed) (bool, error) {
+	hasLink, err := k.Links.Has(ctx, linkID)
+	if err != nil || !hasLink {
+		return false, err
+	}
+
+	if err := k.endSentLink(ctx, linkID); err != nil {
+		return false, err
+	}
+
+	if fail {
+		if event.FailedPackets, err = k.forceFailSentLink(ctx, linkID); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// forceCloseReceivedLink rejects the buffered packets of a link received on a channel of this chain and discards
+// its received state. The rejected packets are added to the event. It returns false if the link is not found.
+func (k Keeper) forceCloseReceivedLink(
+	ctx context.Context, key collections.Triple[string, string, string], event *linkedpackets.EventLinkForceClosed,
+) (bool, error) {
+	if err := linkedpackets.ValidateChannels([]linkedpackets.ChannelIdentifier{{PortId: key.K1(), ChannelId: key.K2()}}); err != nil {
+		return false, err
+	}
+
+	var err error
+	if event.RejectedPackets, err = k.rejectBufferedLinkPackets(ctx, key); err != nil {
+		return false, err
+	}
+
+	hasForwarded, err := k.removeForwardedLink(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	hasReceived, err := k.removeReceivedLink(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	return hasForwarded || hasReceived || len(event.RejectedPackets) > 0, nil
+}
+
+// endSentLink ends the session of a link sent on this chain if it is still open.
+func (k Keeper) endSentLink(ctx context.Context, linkID string) error {
+	link, err := k.Links.Get(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if link.Status != linkedpackets.LinkStatusOpen {
+		return nil
+	}
+
+	session, err := k.Sessions.Get(ctx, link.Owner)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && session.LinkId == linkID {
+		return k.EndLink(ctx, link.Owner)
+	}
+
+	return k.CloseLink(ctx, linkID)
+}
+
+// forceFailSentLink fails the packets of a closed link which have no outcome yet and resolves the link. The failed
+// packets are detached from the link, so that their acknowledgements or timeouts no longer affect it. It returns
+// the identifiers of the failed packets.
+func (k Keeper) forceFailSentLink(ctx context.Context, linkID string) ([]linkedpackets.PacketIdentifier, error) {
+	link, err := k.Links.Get(ctx, linkID)
+	if errors.Is(err, collections.ErrNotFound) {
+		// links without packets are removed once closed
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if link.Status != linkedpackets.LinkStatusPending {
+		return nil, nil
+	}
+
+	var failed []linkedpackets.PacketIdentifier
+	for i, p := range link.Packets {
+		if p.Outcome != linkedpackets.PacketOutcomePending {
+			continue
+		}
+
+		if err := k.removePacketLink(ctx, p.Packet); err != nil {
+			return nil, err
+		}
+
+		link.Packets[i].Outcome = linkedpackets.PacketOutcomeForceFailed
+		failed = append(failed, p.Packet)
+	}
+
+	return failed, k.resolveLink(ctx, link)
+}
+
+// rejectBufferedLinkPackets gives an error acknowledgement to the packets of a link buffered on a channel of this
+// chain. It returns the identifiers of the rejected packets.
+func (k Keeper) rejectBufferedLinkPackets(
+	ctx context.Context, key collections.Triple[string, string, string],
+) ([]linkedpackets.PacketIdentifier, error) {
+	packets, err := k.TakeBufferedLinkPackets(ctx, key.K1(), key.K2(), key.K3())
+	if err != nil {
+		return nil, err
+	}
+
+	ackErr := errorsmod.Wrapf(linkedpackets.ErrLinkForceClosed, "link %s", key.K3())
+	var rejected []linkedpackets.PacketIdentifier
+	for _, p := range packets {
+		ack, err := k.newForceClosedAcknowledgement(ctx, p, ackErr)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.WriteBufferedAcknowledgement(ctx, p.Packet, ack); err != nil {
+			return nil, err
+		}
+
+		rejected = append(rejected, linkedpackets.PacketIdentifier{
+			PortId:    p.Packet.DestinationPort,
+			ChannelId: p.Packet.DestinationChannel,
+			Seq:       strconv.FormatUint(p.Packet.Sequence, 10),
+		})
+	}
+
+	return rejected, nil
+}
+
+// newForceClosedAcknowledgement returns the error acknowledgement of a buffered packet of a force closed link. Like
+// the acknowledgements of the middleware, the error acknowledgement given to the sending application is
+// incentivized if fees are enabled on the channel, and the relayer recorded in the link acknowledgement is its
+// counterparty payee if it registered one.
+func (k Keeper) newForceClosedAcknowledgement(
+	ctx context.Context, p BufferedPacket, ackErr error,
+) (linkedpackets.LinkAcknowledgement, error) {
+	var relayer string
+	if len(p.Relayer) != 0 {
+		var err error
+		if relayer, err = k.addressCodec.BytesToString(p.Relayer); err != nil {
+			return linkedpackets.LinkAcknowledgement{}, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var appAck ibcexported.Acknowledgement = channeltypes.NewErrorAcknowledgement(ackErr)
+	if k.feeKeeper != nil && k.feeKeeper.IsFeeEnabled(sdkCtx, p.Packet.DestinationPort, p.Packet.DestinationChannel) {
+		// if forwardRelayer is not found the recv_fee is refunded
+		forwardRelayer, found := k.feeKeeper.GetCounterpartyPayeeAddress(sdkCtx, relayer, p.Packet.DestinationChannel)
+		appAck = ibcfeetypes.NewIncentivizedAcknowledgement(forwardRelayer, appAck.Acknowledgement(), false)
+		if found {
+			relayer = forwardRelayer
+		}
+	}
+
+	ack := linkedpackets.NewLinkErrorAcknowledgement(linkedpackets.LinkAckCodeForceClosed, ackErr, appAck)
+	ack.Relayer = relayer
+
+	return ack, nil
+}
+
+// removeForwardedLink stops tracking the hop forwarding a link received on a channel of this chain. It returns
+// true if the link was forwarded.
+func (k Keeper) removeForwardedLink(ctx context.Context, key collections.Triple[string, string, string]) (bool, error) {
+	has, err := k.ForwardedLinkPackets.Has(ctx, key)
+	if err != nil || !has {
+		return false, err
+	}
+
+	return true, k.ForwardedLinkPackets.Remove(ctx, key)
+}
+
+// removeReceivedLink stops recording the last packet of a link received on a channel of this chain. It returns
+// true if the link was received.
+func (k Keeper) removeReceivedLink(ctx context.Context, key collections.Triple[string, string, string]) (bool, error) {
+	has, err := k.ReceivedLinkPackets.Has(ctx, key)
+	if err != nil || !has {
+		return false, err
+	}
+
+	return true, k.ReceivedLinkPackets.Remove(ctx, key)
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestForceCloseLink(t *testing.T) {
+	testCases := []struct {
+		name           string
+		fail           bool
+		expStatus      linkedpackets.LinkStatus
+		expOutcomes    []linkedpackets.PacketOutcome
+		expLinked      []bool
+		expLateOutcome linkedpackets.PacketOutcome
+	}{
+		{
+			"close: the link waits for its pending packets",
+			false,
+			linkedpackets.LinkStatusPending,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomePending},
+			[]bool{true, true},
+			linkedpackets.PacketOutcomeSuccess,
+		},
+		{
+			"fail: the pending packets of the link are failed",
+			true,
+			linkedpackets.LinkStatusPartiallyFailed,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeForceFailed},
+			// the packets of a resolved link are no longer tracked
+			[]bool{false, false},
+			linkedpackets.PacketOutcomeForceFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			owner := f.addrs[1].String()
+			_, err := f.k.StartLink(f.ctx, owner, linkedpackets.LinkOptions{LinkID: "mylinkid"})
+			require.NoError(err)
+			require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+			require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 2))
+			require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+
+			// a link with the same identifier is received and forwarded on another channel of this chain
+			receivedKey := collections.Join3("transfer", "channel-1", "mylinkid")
+			require.NoError(f.k.ForwardedLinkPackets.Set(f.ctx, receivedKey, linkedpackets.PacketIdentifier{
+				PortId: "transfer", ChannelId: "channel-2", Seq: "1",
+			}))
+			require.NoError(f.k.ReceivedLinkPackets.Set(f.ctx, receivedKey, 1))
+
+			if tc.fail {
+				_, err = f.msgServer.ForceFailLink(f.ctx, &linkedpackets.MsgForceFailLink{Authority: f.k.GetAuthority(), LinkId: "mylinkid"})
+			} else {
+				_, err = f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{Authority: f.k.GetAuthority(), LinkId: "mylinkid"})
+			}
+			require.NoError(err)
+
+			hasSession, err := f.k.Sessions.Has(f.ctx, owner)
+			require.NoError(err)
+			require.False(hasSession)
+
+			link, err := f.k.Links.Get(f.ctx, "mylinkid")
+			require.NoError(err)
+			require.Equal(tc.expStatus, link.Status)
+			for i, p := range link.Packets {
+				require.Equal(tc.expOutcomes[i], p.Outcome)
+
+				hasPacket, err := f.k.PacketLinks.Has(f.ctx, collections.Join3("transfer", "channel-0", uint64(i+1)))
+				require.NoError(err)
+				require.Equal(tc.expLinked[i], hasPacket)
+			}
+
+			// the received link is left untouched
+			hasForwarded, err := f.k.ForwardedLinkPackets.Has(f.ctx, receivedKey)
+			require.NoError(err)
+			require.True(hasForwarded)
+
+			hasReceived, err := f.k.ReceivedLinkPackets.Has(f.ctx, receivedKey)
+			require.NoError(err)
+			require.True(hasReceived)
+
+			// the outcome of a packet only affects its link if the packet was not failed
+			require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 2, linkedpackets.PacketOutcomeSuccess))
+			link, err = f.k.Links.Get(f.ctx, "mylinkid")
+			require.NoError(err)
+			require.Equal(tc.expLateOutcome, link.Packets[1].Outcome)
+		})
+	}
+}
+
+func TestForceCloseLinkErrors(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	_, err := f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{Authority: f.addrs[1].String(), LinkId: "mylinkid"})
+	require.ErrorContains(err, "unauthorized")
+
+	_, err = f.msgServer.ForceFailLink(f.ctx, &linkedpackets.MsgForceFailLink{Authority: "foo", LinkId: "mylinkid"})
+	require.ErrorContains(err, "invalid authority address")
+
+	_, err = f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{Authority: f.k.GetAuthority(), LinkId: "mylinkid"})
+	require.ErrorIs(err, linkedpackets.ErrLinkNotFound)
+
+	// a link which is only received on this chain is force closed on its channel
+	require.NoError(f.k.ReceivedLinkPackets.Set(f.ctx, collections.Join3("transfer", "channel-1", "mylinkid"), 1))
+	_, err = f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{Authority: f.k.GetAuthority(), LinkId: "mylinkid"})
+	require.ErrorIs(err, linkedpackets.ErrLinkNotFound)
+
+	_, err = f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{
+		Authority: f.k.GetAuthority(), LinkId: "mylinkid", PortId: "transfer", ChannelId: "channel-0",
+	})
+	require.ErrorIs(err, linkedpackets.ErrLinkNotFound)
+
+	_, err = f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{
+		Authority: f.k.GetAuthority(), LinkId: "mylinkid", ChannelId: "channel-1",
+	})
+	require.Error(err)
+
+	_, err = f.msgServer.ForceCloseLink(f.ctx, &linkedpackets.MsgForceCloseLink{
+		Authority: f.k.GetAuthority(), LinkId: "mylinkid", PortId: "transfer", ChannelId: "channel-1",
+	})
+	require.NoError(err)
+}
+
+func TestForceCloseReceivedLink(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// two links with the same identifier are received and forwarded on different channels of this chain
+	keys := []collections.Triple[string, string, string]{
+		collections.Join3("transfer", "channel-0", "mylinkid"),
+		collections.Join3("transfer", "channel-1", "mylinkid"),
+	}
+	for _, key := range keys {
+		require.NoError(f.k.ReceivedLinkPackets.Set(f.ctx, key, 1))
+		require.NoError(f.k.ForwardedLinkPackets.Set(f.ctx, key, linkedpackets.PacketIdentifier{
+			PortId: "transfer", ChannelId: "channel-2", Seq: "1",
+		}))
+	}
+
+	_, err := f.msgServer.ForceFailLink(f.ctx, &linkedpackets.MsgForceFailLink{
+		Authority: f.k.GetAuthority(), LinkId: "mylinkid", PortId: "transfer", ChannelId: "channel-1",
+	})
+	require.NoError(err)
+
+	for i, key := range keys {
+		hasReceived, err := f.k.ReceivedLinkPackets.Has(f.ctx, key)
+		require.NoError(err)
+		require.Equal(i == 0, hasReceived)
+
+		hasForwarded, err := f.k.ForwardedLinkPackets.Has(f.ctx, key)
+		require.NoError(err)
+		require.Equal(i == 0, hasForwarded)
+	}
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// receivedLinkKey is the context key of the linked packet being received.
+type receivedLinkKey struct{}
+
+// ReceivedLink is a linked packet with forward instructions being received. A packet sent while it is received
+// is a hop forwarding it to the next chain, e.g. sent by the packet forward middleware, and carries its link.
+type ReceivedLink struct {
+	Packet   channeltypes.Packet
+	LinkData linkedpackets.LinkData
+	// Forwarded is true once the hop forwarding the packet is sent.
+	Forwarded bool
+}
+
+// WithReceivedLink returns a context in which the packet sent is a hop forwarding the given received packet.
+func WithReceivedLink(ctx sdk.Context, packet channeltypes.Packet, linkData linkedpackets.LinkData) (sdk.Context, *ReceivedLink) {
+	receivedLink := &ReceivedLink{Packet: packet, LinkData: linkData}
+	return ctx.WithValue(receivedLinkKey{}, receivedLink), receivedLink
+}
+
+// GetReceivedLink returns the received linked packet whose hop is yet to be sent with the given context, if any.
+func GetReceivedLink(ctx context.Context) (*ReceivedLink, bool) {
+	receivedLink, ok := ctx.Value(receivedLinkKey{}).(*ReceivedLink)
+	if !ok || receivedLink.Forwarded {
+		return nil, false
+	}
+
+	return receivedLink, true
+}
+
+// ForwardLinkData returns the link data of the hop forwarding a received linked packet. The hops of a link are
+// linked to each other in the order they are sent by this chain, so that the next chain can enforce the link.
+func (k Keeper) ForwardLinkData(ctx context.Context, receivedLink *ReceivedLink) (linkedpackets.LinkData, error) {
+	packet := receivedLink.Packet
+	key := collections.Join3(packet.DestinationPort, packet.DestinationChannel, receivedLink.LinkData.LinkID)
+	prevHop, err := k.ForwardedLinkPackets.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return linkedpackets.LinkData{}, err
+	}
+
+	linkData := receivedLink.LinkData
+	linkData.PrevPacket = prevHop
+	linkData.IsInitalPacket = prevHop == linkedpackets.PacketIdentifier{}
+
+	return linkData, nil
+}
+
+// SetLinkPacketForwarded records the hop forwarding a received linked packet, so that the next hop of the link
+// is linked to it. The hops of the link are no longer recorded once its last packet is forwarded.
+func (k Keeper) SetLinkPacketForwarded(
+	ctx context.Context, receivedLink *ReceivedLink, hopLinkData linkedpackets.LinkData, hop linkedpackets.PacketIdentifier,
+) error {
+	linkIndex, err := strconv.ParseUint(receivedLink.LinkData.LinkIndex, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	packet := receivedLink.Packet
+	key := collections.Join3(packet.DestinationPort, packet.DestinationChannel, receivedLink.LinkData.LinkID)
+	if receivedLink.LinkData.IsLastPacket {
+		err = k.ForwardedLinkPackets.Remove(ctx, key)
+	} else {
+		err = k.ForwardedLinkPackets.Set(ctx, key, hop)
+	}
+	if err != nil {
+		return err
+	}
+
+	receivedLink.Forwarded = true
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkPacketForwarded{
+		LinkId:    receivedLink.LinkData.LinkID,
+		LinkIndex: linkIndex,
+		Packet: linkedpackets.PacketIdentifier{
+			PortId:    packet.DestinationPort,
+			ChannelId: packet.DestinationChannel,
+			Seq:       strconv.FormatUint(packet.Sequence, 10),
+		},
+		Hop:     hop,
+		PrevHop: hopLinkData.PrevPacket,
+	})
+}
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
 
 	"github.com/srdtrk/linkedpackets"
 )
@@ -12,6 +16,100 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *linkedpackets.GenesisSta
 		return err
 	}
 
+	for _, channel := range data.LinkEnabledChannels {
+		if err := k.LinkEnabled.Set(ctx, collections.Join(channel.PortId, channel.ChannelId)); err != nil {
+			return err
+		}
+	}
+
+	openLinkCounts := make(map[string]uint64)
+	for _, link := range data.Links {
+		if err := k.Links.Set(ctx, link.LinkId, link); err != nil {
+			return err
+		}
+		if link.Owner != "" && link.IsUnresolved() {
+			openLinkCounts[link.Owner]++
+		}
+
+		// the resolved links to prune are derived from the links and are not part of the genesis state
+		if !link.IsUnresolved() {
+			if err := k.ResolvedLinks.Set(ctx, collections.Join(link.ResolveHeight, link.LinkId)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the open link counts are derived from the links and are not part of the genesis state
+	for owner, count := range openLinkCounts {
+		if err := k.OpenLinkCounts.Set(ctx, owner, count); err != nil {
+			return err
+		}
+	}
+
+	for _, session := range data.Sessions {
+		if err := k.Sessions.Set(ctx, session.Owner, session); err != nil {
+			return err
+		}
+	}
+
+	for _, packetLink := range data.PacketLinks {
+		key, err := packetKey(packetLink.Packet)
+		if err != nil {
+			return err
+		}
+		if err := k.PacketLinks.Set(ctx, key, packetLink.LinkId); err != nil {
+			return err
+		}
+	}
+
+	if err := k.LinkSeq.Set(ctx, data.LinkSequence); err != nil {
+		return err
+	}
+
+	for _, received := range data.ReceivedLinkPackets {
+		key := collections.Join3(received.Channel.PortId, received.Channel.ChannelId, received.LinkId)
+		if err := k.ReceivedLinkPackets.Set(ctx, key, received.Sequence); err != nil {
+			return err
+		}
+	}
+
+	for _, forwarded := range data.ForwardedLinkPackets {
+		key := collections.Join3(forwarded.Channel.PortId, forwarded.Channel.ChannelId, forwarded.LinkId)
+		if err := k.ForwardedLinkPackets.Set(ctx, key, forwarded.LastHop); err != nil {
+			return err
+		}
+	}
+
+	if err := k.LinkingPaused.Set(ctx, data.LinkingPaused); err != nil {
+		return err
+	}
+
+	for _, channel := range data.PausedChannels {
+		if err := k.PausedChannels.Set(ctx, collections.Join(channel.PortId, channel.ChannelId)); err != nil {
+			return err
+		}
+	}
+
+	for _, rateLimit := range data.AccountRateLimits {
+		if err := k.AccountRateLimits.Set(ctx, rateLimit.Account, rateLimit.Usage); err != nil {
+			return err
+		}
+	}
+
+	for _, rateLimit := range data.ChannelRateLimits {
+		key := collections.Join(rateLimit.Channel.PortId, rateLimit.Channel.ChannelId)
+		if err := k.ChannelRateLimits.Set(ctx, key, rateLimit.Usage); err != nil {
+			return err
+		}
+	}
+
+	for _, buffered := range data.BufferedLinks {
+		key := collections.Join3(buffered.Channel.PortId, buffered.Channel.ChannelId, buffered.LinkId)
+		if err := k.BufferedLinkPackets.Set(ctx, key, linkedpackets.BufferedLinkPackets{Packets: buffered.Packets}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -22,10 +120,148 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*linkedpackets.GenesisState
 		return nil, err
 	}
 
-	var counters []linkedpackets.Counter
+	genesis := &linkedpackets.GenesisState{
+		Params:              params,
+		LinkEnabledChannels: []linkedpackets.ChannelIdentifier{},
+		Links:               []linkedpackets.Link{},
+		PacketLinks:         []linkedpackets.PacketLink{},
+		ReceivedLinkPackets: []linkedpackets.ReceivedLinkPacket{},
+		Sessions:            []linkedpackets.LinkSession{},
 
-	return &linkedpackets.GenesisState{
-		Params:   params,
-		Counters: counters,
-	}, nil
+		ForwardedLinkPackets: []linkedpackets.ForwardedLinkPacket{},
+		BufferedLinks:        []linkedpackets.BufferedLink{},
+		PausedChannels:       []linkedpackets.ChannelIdentifier{},
+		AccountRateLimits:    []linkedpackets.AccountRateLimit{},
+		ChannelRateLimits:    []linkedpackets.ChannelRateLimit{},
+	}
+
+	paused, err := k.LinkingPaused.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	genesis.LinkingPaused = paused
+
+	if err := k.PausedChannels.Walk(ctx, nil, func(key collections.Pair[string, string]) (bool, error) {
+		genesis.PausedChannels = append(genesis.PausedChannels, linkedpackets.ChannelIdentifier{
+			PortId:    key.K1(),
+			ChannelId: key.K2(),
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.AccountRateLimits.Walk(ctx, nil, func(account string, usage linkedpackets.RateLimitUsage) (bool, error) {
+		genesis.AccountRateLimits = append(genesis.AccountRateLimits, linkedpackets.AccountRateLimit{Account: account, Usage: usage})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelRateLimits.Walk(ctx, nil, func(key collections.Pair[string, string], usage linkedpackets.RateLimitUsage) (bool, error) {
+		genesis.ChannelRateLimits = append(genesis.ChannelRateLimits, linkedpackets.ChannelRateLimit{
+			Channel: linkedpackets.ChannelIdentifier{PortId: key.K1(), ChannelId: key.K2()},
+			Usage:   usage,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.LinkEnabled.Walk(ctx, nil, func(key collections.Pair[string, string]) (bool, error) {
+		genesis.LinkEnabledChannels = append(genesis.LinkEnabledChannels, linkedpackets.ChannelIdentifier{
+			PortId:    key.K1(),
+			ChannelId: key.K2(),
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Links.Walk(ctx, nil, func(_ string, link linkedpackets.Link) (bool, error) {
+		genesis.Links = append(genesis.Links, link)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.PacketLinks.Walk(ctx, nil, func(key collections.Triple[string, string, uint64], linkID string) (bool, error) {
+		genesis.PacketLinks = append(genesis.PacketLinks, linkedpackets.PacketLink{
+			Packet: packetIdentifier(key),
+			LinkId: linkID,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Sessions.Walk(ctx, nil, func(_ string, session linkedpackets.LinkSession) (bool, error) {
+		genesis.Sessions = append(genesis.Sessions, session)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.LinkSequence, err = k.LinkSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ReceivedLinkPackets.Walk(ctx, nil, func(key collections.Triple[string, string, string], sequence uint64) (bool, error) {
+		genesis.ReceivedLinkPackets = append(genesis.ReceivedLinkPackets, linkedpackets.ReceivedLinkPacket{
+			Channel:  linkedpackets.ChannelIdentifier{PortId: key.K1(), ChannelId: key.K2()},
+			LinkId:   key.K3(),
+			Sequence: sequence,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.ForwardedLinkPackets.Walk(ctx, nil, func(
+		key collections.Triple[string, string, string], lastHop linkedpackets.PacketIdentifier,
+	) (bool, error) {
+		genesis.ForwardedLinkPackets = append(genesis.ForwardedLinkPackets, linkedpackets.ForwardedLinkPacket{
+			Channel: linkedpackets.ChannelIdentifier{PortId: key.K1(), ChannelId: key.K2()},
+			LinkId:  key.K3(),
+			LastHop: lastHop,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.BufferedLinkPackets.Walk(ctx, nil, func(
+		key collections.Triple[string, string, string], buffered linkedpackets.BufferedLinkPackets,
+	) (bool, error) {
+		genesis.BufferedLinks = append(genesis.BufferedLinks, linkedpackets.BufferedLink{
+			Channel: linkedpackets.ChannelIdentifier{PortId: key.K1(), ChannelId: key.K2()},
+			LinkId:  key.K3(),
+			Packets: buffered.Packets,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// packetKey returns the collections key of a packet identifier.
+func packetKey(packet linkedpackets.PacketIdentifier) (collections.Triple[string, string, uint64], error) {
+	seq, err := strconv.ParseUint(packet.Seq, 10, 64)
+	if err != nil {
+		return collections.Triple[string, string, uint64]{}, err
+	}
+
+	return collections.Join3(packet.PortId, packet.ChannelId, seq), nil
+}
+
+// packetIdentifier returns the packet identifier of a collections packet key.
+func packetIdentifier(key collections.Triple[string, string, uint64]) linkedpackets.PacketIdentifier {
+	return linkedpackets.PacketIdentifier{
+		PortId:    key.K1(),
+		ChannelId: key.K2(),
+		Seq:       strconv.FormatUint(key.K3(), 10),
+	}
 }
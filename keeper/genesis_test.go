@@ -3,29 +3,67 @@ package keeper_test
 import (
 	"testing"
 
-	"github.com/srdtrk/linkedpackets"
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/srdtrk/linkedpackets"
 )
 
 func TestInitGenesis(t *testing.T) {
 	fixture := initFixture(t)
 
+	packet := linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "1"}
 	data := &linkedpackets.GenesisState{
-		Counters: []linkedpackets.Counter{
-			{
-				Address: fixture.addrs[0].String(),
-				Count:   5,
-			},
-		},
-		Params: linkedpackets.DefaultParams(),
+		Params:              linkedpackets.DefaultParams(),
+		LinkEnabledChannels: []linkedpackets.ChannelIdentifier{{PortId: "transfer", ChannelId: "channel-0"}},
+		Links: []linkedpackets.Link{{
+			LinkId:  "mylinkid",
+			Owner:   fixture.addrs[0].String(),
+			Status:  linkedpackets.LinkStatusOpen,
+			Packets: []linkedpackets.LinkPacket{{Packet: packet}},
+		}},
+		PacketLinks:  []linkedpackets.PacketLink{{Packet: packet, LinkId: "mylinkid"}},
+		LinkSequence: 5,
+		ReceivedLinkPackets: []linkedpackets.ReceivedLinkPacket{{
+			Channel:  linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-1"},
+			LinkId:   "mylinkid",
+			Sequence: 3,
+		}},
+		Sessions: []linkedpackets.LinkSession{{
+			LinkId:     "mylinkid",
+			PrevPacket: packet,
+			Owner:      fixture.addrs[0].String(),
+			LinkIndex:  1,
+		}},
 	}
 	err := fixture.k.InitGenesis(fixture.ctx, data)
 	require.NoError(t, err)
 
 	params, err := fixture.k.Params.Get(fixture.ctx)
 	require.NoError(t, err)
-
 	require.Equal(t, linkedpackets.DefaultParams(), params)
+
+	enabled, err := fixture.k.LinkEnabled.Has(fixture.ctx, collections.Join("transfer", "channel-0"))
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	session, err := fixture.k.Sessions.Get(fixture.ctx, fixture.addrs[0].String())
+	require.NoError(t, err)
+	require.Equal(t, data.Sessions[0], session)
+
+	linkID, err := fixture.k.PacketLinks.Get(fixture.ctx, collections.Join3("transfer", "channel-0", uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, "mylinkid", linkID)
+
+	received, err := fixture.k.ReceivedLinkPackets.Get(fixture.ctx, collections.Join3("transfer", "channel-1", "mylinkid"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), received)
+
+	// the next generated link identifier continues from the link sequence
+	generated, err := fixture.k.StartLink(fixture.ctx, fixture.addrs[1].String(), linkedpackets.LinkOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "link-5", generated)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -33,12 +71,29 @@ func TestExportGenesis(t *testing.T) {
 
 	_, err := fixture.msgServer.InitLink(fixture.ctx, &linkedpackets.MsgInitLink{
 		Sender: fixture.addrs[0].String(),
+		LinkId: "mylinkid",
 	})
 	require.NoError(t, err)
+	require.NoError(t, fixture.k.AddLinkPacket(fixture.ctx, "mylinkid", "transfer", "channel-0", 1))
 
-	_, err = fixture.k.ExportGenesis(fixture.ctx)
+	out, err := fixture.k.ExportGenesis(fixture.ctx)
 	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+
+	require.Equal(t, linkedpackets.DefaultParams(), out.Params)
+	require.Equal(t, []linkedpackets.LinkSession{{LinkId: "mylinkid", Owner: fixture.addrs[0].String()}}, out.Sessions)
+	require.Len(t, out.Links, 1)
+	require.Equal(t, fixture.addrs[0].String(), out.Links[0].Owner)
+	require.Equal(t, []linkedpackets.PacketLink{{
+		Packet: linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "1"},
+		LinkId: "mylinkid",
+	}}, out.PacketLinks)
 
-	// require.Equal(t, linkedpackets.DefaultParams(), out.Params)
-	// require.Equal(t, uint64(0), out.Counters[0].Count)
+	// a round trip through a new keeper exports the same state
+	other := initFixture(t)
+	require.NoError(t, other.k.InitGenesis(other.ctx, out))
+
+	reexported, err := other.k.ExportGenesis(other.ctx)
+	require.NoError(t, err)
+	require.Equal(t, out, reexported)
 }
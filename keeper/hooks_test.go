@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+)
+
+var _ linkedpackets.LinkHooks = &mockLinkHooks{}
+
+// mockLinkHooks records the link hook calls and returns err from every hook.
+type mockLinkHooks struct {
+	err error
+
+	initLinks      []linkedpackets.Link
+	sentIndices    []uint64
+	completedLinks []linkedpackets.Link
+	failedLinks    []linkedpackets.Link
+}
+
+func (h *mockLinkHooks) AfterLinkInit(_ context.Context, link linkedpackets.Link) error {
+	h.initLinks = append(h.initLinks, link)
+	return h.err
+}
+
+func (h *mockLinkHooks) AfterLinkPacketSent(_ context.Context, _ string, _ linkedpackets.PacketIdentifier, linkIndex uint64) error {
+	h.sentIndices = append(h.sentIndices, linkIndex)
+	return h.err
+}
+
+func (*mockLinkHooks) AfterLinkPacketReceived(context.Context, linkedpackets.PacketIdentifier, linkedpackets.LinkData) error {
+	return nil
+}
+
+func (h *mockLinkHooks) AfterLinkCompleted(_ context.Context, link linkedpackets.Link) error {
+	h.completedLinks = append(h.completedLinks, link)
+	return h.err
+}
+
+func (h *mockLinkHooks) AfterLinkFailed(_ context.Context, link linkedpackets.Link) error {
+	h.failedLinks = append(h.failedLinks, link)
+	return h.err
+}
+
+func TestLinkHooks(t *testing.T) {
+	testCases := []struct {
+		name         string
+		outcome      linkedpackets.PacketOutcome
+		expCompleted int
+		expFailed    int
+	}{
+		{"link completed", linkedpackets.PacketOutcomeSuccess, 1, 0},
+		{"link failed", linkedpackets.PacketOutcomeTimeout, 0, 1},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			hooks := &mockLinkHooks{}
+			f.k.SetHooks(hooks)
+			msgServer := keeper.NewMsgServerImpl(f.k)
+
+			_, err := msgServer.InitLink(f.ctx, &linkedpackets.MsgInitLink{Sender: f.addrs[0].String(), LinkId: "mylinkid"})
+			require.NoError(err)
+			require.Len(hooks.initLinks, 1)
+			require.Equal(f.addrs[0].String(), hooks.initLinks[0].Owner)
+
+			require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+			require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 2))
+			require.Equal([]uint64{0, 1}, hooks.sentIndices)
+
+			require.NoError(f.k.CloseLink(f.ctx, "mylinkid"))
+			require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+			require.Empty(hooks.completedLinks)
+			require.Empty(hooks.failedLinks)
+
+			require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 2, tc.outcome))
+			require.Len(hooks.completedLinks, tc.expCompleted)
+			require.Len(hooks.failedLinks, tc.expFailed)
+		})
+	}
+}
+
+func TestLinkHooksErrors(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	hooks := &mockLinkHooks{err: errors.New("hook error")}
+	f.k.SetHooks(hooks)
+
+	// errors are returned while the link is being sent, the state changes are reverted with the transaction
+	cacheCtx, _ := f.ctx.CacheContext()
+	_, err := f.k.StartLink(cacheCtx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.ErrorContains(err, "hook error")
+
+	hooks.err = nil
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+
+	hooks.err = errors.New("hook error")
+	cacheCtx, _ = f.ctx.CacheContext()
+	require.ErrorContains(f.k.AddLinkPacket(cacheCtx, "mylinkid", "transfer", "channel-0", 1), "hook error")
+
+	hooks.err = nil
+	require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+	require.NoError(f.k.CloseLink(f.ctx, "mylinkid"))
+
+	// errors are not returned once the link is resolved
+	hooks.err = errors.New("hook error")
+	require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+	require.Len(hooks.completedLinks, 1)
+
+	link, err := f.k.Links.Get(f.ctx, "mylinkid")
+	require.NoError(err)
+	require.Equal(linkedpackets.LinkStatusSucceeded, link.Status)
+}
+
+func TestSetHooksTwice(t *testing.T) {
+	f := initFixture(t)
+
+	f.k.SetHooks(&mockLinkHooks{})
+	require.Panics(t, func() { f.k.SetHooks(&mockLinkHooks{}) })
+}
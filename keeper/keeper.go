@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/srdtrk/linkedpackets"
 )
@@ -15,24 +19,93 @@ import (
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	addressCodec address.Codec
+	storeService storetypes.KVStoreService
 
 	// authority is the address capable of executing a MsgUpdateParams and other authority-gated message.
 	// typically, this should be the x/gov module account.
 	authority string
 
+	hooks linkedpackets.LinkHooks
+
+	bankKeeper          linkedpackets.BankKeeper
+	distrKeeper         linkedpackets.DistributionKeeper
+	channelKeeper       linkedpackets.ChannelKeeper
+	feeKeeper           linkedpackets.FeeKeeper
+	icaControllerKeeper linkedpackets.ICAControllerKeeper
+	circuitBreaker      linkedpackets.CircuitBreaker
+
+	// callbacks is shared by the copies of the keeper, so that the contract keeper can be set after the keeper is
+	// passed to the IBC middleware stacks.
+	callbacks *contractCallbacks
+	// atomicICAHost is true if the packets of the atomic links received on the interchain accounts host port are
+	// executed together once the last packet of their link is received.
+	atomicICAHost bool
+
 	// state management
 	Schema collections.Schema
 	Params collections.Item[linkedpackets.Params]
 	// LinkEnabled is a KeySet of (portID, channelID) that indicates whether linked packets are enabled for a given channel.
 	LinkEnabled collections.KeySet[collections.Pair[string, string]]
-	Linking     collections.Item[bool]
-	PrevPacket  collections.Item[linkedpackets.PacketIdentifier]
-	LinkId      collections.Item[string]
-	LinkIndex   collections.Map[string, uint64]
+	// Sessions is a Map of owner addresses to the link they are sending.
+	Sessions collections.Map[string, linkedpackets.LinkSession]
+	// Links is a Map of link identifiers to the links opened on this chain.
+	Links collections.Map[string, linkedpackets.Link]
+	// PacketLinks is a Map of (portID, channelID, sequence) to the identifier of the link the sent packet belongs to.
+	PacketLinks collections.Map[collections.Triple[string, string, uint64], string]
+	// LinkSeq is the sequence used to generate link identifiers.
+	LinkSeq collections.Sequence
+	// OpenLinkCounts is a Map of owner addresses to the number of their links which are not resolved yet.
+	OpenLinkCounts collections.Map[string, uint64]
+	// ReceivedLinkPackets is a Map of (portID, channelID, linkID) of the links received on this chain to the sequence
+	// of their last packet received on the channel, until the last packet of the link is received.
+	ReceivedLinkPackets *collections.IndexedMap[collections.Triple[string, string, string], uint64, ReceivedLinkPacketsIndexes]
+	// ForwardedLinkPackets is a Map of (portID, channelID, linkID) of the links received on this chain to the last
+	// hop sent to forward one of their packets, until the last packet of the link is forwarded.
+	ForwardedLinkPackets collections.Map[collections.Triple[string, string, string], linkedpackets.PacketIdentifier]
+	// BufferedLinkPackets is a Map of (portID, channelID, linkID) of the links received on this chain to their
+	// interchain accounts packets buffered until the last packet of the link is received.
+	BufferedLinkPackets collections.Map[collections.Triple[string, string, string], linkedpackets.BufferedLinkPackets]
+	// LinkingPaused is true if linking is paused chain-wide by the authority.
+	LinkingPaused collections.Item[bool]
+	// PausedChannels is a KeySet of (portID, channelID) of the channels on which linking is paused by the authority.
+	PausedChannels collections.KeySet[collections.Pair[string, string]]
+	// AccountRateLimits is a Map of owner addresses to the number of links they opened in their last rate limit window.
+	AccountRateLimits collections.Map[string, linkedpackets.RateLimitUsage]
+	// ChannelRateLimits is a Map of (portID, channelID) to the number of linked packets sent on the channel in its last
+	// rate limit window.
+	ChannelRateLimits collections.Map[collections.Pair[string, string], linkedpackets.RateLimitUsage]
+	// ResolvedLinks is a KeySet of (resolveHeight, linkID) of the resolved links until they are pruned.
+	ResolvedLinks collections.KeySet[collections.Pair[uint64, string]]
+}
+
+// ReceivedLinkPacketsIndexes are the indexes of ReceivedLinkPackets.
+type ReceivedLinkPacketsIndexes struct {
+	// LinkID indexes the channels on which a link is received by the identifier of the link.
+	LinkID *indexes.Multi[string, collections.Triple[string, string, string], uint64]
+}
+
+func newReceivedLinkPacketsIndexes(sb *collections.SchemaBuilder) ReceivedLinkPacketsIndexes {
+	return ReceivedLinkPacketsIndexes{
+		LinkID: indexes.NewMulti(
+			sb, linkedpackets.ReceivedLinkPacketByLinkKey, "received_link_packets_by_link", collections.StringKey,
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			func(key collections.Triple[string, string, string], _ uint64) (string, error) {
+				return key.K3(), nil
+			},
+		),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i ReceivedLinkPacketsIndexes) IndexesList() []collections.Index[collections.Triple[string, string, string], uint64] {
+	return []collections.Index[collections.Triple[string, string, string], uint64]{i.LinkID}
 }
 
 // NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService,
+	bankKeeper linkedpackets.BankKeeper, authority string,
+) Keeper {
 	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
@@ -41,15 +114,56 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 	k := Keeper{
 		cdc:          cdc,
 		addressCodec: addressCodec,
+		storeService: storeService,
 		authority:    authority,
+		bankKeeper:   bankKeeper,
+		callbacks:    &contractCallbacks{},
 		Params:       collections.NewItem(sb, linkedpackets.ParamsKey, "params", codec.CollValue[linkedpackets.Params](cdc)),
 		LinkEnabled: collections.NewKeySet(
 			sb, linkedpackets.LinkEnabledKey, "link_enabled", collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 		),
-		Linking:    collections.NewItem(sb, linkedpackets.LinkingKey, "linking", collections.BoolValue),
-		PrevPacket: collections.NewItem(sb, linkedpackets.PrevPacketKey, "prev_packet", codec.CollValue[linkedpackets.PacketIdentifier](cdc)),
-		LinkId:     collections.NewItem(sb, linkedpackets.LinkIdKey, "link_id", collections.StringValue),
-		LinkIndex:  collections.NewMap(sb, linkedpackets.LinkIndexKey, "link_index", collections.StringKey, collections.Uint64Value),
+		Sessions: collections.NewMap(
+			sb, linkedpackets.SessionsKey, "sessions", collections.StringKey, codec.CollValue[linkedpackets.LinkSession](cdc),
+		),
+		Links: collections.NewMap(sb, linkedpackets.LinksKey, "links", collections.StringKey, codec.CollValue[linkedpackets.Link](cdc)),
+		PacketLinks: collections.NewMap(
+			sb, linkedpackets.PacketLinkKey, "packet_links",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), collections.StringValue,
+		),
+		LinkSeq: collections.NewSequence(sb, linkedpackets.LinkSeqKey, "link_seq"),
+		OpenLinkCounts: collections.NewMap(
+			sb, linkedpackets.OpenLinkCountsKey, "open_link_counts", collections.StringKey, collections.Uint64Value,
+		),
+		ReceivedLinkPackets: collections.NewIndexedMap(
+			sb, linkedpackets.ReceivedLinkPacketKey, "received_link_packets",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), collections.Uint64Value,
+			newReceivedLinkPacketsIndexes(sb),
+		),
+		ForwardedLinkPackets: collections.NewMap(
+			sb, linkedpackets.ForwardedLinkKey, "forwarded_link_packets",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[linkedpackets.PacketIdentifier](cdc),
+		),
+		BufferedLinkPackets: collections.NewMap(
+			sb, linkedpackets.BufferedLinkKey, "buffered_link_packets",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[linkedpackets.BufferedLinkPackets](cdc),
+		),
+		LinkingPaused: collections.NewItem(sb, linkedpackets.LinkingPausedKey, "linking_paused", collections.BoolValue),
+		PausedChannels: collections.NewKeySet(
+			sb, linkedpackets.PausedChannelsKey, "paused_channels", collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		AccountRateLimits: collections.NewMap(
+			sb, linkedpackets.AccountRateLimitsKey, "account_rate_limits", collections.StringKey,
+			codec.CollValue[linkedpackets.RateLimitUsage](cdc),
+		),
+		ChannelRateLimits: collections.NewMap(
+			sb, linkedpackets.ChannelRateLimitsKey, "channel_rate_limits",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[linkedpackets.RateLimitUsage](cdc),
+		),
+		ResolvedLinks: collections.NewKeySet(
+			sb, linkedpackets.ResolvedLinksKey, "resolved_links", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -66,3 +180,77 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+linkedpackets.ModuleName)
+}
+
+// Hooks gets the hooks for the linked packets keeper.
+func (k *Keeper) Hooks() linkedpackets.LinkHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return linkedpackets.MultiLinkHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the link hooks. In contrast to other receivers, this method must take a pointer due to nature
+// of the hooks interface and SDK start up sequence. Since the IBC middleware holds a copy of the keeper,
+// the hooks must be set before the keeper is passed to NewIBCMiddleware.
+func (k *Keeper) SetHooks(lh linkedpackets.LinkHooks) {
+	if k.hooks != nil {
+		panic("cannot set link hooks twice")
+	}
+
+	k.hooks = lh
+}
+
+// SetICAControllerKeeper sets the keepers used to send the compensations of failed ICA links. Like SetHooks,
+// it must be called before the keeper is passed to NewIBCMiddleware. Compensations are not sent if these
+// keepers are not set.
+func (k *Keeper) SetICAControllerKeeper(channelKeeper linkedpackets.ChannelKeeper, icaControllerKeeper linkedpackets.ICAControllerKeeper) {
+	k.channelKeeper = channelKeeper
+	k.icaControllerKeeper = icaControllerKeeper
+}
+
+// SetICAHostChannelKeeper enables the atomic execution of the atomic links received on the interchain accounts host
+// port, and sets the channel keeper used to acknowledge their packets. Like SetHooks, it must be called before the
+// keeper is passed to NewIBCMiddleware. The packets of atomic links are rejected if it is not called.
+func (k *Keeper) SetICAHostChannelKeeper(channelKeeper linkedpackets.ChannelKeeper) {
+	k.channelKeeper = channelKeeper
+	k.atomicICAHost = true
+}
+
+// SetFeeKeeper sets the ICS29 fee keeper used to incentivize the error acknowledgements given to the buffered
+// packets of force closed links on fee enabled channels. Like SetHooks, it must be called before the keeper is
+// passed to NewIBCMiddleware. The acknowledgements are not incentivized if it is not set.
+func (k *Keeper) SetFeeKeeper(feeKeeper linkedpackets.FeeKeeper) {
+	k.feeKeeper = feeKeeper
+}
+
+// SetDistributionKeeper sets the keeper used to send the deposits of expired links to the community pool.
+// The deposits of expired links are burned if this keeper is not set.
+func (k *Keeper) SetDistributionKeeper(distrKeeper linkedpackets.DistributionKeeper) {
+	k.distrKeeper = distrKeeper
+}
+
+// SetContractKeeper sets the contract keeper called back when links are resolved or received, and the maximum
+// amount of gas each callback can consume. Unlike SetHooks, it can be called after the keeper is passed to
+// NewIBCMiddleware, as contract keepers are usually created after the IBC middleware stacks. Links cannot request
+// callbacks if this keeper is not set.
+func (k *Keeper) SetContractKeeper(contractKeeper linkedpackets.ContractKeeper, maxCallbackGas uint64) {
+	if maxCallbackGas == 0 {
+		panic("maxCallbackGas cannot be zero")
+	}
+
+	k.callbacks.contractKeeper = contractKeeper
+	k.callbacks.maxCallbackGas = maxCallbackGas
+}
+
+// SetCircuitBreaker sets the circuit breaker whose message filter pauses linking chain-wide when it disables
+// MsgInitLink. Like SetHooks, it must be called before the keeper is passed to NewIBCMiddleware.
+func (k *Keeper) SetCircuitBreaker(circuitBreaker linkedpackets.CircuitBreaker) {
+	k.circuitBreaker = circuitBreaker
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	storetypes "cosmossdk.io/store/types"
@@ -10,6 +12,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/srdtrk/linkedpackets"
 	"github.com/srdtrk/linkedpackets/keeper"
@@ -20,6 +23,7 @@ type testFixture struct {
 	k           keeper.Keeper
 	msgServer   linkedpackets.MsgServer
 	queryServer linkedpackets.QueryServer
+	bankKeeper  *mockBankKeeper
 
 	addrs []sdk.AccAddress
 }
@@ -31,7 +35,9 @@ func initFixture(t *testing.T) *testFixture {
 	storeService := runtime.NewKVStoreService(key)
 	addrs := simtestutil.CreateIncrementalAccounts(3)
 
-	k := keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), storeService, addrs[0].String())
+	bankKeeper := &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+
+	k := keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), storeService, bankKeeper, addrs[0].String())
 	err := k.InitGenesis(testCtx.Ctx, linkedpackets.NewGenesisState())
 	if err != nil {
 		panic(err)
@@ -42,6 +48,56 @@ func initFixture(t *testing.T) *testFixture {
 		k:           k,
 		msgServer:   keeper.NewMsgServerImpl(k),
 		queryServer: keeper.NewQueryServerImpl(k),
+		bankKeeper:  bankKeeper,
 		addrs:       addrs,
 	}
 }
+
+// mockBankKeeper is an in-memory bank keeper which records the balances of accounts and module accounts.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func (b *mockBankKeeper) subtract(addr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[addr.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[addr.String()], amt)
+	}
+
+	b.balances[addr.String()] = balance
+
+	return nil
+}
+
+func (b *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	if err := b.subtract(from, amt); err != nil {
+		return err
+	}
+
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	if err := b.subtract(authtypes.NewModuleAddress(moduleName), amt); err != nil {
+		return err
+	}
+
+	b.burned = b.burned.Add(amt...)
+
+	return nil
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
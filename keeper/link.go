@@ -0,0 +1,530 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// StartLink starts linking the packets sent by the given owner until EndLink is called. Every packet the owner
+// sends on a link enabled channel in the meantime is added to the link. An owner can only send one link at a
+// time. It returns the identifier of the link, which is generated if no identifier is given in the options.
+func (k Keeper) StartLink(ctx context.Context, owner string, opts linkedpackets.LinkOptions) (string, error) {
+	if _, err := k.addressCodec.StringToBytes(owner); err != nil {
+		return "", fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	if err := linkedpackets.ValidateCompensations(opts.Compensations); err != nil {
+		return "", err
+	}
+
+	if err := opts.RelayerReward.Validate(); err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid relayer reward: %v", err)
+	}
+
+	if err := linkedpackets.ValidateChannels(opts.AllowedChannels); err != nil {
+		return "", err
+	}
+
+	if opts.SrcCallbackAddress != "" && k.callbacks.contractKeeper == nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "link callbacks are not supported on this chain")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.LinkingEnabled {
+		return "", linkedpackets.ErrLinkingDisabled
+	}
+
+	paused, err := k.IsLinkingPaused(ctx, "", "")
+	if err != nil {
+		return "", err
+	}
+	if paused {
+		return "", linkedpackets.ErrLinkingPaused
+	}
+
+	openLinks, err := k.OpenLinkCounts.Get(ctx, owner)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return "", err
+	}
+	if params.MaxOpenLinksPerAccount > 0 && openLinks >= params.MaxOpenLinksPerAccount {
+		return "", errorsmod.Wrapf(linkedpackets.ErrLinkLimitExceeded, "%s already has %d open links", owner, openLinks)
+	}
+
+	linkID := opts.LinkID
+	if linkID == "" {
+		if linkID, err = k.nextLinkID(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	hasLink, err := k.Links.Has(ctx, linkID)
+	if err != nil {
+		return "", err
+	}
+	if hasLink {
+		return "", errorsmod.Wrapf(linkedpackets.ErrLinkExists, "link id: %s", linkID)
+	}
+
+	hasSession, err := k.Sessions.Has(ctx, owner)
+	if err != nil {
+		return "", err
+	}
+	if hasSession {
+		return "", linkedpackets.ErrLinkInProgress
+	}
+
+	if err := k.Sessions.Set(ctx, owner, linkedpackets.LinkSession{LinkId: linkID, Owner: owner}); err != nil {
+		return "", err
+	}
+
+	link := linkedpackets.Link{
+		LinkId:        linkID,
+		Owner:         owner,
+		Status:        linkedpackets.LinkStatusOpen,
+		Compensations: opts.Compensations,
+		OpenTime:      sdk.UnwrapSDKContext(ctx).BlockTime(),
+		OpenHeight:    uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+
+		SrcCallbackAddress:  opts.SrcCallbackAddress,
+		DestCallbackAddress: opts.DestCallbackAddress,
+		Atomic:              opts.Atomic,
+		AllowedChannels:     opts.AllowedChannels,
+		MaxPackets:          opts.MaxPackets,
+	}
+	if err := k.escrowDeposit(ctx, params, &link); err != nil {
+		return "", err
+	}
+	if err := k.escrowRelayerReward(ctx, &link, opts.RelayerReward); err != nil {
+		return "", err
+	}
+	if err := k.Links.Set(ctx, linkID, link); err != nil {
+		return "", err
+	}
+
+	if err := k.OpenLinkCounts.Set(ctx, owner, openLinks+1); err != nil {
+		return "", err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkOpened{
+		LinkId: linkID,
+		Owner:  owner,
+	}); err != nil {
+		return "", err
+	}
+
+	if err := k.Hooks().AfterLinkInit(ctx, link); err != nil {
+		return "", err
+	}
+
+	telemetry.IncrCounter(1, linkedpackets.ModuleName, linkedpackets.MetricKeyLink, linkedpackets.MetricKeyOpened)
+
+	return linkID, nil
+}
+
+// nextLinkID generates the identifier of a new link. The generated identifiers already chosen for another link are
+// skipped, so that a link opened with a user chosen identifier never blocks the generated ones.
+func (k Keeper) nextLinkID(ctx context.Context) (string, error) {
+	for {
+		seq, err := k.LinkSeq.Next(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		linkID := fmt.Sprintf("link-%d", seq)
+		hasLink, err := k.Links.Has(ctx, linkID)
+		if err != nil {
+			return "", err
+		}
+		if !hasLink {
+			return linkID, nil
+		}
+	}
+}
+
+// EndLink stops linking the packets sent by the given owner and closes their link in progress, if any.
+func (k Keeper) EndLink(ctx context.Context, owner string) error {
+	session, err := k.Sessions.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.CloseLink(ctx, session.LinkId); err != nil {
+		return err
+	}
+
+	return k.Sessions.Remove(ctx, owner)
+}
+
+// WithLink links every packet sent by fn. The link is started before and ended after fn is called.
+// State changes are only committed if fn and the link bookkeeping succeed. It returns the identifier
+// of the link.
+func (k Keeper) WithLink(
+	ctx context.Context, owner string, opts linkedpackets.LinkOptions, fn func(ctx context.Context) error,
+) (string, error) {
+	cacheCtx, writeFn := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	linkID, err := k.StartLink(cacheCtx, owner, opts)
+	if err != nil {
+		return "", err
+	}
+
+	if err := fn(cacheCtx); err != nil {
+		return "", err
+	}
+
+	if err := k.EndLink(cacheCtx, owner); err != nil {
+		return "", err
+	}
+
+	writeFn()
+
+	return linkID, nil
+}
+
+// AddLinkPacket appends a sent packet to the link with the given identifier, which must have been started with
+// StartLink.
+func (k Keeper) AddLinkPacket(ctx context.Context, linkID, portID, channelID string, sequence uint64) error {
+	link, err := k.Links.Get(ctx, linkID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkNotFound, "link id: %s", linkID)
+	} else if err != nil {
+		return err
+	}
+
+	packet := linkedpackets.PacketIdentifier{
+		PortId:    portID,
+		ChannelId: channelID,
+		Seq:       strconv.FormatUint(sequence, 10),
+	}
+	linkIndex := uint64(len(link.Packets))
+	link.Packets = append(link.Packets, linkedpackets.LinkPacket{Packet: packet})
+
+	if err := k.PacketLinks.Set(ctx, collections.Join3(portID, channelID, sequence), linkID); err != nil {
+		return err
+	}
+
+	if err := k.Links.Set(ctx, linkID, link); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterLinkPacketSent(ctx, linkID, packet, linkIndex)
+}
+
+// ValidateLinkPacket checks that a packet of the given data type can be sent as the next packet of the link
+// of the session, according to the module parameters.
+func (k Keeper) ValidateLinkPacket(ctx context.Context, session linkedpackets.LinkSession, dataType string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.LinkingEnabled {
+		return linkedpackets.ErrLinkingDisabled
+	}
+
+	if !params.IsPacketDataTypeAllowed(dataType) {
+		return errorsmod.Wrapf(linkedpackets.ErrInvalidPacketData, "%s packets cannot be linked", dataType)
+	}
+
+	if params.MaxLinkPackets > 0 && session.LinkIndex >= params.MaxLinkPackets {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkLimitExceeded, "link %s already has %d packets", session.LinkId, session.LinkIndex)
+	}
+
+	if params.LinkExpiryBlocks > 0 {
+		link, err := k.Links.Get(ctx, session.LinkId)
+		if err != nil {
+			return err
+		}
+
+		if link.IsExpired(uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), params.LinkExpiryBlocks) {
+			return errorsmod.Wrapf(
+				linkedpackets.ErrLinkExpired, "link %s expired at height %d", session.LinkId, link.OpenHeight+params.LinkExpiryBlocks,
+			)
+		}
+	}
+
+	return nil
+}
+
+// ExpireLinks closes the links in progress which expired according to the params and forfeits their deposits.
+// It is called at the end of every block. Links which cannot be expired are logged and skipped so that they
+// cannot halt the chain.
+func (k Keeper) ExpireLinks(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.LinkExpiryBlocks == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	var expired []linkedpackets.Link
+	if err := k.Sessions.Walk(ctx, nil, func(_ string, session linkedpackets.LinkSession) (bool, error) {
+		link, err := k.Links.Get(ctx, session.LinkId)
+		if err != nil {
+			return true, err
+		}
+		if link.IsExpired(height, params.LinkExpiryBlocks) {
+			expired = append(expired, link)
+		}
+
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, link := range expired {
+		cacheCtx, writeFn := sdkCtx.CacheContext()
+		if err := k.expireLink(cacheCtx, params, link); err != nil {
+			k.Logger(ctx).Error("failed to expire link", "link_id", link.LinkId, "error", err)
+			continue
+		}
+		writeFn()
+	}
+
+	return nil
+}
+
+// expireLink forfeits the deposit of an expired link and ends it.
+func (k Keeper) expireLink(ctx context.Context, params linkedpackets.Params, link linkedpackets.Link) error {
+	deposit := link.Deposit
+	action, err := k.forfeitDeposit(ctx, params, &link)
+	if err != nil {
+		return err
+	}
+	if err := k.Links.Set(ctx, link.LinkId, link); err != nil {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkExpired{
+		LinkId:           link.LinkId,
+		Owner:            link.Owner,
+		ForfeitedDeposit: deposit,
+		Action:           action,
+	}); err != nil {
+		return err
+	}
+
+	return k.EndLink(ctx, link.Owner)
+}
+
+// CloseLink marks the link as no longer accepting packets and refunds its deposit. A link without any packets
+// is removed, otherwise it waits for the outcome of its packets to be resolved.
+func (k Keeper) CloseLink(ctx context.Context, linkID string) error {
+	link, err := k.Links.Get(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkClosed{
+		LinkId:      linkID,
+		Owner:       link.Owner,
+		PacketCount: uint64(len(link.Packets)),
+	}); err != nil {
+		return err
+	}
+
+	telemetry.IncrCounter(1, linkedpackets.ModuleName, linkedpackets.MetricKeyLink, linkedpackets.MetricKeyClosed)
+	metrics.AddSample(
+		[]string{linkedpackets.ModuleName, linkedpackets.MetricKeyLink, linkedpackets.MetricKeyLength}, float32(len(link.Packets)),
+	)
+
+	if err := k.refundDeposit(ctx, &link); err != nil {
+		return err
+	}
+
+	if len(link.Packets) == 0 {
+		if err := k.refundRelayerReward(ctx, &link); err != nil {
+			return err
+		}
+
+		if err := k.decrementOpenLinks(ctx, link.Owner); err != nil {
+			return err
+		}
+
+		return k.Links.Remove(ctx, linkID)
+	}
+
+	link.Status = linkedpackets.LinkStatusPending
+
+	return k.resolveLink(ctx, link)
+}
+
+// SetPacketOutcome records the outcome of a sent packet and resolves its link once every packet of the
+// link has an outcome. It is a no-op if the packet is not part of a link.
+func (k Keeper) SetPacketOutcome(ctx context.Context, portID, channelID string, sequence uint64, outcome linkedpackets.PacketOutcome) error {
+	linkID, err := k.PacketLinks.Get(ctx, collections.Join3(portID, channelID, sequence))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	link, err := k.Links.Get(ctx, linkID)
+	if err != nil {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkNotFound, "link %s: %v", linkID, err)
+	}
+
+	packet := linkedpackets.PacketIdentifier{PortId: portID, ChannelId: channelID, Seq: strconv.FormatUint(sequence, 10)}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkPacketOutcome{
+		LinkId:  linkID,
+		Packet:  packet,
+		Outcome: outcome,
+	}); err != nil {
+		return err
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{linkedpackets.ModuleName, linkedpackets.MetricKeyPacket, linkedpackets.MetricKeyOutcome},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(coretypes.LabelSourcePort, portID),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, channelID),
+			telemetry.NewLabel(linkedpackets.LabelOutcome, outcome.String()),
+		},
+	)
+
+	for i, p := range link.Packets {
+		if p.Packet == packet {
+			link.Packets[i].Outcome = outcome
+			return k.resolveLink(ctx, link)
+		}
+	}
+
+	for i, p := range link.CompensationPackets {
+		if p.Packet == packet {
+			link.CompensationPackets[i].Outcome = outcome
+
+			// the link is already resolved, the compensation packet is no longer tracked once it has an outcome
+			if err := k.removePacketLink(ctx, packet); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return k.Links.Set(ctx, linkID, link)
+}
+
+// resolveLink stores the link, setting its final status, emitting an EventLinkResolved and calling the
+// link hooks if every one of its packets has an outcome. Since links are resolved while handling
+// acknowledgements and timeouts, hook errors are logged rather than returned so that they cannot block
+// the underlying packet lifecycle. The packets of a resolved link are no longer tracked, and the link is
+// pruned once its retention period is over.
+func (k Keeper) resolveLink(ctx context.Context, link linkedpackets.Link) error {
+	if !link.IsResolved() {
+		return k.Links.Set(ctx, link.LinkId, link)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	link.Status = link.ResolvedStatus()
+	link.ResolveHeight = uint64(sdkCtx.BlockHeight())
+	if link.Status != linkedpackets.LinkStatusSucceeded {
+		if err := k.sendCompensations(ctx, &link); err != nil {
+			return err
+		}
+	}
+
+	if err := k.settleRelayerReward(ctx, &link); err != nil {
+		return err
+	}
+
+	if err := k.Links.Set(ctx, link.LinkId, link); err != nil {
+		return err
+	}
+
+	for _, p := range link.Packets {
+		if err := k.removePacketLink(ctx, p.Packet); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ResolvedLinks.Set(ctx, collections.Join(link.ResolveHeight, link.LinkId)); err != nil {
+		return err
+	}
+
+	if err := k.decrementOpenLinks(ctx, link.Owner); err != nil {
+		return err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkResolved{
+		LinkId:  link.LinkId,
+		Status:  link.Status,
+		Packets: link.Packets,
+	}); err != nil {
+		return err
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel(linkedpackets.LabelStatus, link.Status.String())}
+	telemetry.IncrCounterWithLabels([]string{linkedpackets.ModuleName, linkedpackets.MetricKeyLink, linkedpackets.MetricKeyResolved}, 1, labels)
+	if !link.OpenTime.IsZero() {
+		metrics.AddSampleWithLabels(
+			[]string{linkedpackets.ModuleName, linkedpackets.MetricKeyLink, linkedpackets.MetricKeyCompletionTime},
+			float32(sdkCtx.BlockTime().Sub(link.OpenTime).Milliseconds()),
+			labels,
+		)
+	}
+
+	hook := k.Hooks().AfterLinkFailed
+	if link.Status == linkedpackets.LinkStatusSucceeded {
+		hook = k.Hooks().AfterLinkCompleted
+	}
+
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+	if err := hook(cacheCtx, link); err != nil {
+		k.Logger(ctx).Error("link hook failed", "link_id", link.LinkId, "status", link.Status.String(), "error", err)
+	} else {
+		writeFn()
+	}
+
+	k.executeLinkResolvedCallback(sdkCtx, link)
+
+	return nil
+}
+
+// decrementOpenLinks decrements the number of open links of the owner once one of their links is resolved
+// or removed.
+func (k Keeper) decrementOpenLinks(ctx context.Context, owner string) error {
+	if owner == "" {
+		return nil
+	}
+
+	count, err := k.OpenLinkCounts.Get(ctx, owner)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if count <= 1 {
+		return k.OpenLinkCounts.Remove(ctx, owner)
+	}
+
+	return k.OpenLinkCounts.Set(ctx, owner, count-1)
+}
@@ -0,0 +1,379 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestSetPacketOutcome(t *testing.T) {
+	testCases := []struct {
+		name      string
+		outcomes  []linkedpackets.PacketOutcome
+		close     bool
+		expStatus linkedpackets.LinkStatus
+	}{
+		{
+			name:      "open link is not resolved",
+			outcomes:  []linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeSuccess},
+			close:     false,
+			expStatus: linkedpackets.LinkStatusOpen,
+		},
+		{
+			name:      "closed link with pending packets is not resolved",
+			outcomes:  []linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomePending},
+			close:     true,
+			expStatus: linkedpackets.LinkStatusPending,
+		},
+		{
+			name:      "all packets succeeded",
+			outcomes:  []linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeSuccess},
+			close:     true,
+			expStatus: linkedpackets.LinkStatusSucceeded,
+		},
+		{
+			name:      "all packets failed",
+			outcomes:  []linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeError, linkedpackets.PacketOutcomeTimeout},
+			close:     true,
+			expStatus: linkedpackets.LinkStatusFailed,
+		},
+		{
+			name:      "some packets failed",
+			outcomes:  []linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeError},
+			close:     true,
+			expStatus: linkedpackets.LinkStatusPartiallyFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			_, err := f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+			require.NoError(err)
+			for i := range tc.outcomes {
+				require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", uint64(i+1)))
+			}
+
+			if tc.close {
+				require.NoError(f.k.CloseLink(f.ctx, "mylinkid"))
+			}
+
+			for i, outcome := range tc.outcomes {
+				if outcome != linkedpackets.PacketOutcomePending {
+					require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", uint64(i+1), outcome))
+				}
+			}
+
+			link, err := f.k.Links.Get(f.ctx, "mylinkid")
+			require.NoError(err)
+			require.Equal(tc.expStatus, link.Status)
+
+			var resolvedEvents int
+			for _, event := range f.ctx.EventManager().ABCIEvents() {
+				if event.Type != proto.MessageName(&linkedpackets.EventLinkResolved{}) {
+					continue
+				}
+
+				resolvedEvents++
+				msg, err := sdk.ParseTypedEvent(event)
+				require.NoError(err)
+				resolved, ok := msg.(*linkedpackets.EventLinkResolved)
+				require.True(ok)
+				require.Equal(tc.expStatus, resolved.Status)
+				require.Len(resolved.Packets, len(tc.outcomes))
+			}
+
+			if tc.expStatus == linkedpackets.LinkStatusOpen || tc.expStatus == linkedpackets.LinkStatusPending {
+				require.Zero(resolvedEvents)
+			} else {
+				require.Equal(1, resolvedEvents)
+			}
+		})
+	}
+}
+
+func TestSetPacketOutcomeUnlinkedPacket(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+
+	has, err := f.k.Links.Has(f.ctx, "")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestCloseEmptyLink(t *testing.T) {
+	f := initFixture(t)
+
+	_, err := f.msgServer.InitLink(f.ctx, &linkedpackets.MsgInitLink{Sender: f.addrs[0].String(), LinkId: "mylinkid"})
+	require.NoError(t, err)
+
+	require.NoError(t, f.k.CloseLink(f.ctx, "mylinkid"))
+
+	has, err := f.k.Links.Has(f.ctx, "mylinkid")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestStartLink(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	_, err := f.k.StartLink(f.ctx, "foo", linkedpackets.LinkOptions{})
+	require.ErrorContains(err, "invalid owner address")
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{
+		Compensations: []linkedpackets.Compensation{{LinkIndex: 0}},
+	})
+	require.ErrorIs(err, linkedpackets.ErrInvalidCompensation)
+
+	linkID, err := f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{})
+	require.NoError(err)
+	require.Equal("link-0", linkID)
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.ErrorIs(err, linkedpackets.ErrLinkInProgress)
+
+	// another owner can send a link at the same time
+	linkID, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+	require.Equal("mylinkid", linkID)
+
+	link, err := f.k.Links.Get(f.ctx, linkID)
+	require.NoError(err)
+	require.Equal(f.addrs[1].String(), link.Owner)
+	require.Equal(linkedpackets.LinkStatusOpen, link.Status)
+
+	session, err := f.k.Sessions.Get(f.ctx, f.addrs[1].String())
+	require.NoError(err)
+	require.Equal(linkedpackets.LinkSession{LinkId: linkID, Owner: f.addrs[1].String()}, session)
+
+	// ending the link of an owner leaves the links of other owners open
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[0].String()))
+
+	hasSession, err := f.k.Sessions.Has(f.ctx, f.addrs[0].String())
+	require.NoError(err)
+	require.False(hasSession)
+
+	hasSession, err = f.k.Sessions.Has(f.ctx, f.addrs[1].String())
+	require.NoError(err)
+	require.True(hasSession)
+
+	// ending a link of an owner without a link in progress is a no-op
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[0].String()))
+}
+
+func TestStartLinkSkipsTakenLinkIDs(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// the next generated identifier is chosen by the owner of another link
+	linkID, err := f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{LinkID: "link-0"})
+	require.NoError(err)
+	require.Equal("link-0", linkID)
+
+	linkID, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{})
+	require.NoError(err)
+	require.Equal("link-1", linkID)
+
+	link, err := f.k.Links.Get(f.ctx, "link-0")
+	require.NoError(err)
+	require.Equal(f.addrs[0].String(), link.Owner)
+}
+
+func TestAddLinkPacketWithoutLink(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// the packets are only added to links started by their owner
+	err := f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1)
+	require.ErrorIs(err, linkedpackets.ErrLinkNotFound)
+
+	has, err := f.k.Links.Has(f.ctx, "mylinkid")
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestStartLinkParams(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := linkedpackets.DefaultParams()
+	params.MaxOpenLinksPerAccount = 1
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	// the link of the owner counts towards the limit until it is resolved
+	linkID, err := f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{})
+	require.NoError(err)
+	require.NoError(f.k.AddLinkPacket(f.ctx, linkID, "transfer", "channel-0", 2))
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[0].String()))
+
+	count, err := f.k.OpenLinkCounts.Get(f.ctx, f.addrs[0].String())
+	require.NoError(err)
+	require.Equal(uint64(1), count)
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{})
+	require.ErrorIs(err, linkedpackets.ErrLinkLimitExceeded)
+
+	// other accounts are not limited by the open links of the owner
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{})
+	require.NoError(err)
+
+	require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 2, linkedpackets.PacketOutcomeSuccess))
+
+	has, err := f.k.OpenLinkCounts.Has(f.ctx, f.addrs[0].String())
+	require.NoError(err)
+	require.False(has)
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{})
+	require.NoError(err)
+
+	params.LinkingEnabled = false
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[2].String(), linkedpackets.LinkOptions{})
+	require.ErrorIs(err, linkedpackets.ErrLinkingDisabled)
+}
+
+func TestValidateLinkPacket(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(params *linkedpackets.Params)
+		dataType string
+		height   int64
+		expErr   error
+	}{
+		{"success: default params", func(*linkedpackets.Params) {}, linkedpackets.PacketDataTypeTransfer, 10, nil},
+		{
+			"failure: linking disabled",
+			func(params *linkedpackets.Params) { params.LinkingEnabled = false },
+			linkedpackets.PacketDataTypeTransfer, 10, linkedpackets.ErrLinkingDisabled,
+		},
+		{
+			"failure: packet data type not allowed",
+			func(params *linkedpackets.Params) {
+				params.AllowedPacketDataTypes = []string{linkedpackets.PacketDataTypeTransfer}
+			},
+			linkedpackets.PacketDataTypeICA, 10, linkedpackets.ErrInvalidPacketData,
+		},
+		{
+			"failure: link has the maximum number of packets",
+			func(params *linkedpackets.Params) { params.MaxLinkPackets = 2 },
+			linkedpackets.PacketDataTypeTransfer, 10, linkedpackets.ErrLinkLimitExceeded,
+		},
+		{
+			"success: link not expired",
+			func(params *linkedpackets.Params) { params.LinkExpiryBlocks = 5 },
+			linkedpackets.PacketDataTypeTransfer, 14, nil,
+		},
+		{
+			"failure: link expired",
+			func(params *linkedpackets.Params) { params.LinkExpiryBlocks = 5 },
+			linkedpackets.PacketDataTypeTransfer, 15, linkedpackets.ErrLinkExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			linkID, err := f.k.StartLink(f.ctx.WithBlockHeight(10), f.addrs[0].String(), linkedpackets.LinkOptions{})
+			require.NoError(err)
+
+			params := linkedpackets.DefaultParams()
+			tc.malleate(&params)
+			require.NoError(f.k.Params.Set(f.ctx, params))
+
+			session := linkedpackets.LinkSession{LinkId: linkID, Owner: f.addrs[0].String(), LinkIndex: 2}
+			err = f.k.ValidateLinkPacket(f.ctx.WithBlockHeight(tc.height), session, tc.dataType)
+			if tc.expErr == nil {
+				require.NoError(err)
+			} else {
+				require.ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestWithLink(t *testing.T) {
+	testCases := []struct {
+		name      string
+		fn        func(f *testFixture) func(ctx context.Context) error
+		expPass   bool
+		expStatus linkedpackets.LinkStatus
+	}{
+		{
+			"success: packets are linked",
+			func(f *testFixture) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					return f.k.AddLinkPacket(ctx, "mylinkid", "transfer", "channel-0", 1)
+				}
+			},
+			true,
+			linkedpackets.LinkStatusPending,
+		},
+		{
+			"success: empty link is removed",
+			func(*testFixture) func(ctx context.Context) error {
+				return func(context.Context) error { return nil }
+			},
+			true,
+			linkedpackets.LinkStatusUnspecified,
+		},
+		{
+			"failure: callback returns an error",
+			func(f *testFixture) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					if err := f.k.AddLinkPacket(ctx, "mylinkid", "transfer", "channel-0", 1); err != nil {
+						return err
+					}
+					return errors.New("callback error")
+				}
+			},
+			false,
+			linkedpackets.LinkStatusUnspecified,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			linkID, err := f.k.WithLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"}, tc.fn(f))
+			if tc.expPass {
+				require.NoError(err)
+				require.Equal("mylinkid", linkID)
+			} else {
+				require.Error(err)
+			}
+
+			hasSession, err := f.k.Sessions.Has(f.ctx, f.addrs[0].String())
+			require.NoError(err)
+			require.False(hasSession)
+
+			link, err := f.k.Links.Get(f.ctx, "mylinkid")
+			if tc.expStatus == linkedpackets.LinkStatusUnspecified {
+				require.ErrorIs(err, collections.ErrNotFound)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expStatus, link.Status)
+			}
+		})
+	}
+}
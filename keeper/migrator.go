@@ -1,5 +1,12 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/srdtrk/linkedpackets/migrations/v2"
+	v3 "github.com/srdtrk/linkedpackets/migrations/v3"
+)
+
 // Migrator is a struct for handling in-place state migrations.
 type Migrator struct {
 	keeper Keeper
@@ -13,6 +20,14 @@ func NewMigrator(k Keeper) Migrator {
 }
 
 // Migrate1to2 migrates the module state from version 1 to version 2.
-// func (m Migrator) Migrate1to2(ctx context.Context) error {
-// 	return v2.Migrate(/* migrations arguments */)
-// }
+// Version 2 keys the links in progress by their owner. The link in progress, whose owner was not recorded in
+// version 1, is given to the module authority, which can stop it or force close it.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper.GetAuthority())
+}
+
+// Migrate2to3 migrates the module state from version 2 to version 3.
+// Version 3 sets the default params and counts the open links of every account.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
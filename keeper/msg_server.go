@@ -25,14 +25,26 @@ func (ms msgServer) InitLink(ctx context.Context, msg *linkedpackets.MsgInitLink
 		return nil, fmt.Errorf("invalid sender address: %w", err)
 	}
 
-	err := ms.k.Linking.Set(ctx, true)
-	if err != nil {
+	if err := ms.k.ConsumeAccountLinkQuota(ctx, msg.Sender); err != nil {
 		return nil, err
 	}
 
-	err = ms.k.LinkId.Set(ctx, msg.LinkId)
+	linkID, err := ms.k.StartLink(ctx, msg.Sender, linkedpackets.LinkOptions{
+		LinkID:        msg.LinkId,
+		Compensations: msg.Compensations,
+		RelayerReward: msg.RelayerReward,
+
+		SrcCallbackAddress:  msg.SrcCallbackAddress,
+		DestCallbackAddress: msg.DestCallbackAddress,
+		Atomic:              msg.Atomic,
+		AllowedChannels:     msg.AllowedChannels,
+		MaxPackets:          msg.MaxPackets,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &linkedpackets.MsgInitLinkResponse{}, nil
+	return &linkedpackets.MsgInitLinkResponse{LinkId: linkID}, nil
 }
 
 // StopLink defines the handler for the MsgStopLink message.
@@ -41,41 +53,78 @@ func (ms msgServer) StopLink(ctx context.Context, msg *linkedpackets.MsgStopLink
 		return nil, fmt.Errorf("invalid sender address: %w", err)
 	}
 
-	err := ms.k.Linking.Set(ctx, false)
-	if err != nil {
+	if err := ms.k.EndLink(ctx, msg.Sender); err != nil {
 		return nil, err
 	}
 
-	err = ms.k.PrevPacket.Remove(ctx)
-	if err != nil {
+	return &linkedpackets.MsgStopLinkResponse{}, nil
+}
+
+// UpdateParams params is defining the handler for the MsgUpdateParams message.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *linkedpackets.MsgUpdateParams) (*linkedpackets.MsgUpdateParamsResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
 
-	err = ms.k.LinkId.Remove(ctx)
-	if err != nil {
+	if err := msg.Params.Validate(); err != nil {
 		return nil, err
 	}
 
-	return &linkedpackets.MsgStopLinkResponse{}, nil
+	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &linkedpackets.MsgUpdateParamsResponse{}, nil
 }
 
-// UpdateParams params is defining the handler for the MsgUpdateParams message.
-func (ms msgServer) UpdateParams(ctx context.Context, msg *linkedpackets.MsgUpdateParams) (*linkedpackets.MsgUpdateParamsResponse, error) {
-	if _, err := ms.k.addressCodec.StringToBytes(msg.Authority); err != nil {
-		return nil, fmt.Errorf("invalid authority address: %w", err)
+// ForceCloseLink defines the handler for the MsgForceCloseLink message.
+func (ms msgServer) ForceCloseLink(ctx context.Context, msg *linkedpackets.MsgForceCloseLink) (*linkedpackets.MsgForceCloseLinkResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
 	}
 
-	if authority := ms.k.GetAuthority(); !strings.EqualFold(msg.Authority, authority) {
-		return nil, fmt.Errorf("unauthorized, authority does not match the module's authority: got %s, want %s", msg.Authority, authority)
+	if err := ms.k.ForceCloseLink(ctx, msg.PortId, msg.ChannelId, msg.LinkId, false); err != nil {
+		return nil, err
 	}
 
-	if err := msg.Params.Validate(); err != nil {
+	return &linkedpackets.MsgForceCloseLinkResponse{}, nil
+}
+
+// ForceFailLink defines the handler for the MsgForceFailLink message.
+func (ms msgServer) ForceFailLink(ctx context.Context, msg *linkedpackets.MsgForceFailLink) (*linkedpackets.MsgForceFailLinkResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
 
-	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+	if err := ms.k.ForceCloseLink(ctx, msg.PortId, msg.ChannelId, msg.LinkId, true); err != nil {
 		return nil, err
 	}
 
-	return &linkedpackets.MsgUpdateParamsResponse{}, nil
+	return &linkedpackets.MsgForceFailLinkResponse{}, nil
+}
+
+// SetLinkingPaused defines the handler for the MsgSetLinkingPaused message.
+func (ms msgServer) SetLinkingPaused(ctx context.Context, msg *linkedpackets.MsgSetLinkingPaused) (*linkedpackets.MsgSetLinkingPausedResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetLinkingPaused(ctx, msg.Paused, msg.Channels); err != nil {
+		return nil, err
+	}
+
+	return &linkedpackets.MsgSetLinkingPausedResponse{}, nil
+}
+
+// validateAuthority checks that the signer of an authority-gated message is the module's authority.
+func (ms msgServer) validateAuthority(authority string) error {
+	if _, err := ms.k.addressCodec.StringToBytes(authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	if expected := ms.k.GetAuthority(); !strings.EqualFold(authority, expected) {
+		return fmt.Errorf("unauthorized, authority does not match the module's authority: got %s, want %s", authority, expected)
+	}
+
+	return nil
 }
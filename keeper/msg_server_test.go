@@ -31,11 +31,19 @@ func TestUpdateParams(t *testing.T) {
 			},
 			expectErrMsg: fmt.Sprintf("unauthorized, authority does not match the module's authority: got %s, want %s", f.addrs[1].String(), f.k.GetAuthority()),
 		},
+		{
+			name: "set invalid params",
+			request: &linkedpackets.MsgUpdateParams{
+				Authority: f.k.GetAuthority(),
+				Params:    linkedpackets.Params{AllowedPacketDataTypes: []string{"foo"}},
+			},
+			expectErrMsg: "unknown packet data type",
+		},
 		{
 			name: "set valid params",
 			request: &linkedpackets.MsgUpdateParams{
 				Authority: f.k.GetAuthority(),
-				Params:    linkedpackets.Params{},
+				Params:    linkedpackets.DefaultParams(),
 			},
 			expectErrMsg: "",
 		},
@@ -75,9 +83,26 @@ func TestInitLink(t *testing.T) {
 			name: "set valid sender",
 			request: &linkedpackets.MsgInitLink{
 				Sender: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+				LinkId: "mylinkid",
 			},
 			expectErrMsg: "",
 		},
+		{
+			name: "link already exists",
+			request: &linkedpackets.MsgInitLink{
+				Sender: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+				LinkId: "mylinkid",
+			},
+			expectErrMsg: "link already exists",
+		},
+		{
+			name: "link already in progress",
+			request: &linkedpackets.MsgInitLink{
+				Sender: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+				LinkId: "otherlinkid",
+			},
+			expectErrMsg: "link already in progress",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,9 +114,9 @@ func TestInitLink(t *testing.T) {
 				require.ErrorContains(err, tc.expectErrMsg)
 			} else {
 				require.NoError(err)
-				val, err := f.k.Linking.Get(f.ctx)
+				hasSession, err := f.k.Sessions.Has(f.ctx, tc.request.Sender)
 				require.NoError(err)
-				require.Equal(true, val)
+				require.True(hasSession)
 			}
 		})
 	}
@@ -131,9 +156,9 @@ func TestStopLink(t *testing.T) {
 				require.ErrorContains(err, tc.expectErrMsg)
 			} else {
 				require.NoError(err)
-				val, err := f.k.Linking.Get(f.ctx)
+				hasSession, err := f.k.Sessions.Has(f.ctx, tc.request.Sender)
 				require.NoError(err)
-				require.Equal(false, val)
+				require.False(hasSession)
 			}
 		})
 	}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// SetLinkingPaused pauses or resumes linking on the given channels, or chain-wide if no channel is given. While
+// linking is paused, links cannot be opened and linked packets are neither sent nor received. Packets which are
+// not linked are not affected.
+func (k Keeper) SetLinkingPaused(ctx context.Context, paused bool, channels []linkedpackets.ChannelIdentifier) error {
+	if err := linkedpackets.ValidateChannels(channels); err != nil {
+		return err
+	}
+
+	if len(channels) == 0 {
+		if err := k.LinkingPaused.Set(ctx, paused); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range channels {
+		key := collections.Join(c.PortId, c.ChannelId)
+		if paused {
+			if err := k.PausedChannels.Set(ctx, key); err != nil {
+				return err
+			}
+		} else if err := k.PausedChannels.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkingPaused{
+		Paused:   paused,
+		Channels: channels,
+	})
+}
+
+// IsLinkingPaused returns true if linking is paused chain-wide or, if a channel is given, on the channel. Linking
+// is paused chain-wide if the authority paused it, or if the circuit breaker disables MsgInitLink.
+func (k Keeper) IsLinkingPaused(ctx context.Context, portID, channelID string) (bool, error) {
+	paused, err := k.LinkingPaused.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+	if paused {
+		return true, nil
+	}
+
+	if k.circuitBreaker != nil {
+		allowed, err := k.circuitBreaker.IsAllowed(ctx, sdk.MsgTypeURL(&linkedpackets.MsgInitLink{}))
+		if err != nil {
+			return false, err
+		}
+		if !allowed {
+			return true, nil
+		}
+	}
+
+	if channelID == "" {
+		return false, nil
+	}
+
+	return k.PausedChannels.Has(ctx, collections.Join(portID, channelID))
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// mockCircuitBreaker is a circuit breaker which disables the recorded message type URLs.
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb.disabled[typeURL], nil
+}
+
+func TestSetLinkingPaused(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	channel := linkedpackets.ChannelIdentifier{PortId: "transfer", ChannelId: "channel-0"}
+	isPaused := func(portID, channelID string) bool {
+		res, err := f.queryServer.LinkingPaused(f.ctx, &linkedpackets.QueryLinkingPausedRequest{PortId: portID, ChannelId: channelID})
+		require.NoError(err)
+		return res.Paused
+	}
+
+	_, err := f.msgServer.SetLinkingPaused(f.ctx, &linkedpackets.MsgSetLinkingPaused{Authority: f.addrs[1].String(), Paused: true})
+	require.ErrorContains(err, "unauthorized")
+
+	_, err = f.msgServer.SetLinkingPaused(f.ctx, &linkedpackets.MsgSetLinkingPaused{
+		Authority: f.k.GetAuthority(), Paused: true, Channels: []linkedpackets.ChannelIdentifier{channel, channel},
+	})
+	require.ErrorContains(err, "duplicate channel")
+
+	// pausing a channel only pauses linking on the channel
+	_, err = f.msgServer.SetLinkingPaused(f.ctx, &linkedpackets.MsgSetLinkingPaused{
+		Authority: f.k.GetAuthority(), Paused: true, Channels: []linkedpackets.ChannelIdentifier{channel},
+	})
+	require.NoError(err)
+	require.True(isPaused("transfer", "channel-0"))
+	require.False(isPaused("transfer", "channel-1"))
+	require.False(isPaused("", ""))
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{})
+	require.NoError(err)
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[1].String()))
+
+	// pausing linking chain-wide pauses every channel and the opening of links
+	_, err = f.msgServer.SetLinkingPaused(f.ctx, &linkedpackets.MsgSetLinkingPaused{Authority: f.k.GetAuthority(), Paused: true})
+	require.NoError(err)
+	require.True(isPaused("", ""))
+	require.True(isPaused("transfer", "channel-1"))
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{})
+	require.ErrorIs(err, linkedpackets.ErrLinkingPaused)
+
+	// resuming linking chain-wide does not resume the paused channels
+	_, err = f.msgServer.SetLinkingPaused(f.ctx, &linkedpackets.MsgSetLinkingPaused{Authority: f.k.GetAuthority(), Paused: false})
+	require.NoError(err)
+	require.False(isPaused("", ""))
+	require.True(isPaused("transfer", "channel-0"))
+
+	_, err = f.msgServer.SetLinkingPaused(f.ctx, &linkedpackets.MsgSetLinkingPaused{
+		Authority: f.k.GetAuthority(), Paused: false, Channels: []linkedpackets.ChannelIdentifier{channel},
+	})
+	require.NoError(err)
+	require.False(isPaused("transfer", "channel-0"))
+
+	genesis, err := f.k.ExportGenesis(f.ctx)
+	require.NoError(err)
+	require.False(genesis.LinkingPaused)
+	require.Empty(genesis.PausedChannels)
+}
+
+func TestLinkingPausedByCircuitBreaker(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	circuitBreaker := mockCircuitBreaker{disabled: make(map[string]bool)}
+	f.k.SetCircuitBreaker(circuitBreaker)
+
+	paused, err := f.k.IsLinkingPaused(f.ctx, "transfer", "channel-0")
+	require.NoError(err)
+	require.False(paused)
+
+	circuitBreaker.disabled["/srdtrk.linkedpackets.v1.MsgInitLink"] = true
+
+	paused, err = f.k.IsLinkingPaused(f.ctx, "transfer", "channel-0")
+	require.NoError(err)
+	require.True(paused)
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{})
+	require.ErrorIs(err, linkedpackets.ErrLinkingPaused)
+}
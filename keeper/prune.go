@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// PruneResolvedLinks removes the links resolved at least ResolvedLinkRetentionBlocks blocks ago. It is called at
+// the end of every block, so that the state of the sent links does not grow with every packet sent.
+func (k Keeper) PruneResolvedLinks(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if height < params.ResolvedLinkRetentionBlocks {
+		return nil
+	}
+
+	var keys []collections.Pair[uint64, string]
+	rng := collections.NewPrefixUntilPairRange[uint64, string](height - params.ResolvedLinkRetentionBlocks)
+	if err := k.ResolvedLinks.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.pruneLink(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		if err := k.ResolvedLinks.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneLink removes a resolved link. Its compensation packets still waiting for an outcome are no longer tracked.
+func (k Keeper) pruneLink(ctx context.Context, linkID string) error {
+	link, err := k.Links.Get(ctx, linkID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range link.CompensationPackets {
+		if err := k.removePacketLink(ctx, p.Packet); err != nil {
+			return err
+		}
+	}
+
+	return k.Links.Remove(ctx, linkID)
+}
+
+// removePacketLink stops tracking the link of a sent packet, so that its acknowledgement or timeout no longer
+// affects the link.
+func (k Keeper) removePacketLink(ctx context.Context, packet linkedpackets.PacketIdentifier) error {
+	seq, err := strconv.ParseUint(packet.Seq, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return k.PacketLinks.Remove(ctx, collections.Join3(packet.PortId, packet.ChannelId, seq))
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestPruneResolvedLinks(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := linkedpackets.DefaultParams()
+	params.ResolvedLinkRetentionBlocks = 5
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	ctx := f.ctx.WithBlockHeight(10)
+	_, err := f.k.StartLink(ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+	_, err = f.k.StartLink(ctx, f.addrs[2].String(), linkedpackets.LinkOptions{LinkID: "pendinglink"})
+	require.NoError(err)
+	for seq := uint64(1); seq <= 2; seq++ {
+		require.NoError(f.k.AddLinkPacket(ctx, "mylinkid", "transfer", "channel-0", seq))
+	}
+	require.NoError(f.k.AddLinkPacket(ctx, "pendinglink", "transfer", "channel-0", 3))
+	require.NoError(f.k.EndLink(ctx, f.addrs[1].String()))
+	require.NoError(f.k.EndLink(ctx, f.addrs[2].String()))
+
+	for seq := uint64(1); seq <= 2; seq++ {
+		require.NoError(f.k.SetPacketOutcome(ctx, "transfer", "channel-0", seq, linkedpackets.PacketOutcomeError))
+	}
+
+	// the packets of the resolved link are no longer tracked
+	for seq := uint64(1); seq <= 2; seq++ {
+		has, err := f.k.PacketLinks.Has(ctx, collections.Join3("transfer", "channel-0", seq))
+		require.NoError(err)
+		require.False(has)
+	}
+
+	link, err := f.k.Links.Get(ctx, "mylinkid")
+	require.NoError(err)
+	require.Equal(linkedpackets.LinkStatusFailed, link.Status)
+	require.Equal(uint64(10), link.ResolveHeight)
+
+	// the compensation packets of the resolved link are tracked until they have an outcome or the link is pruned
+	link.CompensationPackets = []linkedpackets.CompensationPacket{
+		{Packet: linkedpackets.PacketIdentifier{PortId: "icacontroller-owner", ChannelId: "channel-1", Seq: "1"}},
+		{Packet: linkedpackets.PacketIdentifier{PortId: "icacontroller-owner", ChannelId: "channel-1", Seq: "2"}},
+	}
+	require.NoError(f.k.Links.Set(ctx, "mylinkid", link))
+	for seq := uint64(1); seq <= 2; seq++ {
+		require.NoError(f.k.PacketLinks.Set(ctx, collections.Join3("icacontroller-owner", "channel-1", seq), "mylinkid"))
+	}
+
+	require.NoError(f.k.SetPacketOutcome(ctx, "icacontroller-owner", "channel-1", 1, linkedpackets.PacketOutcomeSuccess))
+	has, err := f.k.PacketLinks.Has(ctx, collections.Join3("icacontroller-owner", "channel-1", uint64(1)))
+	require.NoError(err)
+	require.False(has)
+
+	// the link is kept during its retention period
+	require.NoError(f.k.PruneResolvedLinks(f.ctx.WithBlockHeight(14)))
+	has, err = f.k.Links.Has(ctx, "mylinkid")
+	require.NoError(err)
+	require.True(has)
+
+	require.NoError(f.k.PruneResolvedLinks(f.ctx.WithBlockHeight(15)))
+	has, err = f.k.Links.Has(ctx, "mylinkid")
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.PacketLinks.Has(ctx, collections.Join3("icacontroller-owner", "channel-1", uint64(2)))
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.ResolvedLinks.Has(ctx, collections.Join(uint64(10), "mylinkid"))
+	require.NoError(err)
+	require.False(has)
+
+	// unresolved links are never pruned
+	has, err = f.k.Links.Has(ctx, "pendinglink")
+	require.NoError(err)
+	require.True(has)
+
+	has, err = f.k.PacketLinks.Has(ctx, collections.Join3("transfer", "channel-0", uint64(3)))
+	require.NoError(err)
+	require.True(has)
+
+	// the link identifier can be reused once the link is pruned
+	_, err = f.k.StartLink(ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+}
+
+func TestPruneResolvedLinksWithoutRetention(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := linkedpackets.DefaultParams()
+	params.ResolvedLinkRetentionBlocks = 0
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	ctx := f.ctx.WithBlockHeight(10)
+	_, err := f.k.StartLink(ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+	require.NoError(f.k.AddLinkPacket(ctx, "mylinkid", "transfer", "channel-0", 1))
+	require.NoError(f.k.EndLink(ctx, f.addrs[1].String()))
+	require.NoError(f.k.SetPacketOutcome(ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+
+	// the link can be queried until the end of the block it is resolved in
+	link, err := f.k.Links.Get(ctx, "mylinkid")
+	require.NoError(err)
+	require.Equal(linkedpackets.LinkStatusSucceeded, link.Status)
+
+	require.NoError(f.k.PruneResolvedLinks(ctx))
+	has, err := f.k.Links.Has(ctx, "mylinkid")
+	require.NoError(err)
+	require.False(has)
+}
@@ -8,6 +8,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/srdtrk/linkedpackets"
 )
 
@@ -36,6 +38,159 @@ func (qs queryServer) LinkEnabledChannel(ctx context.Context, req *linkedpackets
 	return &linkedpackets.QueryLinkEnabledChannelResponse{LinkEnabled: isLinkEnabled}, nil
 }
 
+// LinkEnabledChannels defines the handler for the Query/LinkEnabledChannels RPC method.
+func (qs queryServer) LinkEnabledChannels(ctx context.Context, req *linkedpackets.QueryLinkEnabledChannelsRequest) (*linkedpackets.QueryLinkEnabledChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	channels, pageRes, err := query.CollectionPaginate(
+		ctx, qs.k.LinkEnabled, req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (linkedpackets.ChannelIdentifier, error) {
+			return linkedpackets.ChannelIdentifier{PortId: key.K1(), ChannelId: key.K2()}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryLinkEnabledChannelsResponse{Channels: channels, Pagination: pageRes}, nil
+}
+
+// Link defines the handler for the Query/Link RPC method.
+func (qs queryServer) Link(ctx context.Context, req *linkedpackets.QueryLinkRequest) (*linkedpackets.QueryLinkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	link, err := qs.k.Links.Get(ctx, req.LinkId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "link %s not found", req.LinkId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryLinkResponse{Link: link}, nil
+}
+
+// Links defines the handler for the Query/Links RPC method.
+func (qs queryServer) Links(ctx context.Context, req *linkedpackets.QueryLinksRequest) (*linkedpackets.QueryLinksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	links, pageRes, err := query.CollectionFilteredPaginate(
+		ctx, qs.k.Links, req.Pagination,
+		func(_ string, link linkedpackets.Link) (bool, error) {
+			return matchLink(link, req), nil
+		},
+		func(_ string, link linkedpackets.Link) (linkedpackets.Link, error) {
+			return link, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryLinksResponse{Links: links, Pagination: pageRes}, nil
+}
+
+// OpenSession defines the handler for the Query/OpenSession RPC method.
+func (qs queryServer) OpenSession(ctx context.Context, req *linkedpackets.QueryOpenSessionRequest) (*linkedpackets.QueryOpenSessionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := qs.k.addressCodec.StringToBytes(req.Sender); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	session, err := qs.k.Sessions.Get(ctx, req.Sender)
+	if errors.Is(err, collections.ErrNotFound) {
+		return &linkedpackets.QueryOpenSessionResponse{}, nil
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	link, err := qs.k.Links.Get(ctx, session.LinkId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryOpenSessionResponse{Link: &link}, nil
+}
+
+// matchLink returns true if the link matches every filter set in the request.
+func matchLink(link linkedpackets.Link, req *linkedpackets.QueryLinksRequest) bool {
+	if req.Owner != "" && link.Owner != req.Owner {
+		return false
+	}
+
+	if req.Status != linkedpackets.LinkStatusUnspecified && link.Status != req.Status {
+		return false
+	}
+
+	if req.PortId == "" && req.ChannelId == "" {
+		return true
+	}
+
+	for _, p := range link.Packets {
+		if (req.PortId == "" || p.Packet.PortId == req.PortId) && (req.ChannelId == "" || p.Packet.ChannelId == req.ChannelId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LinkingPaused defines the handler for the Query/LinkingPaused RPC method.
+func (qs queryServer) LinkingPaused(ctx context.Context, req *linkedpackets.QueryLinkingPausedRequest) (*linkedpackets.QueryLinkingPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	paused, err := qs.k.IsLinkingPaused(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryLinkingPausedResponse{Paused: paused}, nil
+}
+
+// AccountLinkQuota defines the handler for the Query/AccountLinkQuota RPC method.
+func (qs queryServer) AccountLinkQuota(ctx context.Context, req *linkedpackets.QueryAccountLinkQuotaRequest) (*linkedpackets.QueryAccountLinkQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := qs.k.addressCodec.StringToBytes(req.Account); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	quota, err := qs.k.AccountLinkQuota(ctx, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryAccountLinkQuotaResponse{Quota: quota}, nil
+}
+
+// ChannelLinkQuota defines the handler for the Query/ChannelLinkQuota RPC method.
+func (qs queryServer) ChannelLinkQuota(ctx context.Context, req *linkedpackets.QueryChannelLinkQuotaRequest) (*linkedpackets.QueryChannelLinkQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	quota, err := qs.k.ChannelLinkQuota(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &linkedpackets.QueryChannelLinkQuotaResponse{Quota: quota}, nil
+}
+
 // Params defines the handler for the Query/Params RPC method.
 func (qs queryServer) Params(ctx context.Context, req *linkedpackets.QueryParamsRequest) (*linkedpackets.QueryParamsResponse, error) {
 	params, err := qs.k.Params.Get(ctx)
@@ -3,8 +3,15 @@ package keeper_test
 import (
 	"testing"
 
-	"github.com/srdtrk/linkedpackets"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/srdtrk/linkedpackets"
 )
 
 func TestQueryParams(t *testing.T) {
@@ -13,7 +20,7 @@ func TestQueryParams(t *testing.T) {
 
 	resp, err := f.queryServer.Params(f.ctx, &linkedpackets.QueryParamsRequest{})
 	require.NoError(err)
-	require.Equal(linkedpackets.Params{}, resp.Params)
+	require.Equal(linkedpackets.DefaultParams(), resp.Params)
 }
 
 func TestQueryLinkEnabledChannel(t *testing.T) {
@@ -24,3 +31,121 @@ func TestQueryLinkEnabledChannel(t *testing.T) {
 	require.NoError(err)
 	require.Equal(false, resp.LinkEnabled)
 }
+
+func TestQueryLinkEnabledChannels(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.LinkEnabled.Set(f.ctx, collections.Join("transfer", "channel-0")))
+	require.NoError(f.k.LinkEnabled.Set(f.ctx, collections.Join("transfer", "channel-1")))
+
+	resp, err := f.queryServer.LinkEnabledChannels(f.ctx, &linkedpackets.QueryLinkEnabledChannelsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal([]linkedpackets.ChannelIdentifier{{PortId: "transfer", ChannelId: "channel-0"}}, resp.Channels)
+	require.Equal(uint64(2), resp.Pagination.Total)
+
+	resp, err = f.queryServer.LinkEnabledChannels(f.ctx, &linkedpackets.QueryLinkEnabledChannelsRequest{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal([]linkedpackets.ChannelIdentifier{{PortId: "transfer", ChannelId: "channel-1"}}, resp.Channels)
+}
+
+func TestQueryLink(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	_, err := f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+	require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+
+	testCases := []struct {
+		name    string
+		request *linkedpackets.QueryLinkRequest
+		expCode codes.Code
+	}{
+		{"success", &linkedpackets.QueryLinkRequest{LinkId: "mylinkid"}, codes.OK},
+		{"link not found", &linkedpackets.QueryLinkRequest{LinkId: "otherlinkid"}, codes.NotFound},
+		{"empty request", nil, codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := f.queryServer.Link(f.ctx, tc.request)
+			require.Equal(tc.expCode, status.Code(err))
+			if tc.expCode == codes.OK {
+				require.Equal("mylinkid", resp.Link.LinkId)
+				require.Len(resp.Link.Packets, 1)
+			}
+		})
+	}
+}
+
+func TestQueryLinks(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	_, err := f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{LinkID: "link-a"})
+	require.NoError(err)
+	require.NoError(f.k.AddLinkPacket(f.ctx, "link-a", "transfer", "channel-0", 1))
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[0].String()))
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{LinkID: "link-b"})
+	require.NoError(err)
+	require.NoError(f.k.AddLinkPacket(f.ctx, "link-b", "transfer", "channel-1", 1))
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[1].String()))
+	require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-1", 1, linkedpackets.PacketOutcomeSuccess))
+
+	testCases := []struct {
+		name     string
+		request  *linkedpackets.QueryLinksRequest
+		expLinks []string
+	}{
+		{"no filter", &linkedpackets.QueryLinksRequest{}, []string{"link-a", "link-b"}},
+		{"owner filter", &linkedpackets.QueryLinksRequest{Owner: f.addrs[0].String()}, []string{"link-a"}},
+		{"status filter", &linkedpackets.QueryLinksRequest{Status: linkedpackets.LinkStatusSucceeded}, []string{"link-b"}},
+		{"channel filter", &linkedpackets.QueryLinksRequest{PortId: "transfer", ChannelId: "channel-0"}, []string{"link-a"}},
+		{"no match", &linkedpackets.QueryLinksRequest{ChannelId: "channel-2"}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := f.queryServer.Links(f.ctx, tc.request)
+			require.NoError(err)
+
+			var linkIDs []string
+			for _, link := range resp.Links {
+				linkIDs = append(linkIDs, link.LinkId)
+			}
+			require.Equal(tc.expLinks, linkIDs)
+		})
+	}
+}
+
+func TestQueryOpenSession(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	resp, err := f.queryServer.OpenSession(f.ctx, &linkedpackets.QueryOpenSessionRequest{Sender: f.addrs[0].String()})
+	require.NoError(err)
+	require.Nil(resp.Link)
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+
+	resp, err = f.queryServer.OpenSession(f.ctx, &linkedpackets.QueryOpenSessionRequest{Sender: f.addrs[0].String()})
+	require.NoError(err)
+	require.NotNil(resp.Link)
+	require.Equal("mylinkid", resp.Link.LinkId)
+
+	resp, err = f.queryServer.OpenSession(f.ctx, &linkedpackets.QueryOpenSessionRequest{Sender: f.addrs[1].String()})
+	require.NoError(err)
+	require.Nil(resp.Link)
+
+	_, err = f.queryServer.OpenSession(f.ctx, &linkedpackets.QueryOpenSessionRequest{Sender: "foo"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
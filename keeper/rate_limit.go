@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// ConsumeAccountLinkQuota counts a link opened by the given owner in the current rate limit window. It returns an
+// error if the owner already opened the maximum number of links per window.
+func (k Keeper) ConsumeAccountLinkQuota(ctx context.Context, owner string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	usage, err := k.AccountRateLimits.Get(ctx, owner)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	usage, err = consumeQuota(ctx, params, params.MaxLinksPerAccountWindow, usage)
+	if err != nil {
+		return errorsmod.Wrapf(err, "links opened by %s", owner)
+	}
+	if usage == (linkedpackets.RateLimitUsage{}) {
+		return nil
+	}
+
+	return k.AccountRateLimits.Set(ctx, owner, usage)
+}
+
+// ConsumeChannelLinkQuota counts a linked packet sent on the given channel in the current rate limit window. It
+// returns an error if the maximum number of linked packets per window was already sent on the channel.
+func (k Keeper) ConsumeChannelLinkQuota(ctx context.Context, portID, channelID string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(portID, channelID)
+	usage, err := k.ChannelRateLimits.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	usage, err = consumeQuota(ctx, params, params.MaxLinkedPacketsPerChannelWindow, usage)
+	if err != nil {
+		return errorsmod.Wrapf(err, "linked packets sent on channel %s/%s", portID, channelID)
+	}
+	if usage == (linkedpackets.RateLimitUsage{}) {
+		return nil
+	}
+
+	return k.ChannelRateLimits.Set(ctx, key, usage)
+}
+
+// AccountLinkQuota returns the number of links the given owner can still open in the current rate limit window.
+func (k Keeper) AccountLinkQuota(ctx context.Context, owner string) (linkedpackets.RateLimitQuota, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return linkedpackets.RateLimitQuota{}, err
+	}
+
+	usage, err := k.AccountRateLimits.Get(ctx, owner)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return linkedpackets.RateLimitQuota{}, err
+	}
+
+	return params.Quota(params.MaxLinksPerAccountWindow, usage, sdk.UnwrapSDKContext(ctx).BlockHeight()), nil
+}
+
+// ChannelLinkQuota returns the number of linked packets which can still be sent on the given channel in the current
+// rate limit window.
+func (k Keeper) ChannelLinkQuota(ctx context.Context, portID, channelID string) (linkedpackets.RateLimitQuota, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return linkedpackets.RateLimitQuota{}, err
+	}
+
+	usage, err := k.ChannelRateLimits.Get(ctx, collections.Join(portID, channelID))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return linkedpackets.RateLimitQuota{}, err
+	}
+
+	return params.Quota(params.MaxLinkedPacketsPerChannelWindow, usage, sdk.UnwrapSDKContext(ctx).BlockHeight()), nil
+}
+
+// PruneRateLimits removes the usages of the rate limit windows which are over. It is called at the end of every
+// block, so that the state of the rate limits does not grow with every account opening a link, but only walks the
+// usages on the first block of a window, once the usages of the previous window are over. Every usage is removed
+// if the rate limits are disabled.
+func (k Keeper) PruneRateLimits(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if params.RateLimitWindowBlocks != 0 && uint64(height)%params.RateLimitWindowBlocks != 0 {
+		return nil
+	}
+
+	window := params.RateLimitWindow(height)
+	isExpired := func(usage linkedpackets.RateLimitUsage) bool {
+		return params.RateLimitWindowBlocks == 0 || usage.Window != window
+	}
+
+	var accounts []string
+	if err := k.AccountRateLimits.Walk(ctx, nil, func(owner string, usage linkedpackets.RateLimitUsage) (bool, error) {
+		if isExpired(usage) {
+			accounts = append(accounts, owner)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, owner := range accounts {
+		if err := k.AccountRateLimits.Remove(ctx, owner); err != nil {
+			return err
+		}
+	}
+
+	var channels []collections.Pair[string, string]
+	if err := k.ChannelRateLimits.Walk(ctx, nil, func(key collections.Pair[string, string], usage linkedpackets.RateLimitUsage) (bool, error) {
+		if isExpired(usage) {
+			channels = append(channels, key)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range channels {
+		if err := k.ChannelRateLimits.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consumeQuota returns the usage of a rate limit with the given limit per window after counting one more link or
+// linked packet in the current window. It returns an empty usage if there is no limit, and an error if the quota
+// of the current window is exhausted.
+func consumeQuota(
+	ctx context.Context, params linkedpackets.Params, limit uint64, usage linkedpackets.RateLimitUsage,
+) (linkedpackets.RateLimitUsage, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	quota := params.Quota(limit, usage, height)
+	if quota.Limit == 0 {
+		return linkedpackets.RateLimitUsage{}, nil
+	}
+	if quota.Remaining == 0 {
+		return linkedpackets.RateLimitUsage{}, errorsmod.Wrapf(
+			linkedpackets.ErrRateLimitExceeded, "limit of %d per window reached until height %d", limit, quota.WindowEndHeight,
+		)
+	}
+
+	return linkedpackets.RateLimitUsage{
+		Window: params.RateLimitWindow(height),
+		Used:   limit - quota.Remaining + 1,
+	}, nil
+}
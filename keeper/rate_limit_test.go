@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestAccountLinkRateLimit(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := linkedpackets.DefaultParams()
+	params.RateLimitWindowBlocks = 10
+	params.MaxLinksPerAccountWindow = 2
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	owner := f.addrs[1].String()
+	ctx := f.ctx.WithBlockHeight(12)
+	quota := func(ctx sdk.Context) linkedpackets.RateLimitQuota {
+		res, err := f.queryServer.AccountLinkQuota(ctx, &linkedpackets.QueryAccountLinkQuotaRequest{Account: owner})
+		require.NoError(err)
+		return res.Quota
+	}
+
+	require.Equal(linkedpackets.RateLimitQuota{Limit: 2, Remaining: 2, WindowEndHeight: 20}, quota(ctx))
+
+	for i := 0; i < 2; i++ {
+		_, err := f.msgServer.InitLink(ctx, &linkedpackets.MsgInitLink{Sender: owner})
+		require.NoError(err)
+		_, err = f.msgServer.StopLink(ctx, &linkedpackets.MsgStopLink{Sender: owner})
+		require.NoError(err)
+	}
+	require.Equal(linkedpackets.RateLimitQuota{Limit: 2, Remaining: 0, WindowEndHeight: 20}, quota(ctx))
+
+	_, err := f.msgServer.InitLink(ctx, &linkedpackets.MsgInitLink{Sender: owner})
+	require.ErrorIs(err, linkedpackets.ErrRateLimitExceeded)
+
+	// other accounts have their own quota
+	_, err = f.msgServer.InitLink(ctx, &linkedpackets.MsgInitLink{Sender: f.addrs[2].String()})
+	require.NoError(err)
+
+	// the quota is reset in the next window
+	ctx = f.ctx.WithBlockHeight(20)
+	require.Equal(linkedpackets.RateLimitQuota{Limit: 2, Remaining: 2, WindowEndHeight: 30}, quota(ctx))
+	_, err = f.msgServer.InitLink(ctx, &linkedpackets.MsgInitLink{Sender: owner})
+	require.NoError(err)
+
+	genesis, err := f.k.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Contains(genesis.AccountRateLimits, linkedpackets.AccountRateLimit{
+		Account: owner,
+		Usage:   linkedpackets.RateLimitUsage{Window: 2, Used: 1},
+	})
+
+	_, err = f.queryServer.AccountLinkQuota(ctx, &linkedpackets.QueryAccountLinkQuotaRequest{Account: "foo"})
+	require.ErrorContains(err, "invalid account address")
+}
+
+func TestChannelLinkRateLimit(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// channels are not rate limited by default
+	require.NoError(f.k.ConsumeChannelLinkQuota(f.ctx, "transfer", "channel-0"))
+	has, err := f.k.ChannelRateLimits.Has(f.ctx, collections.Join("transfer", "channel-0"))
+	require.NoError(err)
+	require.False(has)
+
+	params := linkedpackets.DefaultParams()
+	params.MaxLinkedPacketsPerChannelWindow = 1
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	require.NoError(f.k.ConsumeChannelLinkQuota(f.ctx, "transfer", "channel-0"))
+	require.ErrorIs(f.k.ConsumeChannelLinkQuota(f.ctx, "transfer", "channel-0"), linkedpackets.ErrRateLimitExceeded)
+	require.NoError(f.k.ConsumeChannelLinkQuota(f.ctx, "transfer", "channel-1"))
+
+	res, err := f.queryServer.ChannelLinkQuota(f.ctx, &linkedpackets.QueryChannelLinkQuotaRequest{PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(err)
+	require.Equal(uint64(0), res.Quota.Remaining)
+}
+
+func TestPruneRateLimits(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := linkedpackets.DefaultParams()
+	params.RateLimitWindowBlocks = 10
+	params.MaxLinksPerAccountWindow = 2
+	params.MaxLinkedPacketsPerChannelWindow = 2
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	owners := []string{f.addrs[1].String(), f.addrs[2].String()}
+	channels := []collections.Pair[string, string]{
+		collections.Join("transfer", "channel-0"),
+		collections.Join("transfer", "channel-1"),
+	}
+	// the usages of the first account and channel are in the window ending at height 20
+	for i, window := range []uint64{1, 2} {
+		usage := linkedpackets.RateLimitUsage{Window: window, Used: 1}
+		require.NoError(f.k.AccountRateLimits.Set(f.ctx, owners[i], usage))
+		require.NoError(f.k.ChannelRateLimits.Set(f.ctx, channels[i], usage))
+	}
+
+	requireUsages := func(exp ...bool) {
+		t.Helper()
+		for i, hasUsage := range exp {
+			has, err := f.k.AccountRateLimits.Has(f.ctx, owners[i])
+			require.NoError(err)
+			require.Equal(hasUsage, has)
+
+			has, err = f.k.ChannelRateLimits.Has(f.ctx, channels[i])
+			require.NoError(err)
+			require.Equal(hasUsage, has)
+		}
+	}
+
+	// the usages are only pruned on the first block of a window
+	require.NoError(f.k.PruneRateLimits(f.ctx.WithBlockHeight(25)))
+	requireUsages(true, true)
+
+	require.NoError(f.k.PruneRateLimits(f.ctx.WithBlockHeight(20)))
+	requireUsages(false, true)
+
+	// every usage is pruned once the rate limits are disabled
+	params.RateLimitWindowBlocks = 0
+	params.MaxLinksPerAccountWindow = 0
+	params.MaxLinkedPacketsPerChannelWindow = 0
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	require.NoError(f.k.PruneRateLimits(f.ctx.WithBlockHeight(21)))
+	requireUsages(false, false)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// VerifyLinkPacket checks that the previous packet of a received linked packet is the last packet of its link
+// received on the same channel.
+//
+// NOTE: the order of the packets of a link is only enforced between the packets sent on the same channel. The
+// previous packet of a packet sent on another channel may have been sent to another chain, so this chain cannot
+// tell whether it was received. Such packets are always accepted, and links which need their packets to be
+// received in order must send them on a single channel.
+func (k Keeper) VerifyLinkPacket(ctx context.Context, packet channeltypes.Packet, linkData linkedpackets.LinkData) error {
+	prevSeq, ok, err := prevSequence(packet, linkData)
+	if err != nil || !ok {
+		return err
+	}
+
+	lastSeq, err := k.ReceivedLinkPackets.Get(ctx, collections.Join3(packet.DestinationPort, packet.DestinationChannel, linkData.LinkID))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err != nil || lastSeq != prevSeq {
+		return errorsmod.Wrapf(linkedpackets.ErrBrokenLink, "previous packet %d of link %s was not received", prevSeq, linkData.LinkID)
+	}
+
+	return nil
+}
+
+// SetLinkPacketReceived records a successfully received linked packet as the last packet of its link received on
+// its channel, so that its next packet on the channel can be verified. The link is no longer recorded on any of
+// its channels once its last packet is received.
+func (k Keeper) SetLinkPacketReceived(ctx context.Context, packet channeltypes.Packet, linkData linkedpackets.LinkData) error {
+	linkIndex, err := strconv.ParseUint(linkData.LinkIndex, 10, 64)
+	if err != nil {
+		return errorsmod.Wrapf(linkedpackets.ErrInvalidPacketData, "invalid link index %s", linkData.LinkIndex)
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkPacketReceived{
+		LinkId:    linkData.LinkID,
+		LinkIndex: linkIndex,
+		Packet: linkedpackets.PacketIdentifier{
+			PortId:    packet.DestinationPort,
+			ChannelId: packet.DestinationChannel,
+			Seq:       strconv.FormatUint(packet.Sequence, 10),
+		},
+		LastPacket: linkData.IsLastPacket,
+	}); err != nil {
+		return err
+	}
+
+	key := collections.Join3(packet.DestinationPort, packet.DestinationChannel, linkData.LinkID)
+	if linkData.IsLastPacket {
+		return k.removeReceivedLinkChannels(ctx, key)
+	}
+
+	return k.ReceivedLinkPackets.Set(ctx, key, packet.Sequence)
+}
+
+// removeReceivedLinkChannels stops recording a link on the channel its last packet is received on, and on the other
+// channels its packets were received on. The links with the same identifier received from other chains, i.e. on
+// channels with another client, are left untouched. Without a channel keeper, the link is only removed from the
+// channel of its last packet.
+func (k Keeper) removeReceivedLinkChannels(ctx context.Context, key collections.Triple[string, string, string]) error {
+	if k.channelKeeper == nil {
+		return k.ReceivedLinkPackets.Remove(ctx, key)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	clientID, _, err := k.channelKeeper.GetChannelClientState(sdkCtx, key.K1(), key.K2())
+	if err != nil {
+		return err
+	}
+
+	iter, err := k.ReceivedLinkPackets.Indexes.LinkID.MatchExact(ctx, key.K3())
+	if err != nil {
+		return err
+	}
+	channelKeys, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, channelKey := range channelKeys {
+		channelClientID, _, err := k.channelKeeper.GetChannelClientState(sdkCtx, channelKey.K1(), channelKey.K2())
+		if err != nil || channelClientID != clientID {
+			continue
+		}
+
+		if err := k.ReceivedLinkPackets.Remove(ctx, channelKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prevSequence returns the sequence of the previous packet of the link if it was sent on the same channel as the
+// given packet.
+func prevSequence(packet channeltypes.Packet, linkData linkedpackets.LinkData) (uint64, bool, error) {
+	prevPacket := linkData.PrevPacket
+	if linkData.IsInitalPacket || prevPacket.PortId != packet.SourcePort || prevPacket.ChannelId != packet.SourceChannel {
+		return 0, false, nil
+	}
+
+	prevSeq, err := strconv.ParseUint(prevPacket.Seq, 10, 64)
+	if err != nil {
+		return 0, false, errorsmod.Wrapf(linkedpackets.ErrInvalidPacketData, "invalid previous packet sequence %s", prevPacket.Seq)
+	}
+
+	return prevSeq, true, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestVerifyLinkPacket(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	newPacket := func(seq uint64) channeltypes.Packet {
+		return channeltypes.Packet{
+			Sequence:           seq,
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-1",
+		}
+	}
+	newLinkData := func(prevSeq string, isLast bool) linkedpackets.LinkData {
+		linkIndex := "0"
+		if prevSeq != "" {
+			linkIndex = "1"
+		}
+
+		return linkedpackets.LinkData{
+			LinkID:         "mylinkid",
+			PrevPacket:     linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: prevSeq},
+			IsInitalPacket: prevSeq == "",
+			IsLastPacket:   isLast,
+			LinkIndex:      linkIndex,
+		}
+	}
+
+	// the initial packet is always accepted
+	require.NoError(f.k.VerifyLinkPacket(f.ctx, newPacket(1), newLinkData("", false)))
+
+	// the next packet is rejected until its previous packet is received
+	require.ErrorIs(f.k.VerifyLinkPacket(f.ctx, newPacket(2), newLinkData("1", true)), linkedpackets.ErrBrokenLink)
+
+	require.NoError(f.k.SetLinkPacketReceived(f.ctx, newPacket(1), newLinkData("", false)))
+	require.NoError(f.k.VerifyLinkPacket(f.ctx, newPacket(2), newLinkData("1", true)))
+
+	// the previous packet must be the last packet of the link received on the channel
+	require.ErrorIs(f.k.VerifyLinkPacket(f.ctx, newPacket(3), newLinkData("2", true)), linkedpackets.ErrBrokenLink)
+
+	otherLinkData := newLinkData("1", true)
+	otherLinkData.LinkID = "otherlinkid"
+	require.ErrorIs(f.k.VerifyLinkPacket(f.ctx, newPacket(2), otherLinkData), linkedpackets.ErrBrokenLink)
+
+	// the link is no longer recorded once its last packet is received
+	require.NoError(f.k.SetLinkPacketReceived(f.ctx, newPacket(2), newLinkData("1", true)))
+
+	events := f.ctx.EventManager().ABCIEvents()
+	msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(err)
+	require.Equal(&linkedpackets.EventLinkPacketReceived{
+		LinkId:     "mylinkid",
+		LinkIndex:  1,
+		Packet:     linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-1", Seq: "2"},
+		LastPacket: true,
+	}, msg)
+
+	iter, err := f.k.ReceivedLinkPackets.Iterate(f.ctx, nil)
+	require.NoError(err)
+	keys, err := iter.Keys()
+	require.NoError(err)
+	require.Empty(keys)
+
+	// previous packets sent on another channel cannot be verified, so the packet is accepted
+	linkData := newLinkData("5", false)
+	linkData.PrevPacket.ChannelId = "channel-7"
+	require.NoError(f.k.VerifyLinkPacket(f.ctx, newPacket(3), linkData))
+}
+
+func TestSetLinkPacketReceivedInvalidLinkIndex(t *testing.T) {
+	f := initFixture(t)
+
+	packet := channeltypes.Packet{Sequence: 1, DestinationPort: "transfer", DestinationChannel: "channel-1"}
+	linkData := linkedpackets.LinkData{LinkID: "mylinkid", IsInitalPacket: true, LinkIndex: "foo"}
+	require.ErrorIs(t, f.k.SetLinkPacketReceived(f.ctx, packet, linkData), linkedpackets.ErrInvalidPacketData)
+}
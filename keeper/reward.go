@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// SetPacketRelayer records the relayer address of the acknowledgement of a sent packet. The relayer is paid
+// its share of the relayer reward once the link of the packet is resolved. It is a no-op if the packet is not
+// part of a link.
+func (k Keeper) SetPacketRelayer(ctx context.Context, portID, channelID string, sequence uint64, relayer string) error {
+	linkID, err := k.PacketLinks.Get(ctx, collections.Join3(portID, channelID, sequence))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	link, err := k.Links.Get(ctx, linkID)
+	if err != nil {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkNotFound, "link %s: %v", linkID, err)
+	}
+
+	packet := linkedpackets.PacketIdentifier{PortId: portID, ChannelId: channelID, Seq: strconv.FormatUint(sequence, 10)}
+	for i, p := range link.Packets {
+		if p.Packet == packet {
+			link.Packets[i].Relayer = relayer
+			return k.Links.Set(ctx, linkID, link)
+		}
+	}
+
+	return nil
+}
+
+// escrowRelayerReward escrows the relayer reward of a link from its owner to the module account and records
+// it in the link.
+func (k Keeper) escrowRelayerReward(ctx context.Context, link *linkedpackets.Link, reward sdk.Coins) error {
+	if reward.IsZero() {
+		return nil
+	}
+
+	owner, err := k.addressCodec.StringToBytes(link.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, linkedpackets.ModuleName, reward); err != nil {
+		return err
+	}
+
+	link.RelayerReward = reward
+
+	return nil
+}
+
+// settleRelayerReward pays the relayer reward of a resolved link and clears it from the link. The reward is split
+// evenly between the relayers of the packets of the link, with the remainder going to the relayer of the last
+// packet. It is only paid if every packet of the link was acknowledged without breaking the link rules and
+// has a relayer, otherwise it is refunded to the owner.
+func (k Keeper) settleRelayerReward(ctx context.Context, link *linkedpackets.Link) error {
+	if link.RelayerReward.IsZero() {
+		return nil
+	}
+
+	relayers, ok := rewardedRelayers(*link)
+	if !ok {
+		return k.refundRelayerReward(ctx, link)
+	}
+
+	share := sdk.NewCoins(link.RelayerReward.QuoInt(sdkmath.NewInt(int64(len(relayers))))...)
+	paid := sdk.NewCoins()
+	for i, relayer := range relayers {
+		amount := share
+		if i == len(relayers)-1 {
+			amount = link.RelayerReward.Sub(paid...)
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, linkedpackets.ModuleName, relayer, amount); err != nil {
+			return err
+		}
+		paid = paid.Add(amount...)
+
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkRelayerRewarded{
+			LinkId:  link.LinkId,
+			Relayer: relayer.String(),
+			Amount:  amount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	link.RelayerReward = nil
+
+	return nil
+}
+
+// refundRelayerReward refunds the relayer reward of a link to its owner and clears it from the link.
+func (k Keeper) refundRelayerReward(ctx context.Context, link *linkedpackets.Link) error {
+	if link.RelayerReward.IsZero() {
+		return nil
+	}
+
+	owner, err := k.addressCodec.StringToBytes(link.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, linkedpackets.ModuleName, owner, link.RelayerReward); err != nil {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&linkedpackets.EventLinkRelayerRewardRefunded{
+		LinkId: link.LinkId,
+		Owner:  link.Owner,
+		Amount: link.RelayerReward,
+	}); err != nil {
+		return err
+	}
+
+	link.RelayerReward = nil
+
+	return nil
+}
+
+// rewardedRelayers returns the relayers of the packets of the link, ordered by link index. It returns false if
+// a packet was not acknowledged, was rejected by the link rules or has no valid relayer. The relayer addresses
+// may be encoded with the prefix of the receiving chain, so only their bytes are used.
+func rewardedRelayers(link linkedpackets.Link) ([]sdk.AccAddress, bool) {
+	relayers := make([]sdk.AccAddress, len(link.Packets))
+	for i, p := range link.Packets {
+		if p.Outcome != linkedpackets.PacketOutcomeSuccess && p.Outcome != linkedpackets.PacketOutcomeError {
+			return nil, false
+		}
+
+		_, bz, err := bech32.DecodeAndConvert(p.Relayer)
+		if err != nil || len(bz) == 0 {
+			return nil, false
+		}
+		relayers[i] = bz
+	}
+
+	return relayers, len(relayers) > 0
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestRelayerReward(t *testing.T) {
+	testCases := []struct {
+		name        string
+		reward      int64
+		outcomes    []linkedpackets.PacketOutcome
+		relayers    func(f *testFixture) []string
+		expRewards  []int64
+		expRefunded bool
+	}{
+		{
+			"success: reward split between the relayers",
+			10,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeError},
+			func(f *testFixture) []string { return []string{f.addrs[0].String(), f.addrs[2].String()} },
+			[]int64{5, 5},
+			false,
+		},
+		{
+			"success: remainder paid to the relayer of the last packet",
+			11,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeSuccess},
+			func(f *testFixture) []string { return []string{f.addrs[0].String(), f.addrs[2].String()} },
+			[]int64{5, 6},
+			false,
+		},
+		{
+			"success: relayer address of another chain",
+			10,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess},
+			func(f *testFixture) []string { return []string{sdk.MustBech32ifyAddressBytes("osmo", f.addrs[0])} },
+			[]int64{10, 0},
+			false,
+		},
+		{
+			"success: reward refunded if a packet timed out",
+			10,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeTimeout},
+			func(f *testFixture) []string { return []string{f.addrs[0].String(), ""} },
+			[]int64{0, 0},
+			true,
+		},
+		{
+			"success: reward refunded if a packet broke the link",
+			10,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeLinkError},
+			func(f *testFixture) []string { return []string{f.addrs[0].String(), f.addrs[2].String()} },
+			[]int64{0, 0},
+			true,
+		},
+		{
+			"success: reward refunded if a relayer is missing",
+			10,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeSuccess},
+			func(f *testFixture) []string { return []string{f.addrs[0].String(), ""} },
+			[]int64{0, 0},
+			true,
+		},
+		{
+			"success: reward refunded if the link has no packets",
+			10,
+			nil,
+			func(*testFixture) []string { return nil },
+			[]int64{0, 0},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			require := require.New(t)
+
+			owner := f.addrs[1].String()
+			reward := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.reward))
+			f.bankKeeper.balances[owner] = reward
+
+			linkID, err := f.k.StartLink(f.ctx, owner, linkedpackets.LinkOptions{RelayerReward: reward})
+			require.NoError(err)
+			require.True(f.bankKeeper.balances[owner].IsZero())
+
+			for i := range tc.outcomes {
+				require.NoError(f.k.AddLinkPacket(f.ctx, linkID, "transfer", "channel-0", uint64(i+1)))
+			}
+			require.NoError(f.k.EndLink(f.ctx, owner))
+
+			relayers := tc.relayers(f)
+			for i, outcome := range tc.outcomes {
+				require.NoError(f.k.SetPacketRelayer(f.ctx, "transfer", "channel-0", uint64(i+1), relayers[i]))
+				require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", uint64(i+1), outcome))
+			}
+
+			for i, addr := range []sdk.AccAddress{f.addrs[0], f.addrs[2]} {
+				require.Equal(tc.expRewards[i], f.bankKeeper.balances[addr.String()].AmountOf(sdk.DefaultBondDenom).Int64())
+			}
+			if tc.expRefunded {
+				require.Equal(reward, f.bankKeeper.balances[owner])
+			}
+			require.True(f.bankKeeper.balances[authtypes.NewModuleAddress(linkedpackets.ModuleName).String()].IsZero())
+		})
+	}
+}
+
+func TestRelayerRewardInvalid(t *testing.T) {
+	f := initFixture(t)
+
+	_, err := f.k.StartLink(f.ctx, f.addrs[1].String(), linkedpackets.LinkOptions{
+		RelayerReward: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}},
+	})
+	require.ErrorContains(t, err, "invalid relayer reward")
+}
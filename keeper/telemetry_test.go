@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestLinkTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+	})
+
+	f := initFixture(t)
+	require := require.New(t)
+
+	openTime := time.Unix(1_700_000_000, 0).UTC()
+	f.ctx = f.ctx.WithBlockTime(openTime)
+
+	_, err = f.k.StartLink(f.ctx, f.addrs[0].String(), linkedpackets.LinkOptions{LinkID: "mylinkid"})
+	require.NoError(err)
+	require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 1))
+	require.NoError(f.k.AddLinkPacket(f.ctx, "mylinkid", "transfer", "channel-0", 2))
+	require.NoError(f.k.EndLink(f.ctx, f.addrs[0].String()))
+
+	link, err := f.k.Links.Get(f.ctx, "mylinkid")
+	require.NoError(err)
+	require.Equal(openTime, link.OpenTime)
+
+	f.ctx = f.ctx.WithBlockTime(openTime.Add(5 * time.Second))
+	require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 1, linkedpackets.PacketOutcomeSuccess))
+	require.NoError(f.k.SetPacketOutcome(f.ctx, "transfer", "channel-0", 2, linkedpackets.PacketOutcomeTimeout))
+
+	intervals := sink.Data()
+	require.Len(intervals, 1)
+	counters, samples := intervals[0].Counters, intervals[0].Samples
+
+	require.Equal(1, counters["linkedpackets.link.opened"].Count)
+	require.Equal(1, counters["linkedpackets.link.closed"].Count)
+	require.Equal(float64(2), samples["linkedpackets.link.length"].Sum)
+	require.Equal(1, counters["linkedpackets.packet.outcome;source_port=transfer;source_channel=channel-0;outcome=PACKET_OUTCOME_SUCCESS"].Count)
+	require.Equal(1, counters["linkedpackets.packet.outcome;source_port=transfer;source_channel=channel-0;outcome=PACKET_OUTCOME_TIMEOUT"].Count)
+	require.Equal(1, counters["linkedpackets.link.resolved;status=LINK_STATUS_PARTIALLY_FAILED"].Count)
+	require.Equal(float64(5000), samples["linkedpackets.link.completion_time_ms;status=LINK_STATUS_PARTIALLY_FAILED"].Sum)
+}
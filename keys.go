@@ -11,11 +11,23 @@ const (
 	Version = "ics29-1"
 )
 
+// Prefixes 2 to 5 were used by the singleton link session of the v1 store layout, see migrations/v2.
 var (
-	ParamsKey      = collections.NewPrefix(0)
-	LinkEnabledKey = collections.NewPrefix(1)
-	LinkingKey     = collections.NewPrefix(2)
-	PrevPacketKey  = collections.NewPrefix(3)
-	LinkIdKey      = collections.NewPrefix(4)
-	LinkIndexKey   = collections.NewPrefix(5)
+	ParamsKey             = collections.NewPrefix(0)
+	LinkEnabledKey        = collections.NewPrefix(1)
+	LinksKey              = collections.NewPrefix(6)
+	PacketLinkKey         = collections.NewPrefix(7)
+	LinkSeqKey            = collections.NewPrefix(8)
+	ReceivedLinkPacketKey = collections.NewPrefix(9)
+	SessionsKey           = collections.NewPrefix(10)
+	OpenLinkCountsKey     = collections.NewPrefix(11)
+	ForwardedLinkKey      = collections.NewPrefix(12)
+	BufferedLinkKey       = collections.NewPrefix(13)
+	LinkingPausedKey      = collections.NewPrefix(14)
+	PausedChannelsKey     = collections.NewPrefix(15)
+	AccountRateLimitsKey  = collections.NewPrefix(16)
+	ChannelRateLimitsKey  = collections.NewPrefix(17)
+	ResolvedLinksKey      = collections.NewPrefix(18)
+
+	ReceivedLinkPacketByLinkKey = collections.NewPrefix(19)
 )
@@ -0,0 +1,183 @@
+package linkedpackets
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*Link)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*Compensation)(nil)
+)
+
+// LinkOptions defines the options used to start a link.
+type LinkOptions struct {
+	// LinkID is the identifier of the link. If empty, a link identifier is generated.
+	LinkID string
+	// Compensations are the optional compensating interchain account transactions of the link members.
+	Compensations []Compensation
+	// RelayerReward is the optional reward paid to the relayers of the link once every member is acknowledged.
+	RelayerReward sdk.Coins
+	// SrcCallbackAddress is the optional address of the contract called once the link is resolved.
+	SrcCallbackAddress string
+	// DestCallbackAddress is the optional address of the contract called on the receiving chain once the last
+	// packet of the link is received.
+	DestCallbackAddress string
+	// Atomic requests the interchain accounts host to execute the packets of the link together.
+	Atomic bool
+	// AllowedChannels optionally restricts the channels on which the packets of the link can be sent.
+	AllowedChannels []ChannelIdentifier
+	// MaxPackets is the optional maximum number of packets sent as part of the link.
+	MaxPackets uint64
+}
+
+// IsResolved returns true if the link is closed and every one of its packets has an outcome.
+func (l Link) IsResolved() bool {
+	if l.Status != LinkStatusPending {
+		return false
+	}
+
+	for _, p := range l.Packets {
+		if p.Outcome == PacketOutcomePending {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsUnresolved returns true if the link is still open or waits for the outcome of some of its packets. Unresolved
+// links count towards the open links of their owner.
+func (l Link) IsUnresolved() bool {
+	return l.Status == LinkStatusOpen || l.Status == LinkStatusPending
+}
+
+// IsExpired returns true if the link has been open for at least expiryBlocks blocks at the given height. Links
+// never expire if expiryBlocks is zero.
+func (l Link) IsExpired(height, expiryBlocks uint64) bool {
+	return expiryBlocks > 0 && height >= l.OpenHeight+expiryBlocks
+}
+
+// ResolvedStatus returns the final status of the link based on the outcomes of its packets.
+// It should only be called on a resolved link.
+func (l Link) ResolvedStatus() LinkStatus {
+	var succeeded int
+	for _, p := range l.Packets {
+		if p.Outcome == PacketOutcomeSuccess {
+			succeeded++
+		}
+	}
+
+	switch succeeded {
+	case len(l.Packets):
+		return LinkStatusSucceeded
+	case 0:
+		return LinkStatusFailed
+	default:
+		return LinkStatusPartiallyFailed
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (l Link) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, c := range l.Compensations {
+		if err := c.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidatePacket checks that a packet of the given data type can be sent on a channel as the next packet of the
+// link of the session, according to the restrictions of the link.
+func (l Link) ValidatePacket(session LinkSession, dataType, portID, channelID string) error {
+	if len(l.AllowedChannels) > 0 && !slices.Contains(l.AllowedChannels, ChannelIdentifier{PortId: portID, ChannelId: channelID}) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "packets of link %s cannot be sent on %s/%s", l.LinkId, portID, channelID)
+	}
+
+	if l.MaxPackets > 0 && session.LinkIndex >= l.MaxPackets {
+		return errorsmod.Wrapf(ErrLinkLimitExceeded, "link %s already has %d packets", l.LinkId, session.LinkIndex)
+	}
+
+	if l.Atomic {
+		return validateAtomicLinkPacket(session, dataType, portID, channelID)
+	}
+
+	return nil
+}
+
+// validateAtomicLinkPacket checks that a packet sent as part of an atomic link is an interchain accounts packet
+// sent on the channel of the previous packet of the link. The host only executes together the packets of a link
+// received on the same channel.
+func validateAtomicLinkPacket(session LinkSession, dataType, portID, channelID string) error {
+	if dataType != PacketDataTypeICA {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "%s packets cannot be sent on atomic link %s", dataType, session.LinkId)
+	}
+
+	prevPacket := session.PrevPacket
+	if prevPacket != (PacketIdentifier{}) && (prevPacket.PortId != portID || prevPacket.ChannelId != channelID) {
+		return errorsmod.Wrapf(
+			ErrInvalidPacketData, "packets of atomic link %s must be sent on %s/%s", session.LinkId, prevPacket.PortId, prevPacket.ChannelId,
+		)
+	}
+
+	return nil
+}
+
+// ValidateChannels checks that the given channel identifiers are valid and unique.
+func ValidateChannels(channels []ChannelIdentifier) error {
+	seen := make(map[ChannelIdentifier]bool, len(channels))
+	for _, c := range channels {
+		if err := host.PortIdentifierValidator(c.PortId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+			return err
+		}
+
+		if seen[c] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate channel %s/%s", c.PortId, c.ChannelId)
+		}
+		seen[c] = true
+	}
+
+	return nil
+}
+
+// ValidateCompensations checks that every compensation has messages and that no link member has
+// more than one compensation.
+func ValidateCompensations(compensations []Compensation) error {
+	seen := make(map[uint64]bool, len(compensations))
+	for _, c := range compensations {
+		if len(c.Messages) == 0 {
+			return errorsmod.Wrapf(ErrInvalidCompensation, "no messages for link index %d", c.LinkIndex)
+		}
+
+		if seen[c.LinkIndex] {
+			return errorsmod.Wrapf(ErrInvalidCompensation, "duplicate compensation for link index %d", c.LinkIndex)
+		}
+		seen[c.LinkIndex] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c Compensation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range c.Messages {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(msg, &sdkMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/suite"
 
-	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
@@ -16,13 +15,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
-	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
-	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 
 	"github.com/srdtrk/linkedpackets"
 	"github.com/srdtrk/linkedpackets/simapp"
+	"github.com/srdtrk/linkedpackets/testutil"
 )
 
 func init() {
@@ -33,6 +31,7 @@ func init() {
 func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
 	db := dbm.NewMemDB()
 	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{})
+	app.SetContractKeeper(simapp.NewMockContractKeeper())
 	return app, app.DefaultGenesis()
 }
 
@@ -46,6 +45,15 @@ func GetSimApp(chain *ibctesting.TestChain) *simapp.SimApp {
 	return app
 }
 
+// GetMockContractKeeper returns the mock contract keeper set on the chain by SetupTestingApp.
+func GetMockContractKeeper(chain *ibctesting.TestChain) *simapp.MockContractKeeper {
+	contractKeeper, ok := GetSimApp(chain).GetContractKeeper().(*simapp.MockContractKeeper)
+	if !ok {
+		panic(errors.New("contract keeper is not a simapp.MockContractKeeper"))
+	}
+	return contractKeeper
+}
+
 // LinkedPacketsTestSuite defines the needed instances and methods to test callbacks
 type LinkedPacketsTestSuite struct {
 	suite.Suite
@@ -78,68 +86,48 @@ func (s *LinkedPacketsTestSuite) SetupTransferTest() {
 	s.coordinator.Setup(s.path)
 }
 
-// SetupFeeTransferTest sets up a fee middleware enabled transfer channel between chainA and chainB
+// SetupLinkedPacketsTransferTest sets up a link enabled transfer channel between chainA and chainB
 func (s *LinkedPacketsTestSuite) SetupLinkedPacketsTransferTest() {
 	s.setupChains()
+	s.path = testutil.NewLinkedTransferPath(s.chainA, s.chainB)
 
-	byteVersion, err := json.Marshal(linkedpackets.Metadata{LinkedPacketsVersion: linkedpackets.Version, AppVersion: transfertypes.Version})
-	s.Require().NoError(err)
+	s.coordinator.Setup(s.path)
 
-	linkedTransferVersion := string(byteVersion)
-	s.path.EndpointA.ChannelConfig.Version = linkedTransferVersion
-	s.path.EndpointB.ChannelConfig.Version = linkedTransferVersion
-	s.path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
-	s.path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+	testutil.RequireLinkEnabled(s.T(), s.chainA, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+}
 
-	s.coordinator.Setup(s.path)
+// SetupLinkedPacketsFeeTransferTest sets up a transfer channel between chainA and chainB with both packet
+// linking and the fee middleware enabled.
+func (s *LinkedPacketsTestSuite) SetupLinkedPacketsFeeTransferTest() {
+	s.setupChains()
+	s.path = testutil.NewLinkedFeeTransferPath(s.chainA, s.chainB)
 
-	isEnabled, err := GetSimApp(s.chainA).LinkedPacketsKeeper.LinkEnabled.Has(
-		s.chainA.GetContext(),
-		collections.Join(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID),
-	)
-	s.Require().NoError(err)
-	s.Require().True(isEnabled)
+	s.coordinator.Setup(s.path)
 }
 
 // SetupICATest sets up an interchain accounts channel between chainA (controller) and chainB (host).
 // It funds and returns the interchain account address owned by chainA's SenderAccount.
 func (s *LinkedPacketsTestSuite) SetupICATest() string {
 	s.setupChains()
-	s.coordinator.SetupConnections(s.path)
 
-	icaOwner := s.chainA.SenderAccount.GetAddress().String()
-	defaultIcaVersion := icatypes.NewDefaultMetadataString(s.path.EndpointA.ConnectionID, s.path.EndpointB.ConnectionID)
-	// ICAVersion defines a interchain accounts version string
-	icaVersionBytes, err := json.Marshal(linkedpackets.Metadata{LinkedPacketsVersion: linkedpackets.Version, AppVersion: defaultIcaVersion})
-	s.Require().NoError(err)
-	icaVersion := string(icaVersionBytes)
-	icaControllerPortID, err := icatypes.NewControllerPortID(icaOwner)
+	interchainAccountAddr, err := testutil.SetupLinkedICAPath(s.coordinator, s.path, s.chainA.SenderAccount.GetAddress().String())
 	s.Require().NoError(err)
 
-	s.path.SetChannelOrdered()
-	s.path.EndpointA.ChannelConfig.PortID = icaControllerPortID
-	s.path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
-	s.path.EndpointA.ChannelConfig.Version = icaVersion
-	s.path.EndpointB.ChannelConfig.Version = icaVersion
+	return s.fundInterchainAccount(interchainAccountAddr)
+}
 
-	err = GetSimApp(s.chainA).LinkedPacketsKeeper.LinkEnabled.Set(
-		s.chainA.GetContext(),
-		collections.Join(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID),
-	)
-	s.Require().NoError(err)
+// SetupFeeICATest is like SetupICATest, but the fee middleware is also enabled on the channel.
+func (s *LinkedPacketsTestSuite) SetupFeeICATest() string {
+	s.setupChains()
 
-	s.RegisterInterchainAccount(icaOwner)
-	// open chan init must be skipped. So we cannot use .CreateChannels()
-	err = s.path.EndpointB.ChanOpenTry()
-	s.Require().NoError(err)
-	err = s.path.EndpointA.ChanOpenAck()
-	s.Require().NoError(err)
-	err = s.path.EndpointB.ChanOpenConfirm()
+	interchainAccountAddr, err := testutil.SetupLinkedFeeICAPath(s.coordinator, s.path, s.chainA.SenderAccount.GetAddress().String())
 	s.Require().NoError(err)
 
-	interchainAccountAddr, found := GetSimApp(s.chainB).ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), s.path.EndpointA.ConnectionID, s.path.EndpointA.ChannelConfig.PortID)
-	s.Require().True(found)
+	return s.fundInterchainAccount(interchainAccountAddr)
+}
 
+// fundInterchainAccount funds the given interchain account on chainB and returns its address.
+func (s *LinkedPacketsTestSuite) fundInterchainAccount(interchainAccountAddr string) string {
 	// fund the interchain account on chainB
 	msgBankSend := &banktypes.MsgSend{
 		FromAddress: s.chainB.SenderAccount.GetAddress().String(),
@@ -153,21 +141,6 @@ func (s *LinkedPacketsTestSuite) SetupICATest() string {
 	return interchainAccountAddr
 }
 
-// RegisterInterchainAccount submits a MsgRegisterInterchainAccount and updates the controller endpoint with the
-// channel created.
-func (s *LinkedPacketsTestSuite) RegisterInterchainAccount(owner string) {
-	msgRegister := icacontrollertypes.NewMsgRegisterInterchainAccount(s.path.EndpointA.ConnectionID, owner, s.path.EndpointA.ChannelConfig.Version)
-
-	res, err := s.chainA.SendMsgs(msgRegister)
-	s.Require().NotEmpty(res)
-	s.Require().NoError(err)
-
-	channelID, err := ibctesting.ParseChannelIDFromEvents(res.Events)
-	s.Require().NoError(err)
-
-	s.path.EndpointA.ChannelID = channelID
-}
-
 func (s *LinkedPacketsTestSuite) ExecuteInitLink(linkId string) {
 	msg := linkedpackets.MsgInitLink{
 		Sender: s.chainA.SenderAccount.GetAddress().String(),
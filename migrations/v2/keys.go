@@ -0,0 +1,11 @@
+package v2
+
+import "cosmossdk.io/collections"
+
+// Prefixes of the v1 store layout which are removed by the migration.
+var (
+	LinkingKey    = collections.NewPrefix(2)
+	PrevPacketKey = collections.NewPrefix(3)
+	LinkIdKey     = collections.NewPrefix(4)
+	LinkIndexKey  = collections.NewPrefix(5)
+)
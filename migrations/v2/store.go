@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// v1Store is the part of the v1 store layout which holds the link in progress. Only a single link could be in
+// progress on the whole chain, no matter which account opened it.
+type v1Store struct {
+	Linking    collections.Item[bool]
+	PrevPacket collections.Item[linkedpackets.PacketIdentifier]
+	LinkId     collections.Item[string]
+	LinkIndex  collections.Map[string, uint64]
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. The v2 store layout keys the links in progress
+// by their owner and records every link sent on the chain. The v1 store did not record which account opened the
+// link in progress, so it is given to the owner passed to the migration. The migration:
+//
+//   - opens a link owned by owner for the link in progress, and moves its previous packet and its link index into
+//     the session of owner
+//   - removes the singleton linking flag, previous packet and link identifier
+//   - removes every link index entry
+//
+// A link in progress which has no identifier cannot be keyed and is dropped.
+func MigrateStore(ctx context.Context, storeService storetypes.KVStoreService, cdc codec.BinaryCodec, owner string) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	v1 := v1Store{
+		Linking:    collections.NewItem(sb, LinkingKey, "linking", collections.BoolValue),
+		PrevPacket: collections.NewItem(sb, PrevPacketKey, "prev_packet", codec.CollValue[linkedpackets.PacketIdentifier](cdc)),
+		LinkId:     collections.NewItem(sb, LinkIdKey, "link_id", collections.StringValue),
+		LinkIndex:  collections.NewMap(sb, LinkIndexKey, "link_index", collections.StringKey, collections.Uint64Value),
+	}
+	links := collections.NewMap(sb, linkedpackets.LinksKey, "links", collections.StringKey, codec.CollValue[linkedpackets.Link](cdc))
+	sessions := collections.NewMap(
+		sb, linkedpackets.SessionsKey, "sessions", collections.StringKey, codec.CollValue[linkedpackets.LinkSession](cdc),
+	)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	session, err := migrateSession(ctx, v1, owner)
+	if err != nil {
+		return err
+	}
+	if session != nil {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		link := linkedpackets.Link{
+			LinkId:     session.LinkId,
+			Owner:      session.Owner,
+			Status:     linkedpackets.LinkStatusOpen,
+			OpenTime:   sdkCtx.BlockTime(),
+			OpenHeight: uint64(sdkCtx.BlockHeight()),
+		}
+		if err := links.Set(ctx, link.LinkId, link); err != nil {
+			return err
+		}
+		if err := sessions.Set(ctx, session.Owner, *session); err != nil {
+			return err
+		}
+	}
+
+	if err := v1.Linking.Remove(ctx); err != nil {
+		return err
+	}
+	if err := v1.PrevPacket.Remove(ctx); err != nil {
+		return err
+	}
+	if err := v1.LinkId.Remove(ctx); err != nil {
+		return err
+	}
+
+	return v1.LinkIndex.Clear(ctx, nil)
+}
+
+// migrateSession returns the session of owner for the v1 link in progress, or nil if there is none.
+func migrateSession(ctx context.Context, v1 v1Store, owner string) (*linkedpackets.LinkSession, error) {
+	isLinking, err := v1.Linking.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if !isLinking {
+		return nil, nil
+	}
+
+	linkID, err := v1.LinkId.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if linkID == "" {
+		sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+linkedpackets.ModuleName).Info(
+			"dropping the v1 link in progress without a link identifier",
+		)
+		return nil, nil
+	}
+
+	prevPacket, err := v1.PrevPacket.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	linkIndex, err := v1.LinkIndex.Get(ctx, linkID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &linkedpackets.LinkSession{
+		LinkId:     linkID,
+		PrevPacket: prevPacket,
+		Owner:      owner,
+		LinkIndex:  linkIndex,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/srdtrk/linkedpackets"
+	v2 "github.com/srdtrk/linkedpackets/migrations/v2"
+)
+
+const owner = "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"
+
+func TestMigrateStore(t *testing.T) {
+	prevPacket := linkedpackets.PacketIdentifier{PortId: "transfer", ChannelId: "channel-0", Seq: "2"}
+
+	testCases := []struct {
+		name       string
+		linking    bool
+		linkID     string
+		expSession *linkedpackets.LinkSession
+	}{
+		{
+			"success: link in progress is moved to a link and a session of the owner",
+			true,
+			"mylinkid",
+			&linkedpackets.LinkSession{LinkId: "mylinkid", PrevPacket: prevPacket, Owner: owner, LinkIndex: 2},
+		},
+		{"success: no link in progress", false, "mylinkid", nil},
+		{"success: link in progress without identifier is dropped", true, "", nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cdc := moduletestutil.MakeTestEncodingConfig().Codec
+			key := storetypes.NewKVStoreKey(linkedpackets.ModuleName)
+			ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(10)
+			storeService := runtime.NewKVStoreService(key)
+
+			// write the v1 store layout
+			sb := collections.NewSchemaBuilder(storeService)
+			linking := collections.NewItem(sb, v2.LinkingKey, "linking", collections.BoolValue)
+			prev := collections.NewItem(sb, v2.PrevPacketKey, "prev_packet", codec.CollValue[linkedpackets.PacketIdentifier](cdc))
+			linkID := collections.NewItem(sb, v2.LinkIdKey, "link_id", collections.StringValue)
+			linkIndex := collections.NewMap(sb, v2.LinkIndexKey, "link_index", collections.StringKey, collections.Uint64Value)
+			links := collections.NewMap(sb, linkedpackets.LinksKey, "links", collections.StringKey, codec.CollValue[linkedpackets.Link](cdc))
+			sessions := collections.NewMap(
+				sb, linkedpackets.SessionsKey, "sessions", collections.StringKey, codec.CollValue[linkedpackets.LinkSession](cdc),
+			)
+			_, err := sb.Build()
+			require.NoError(t, err)
+
+			require.NoError(t, linking.Set(ctx, tc.linking))
+			if tc.linking {
+				require.NoError(t, prev.Set(ctx, prevPacket))
+				require.NoError(t, linkID.Set(ctx, tc.linkID))
+				require.NoError(t, linkIndex.Set(ctx, tc.linkID, 2))
+			}
+			// link index entries of other links are dropped
+			require.NoError(t, linkIndex.Set(ctx, "otherlinkid", 1))
+
+			require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, owner))
+
+			var expSessions []linkedpackets.LinkSession
+			var expLinks []linkedpackets.Link
+			if tc.expSession != nil {
+				expSessions = append(expSessions, *tc.expSession)
+				expLinks = append(expLinks, linkedpackets.Link{
+					LinkId:     tc.expSession.LinkId,
+					Owner:      owner,
+					Status:     linkedpackets.LinkStatusOpen,
+					OpenTime:   ctx.BlockTime(),
+					OpenHeight: 10,
+				})
+			}
+
+			var gotSessions []linkedpackets.LinkSession
+			require.NoError(t, sessions.Walk(ctx, nil, func(_ string, session linkedpackets.LinkSession) (bool, error) {
+				gotSessions = append(gotSessions, session)
+				return false, nil
+			}))
+			require.Equal(t, expSessions, gotSessions)
+
+			var gotLinks []linkedpackets.Link
+			require.NoError(t, links.Walk(ctx, nil, func(_ string, link linkedpackets.Link) (bool, error) {
+				gotLinks = append(gotLinks, link)
+				return false, nil
+			}))
+			require.Equal(t, expLinks, gotLinks)
+
+			// the v1 store layout is removed
+			store := storeService.OpenKVStore(ctx)
+			for _, prefix := range []collections.Prefix{v2.LinkingKey, v2.PrevPacketKey, v2.LinkIdKey, v2.LinkIndexKey} {
+				iter, err := store.Iterator(prefix.Bytes(), storetypes.PrefixEndBytes(prefix.Bytes()))
+				require.NoError(t, err)
+				require.False(t, iter.Valid(), "prefix %v is not empty", prefix)
+				require.NoError(t, iter.Close())
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The v2 params had no fields, so decoding them
+// with the v3 layout would disable linking. The migration:
+//
+//   - sets the default params
+//   - counts the unresolved links of every link owner
+func MigrateStore(ctx context.Context, storeService storetypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, linkedpackets.ParamsKey, "params", codec.CollValue[linkedpackets.Params](cdc))
+	links := collections.NewMap(sb, linkedpackets.LinksKey, "links", collections.StringKey, codec.CollValue[linkedpackets.Link](cdc))
+	openLinkCounts := collections.NewMap(
+		sb, linkedpackets.OpenLinkCountsKey, "open_link_counts", collections.StringKey, collections.Uint64Value,
+	)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	if err := params.Set(ctx, linkedpackets.DefaultParams()); err != nil {
+		return err
+	}
+
+	counts := make(map[string]uint64)
+	if err := links.Walk(ctx, nil, func(_ string, link linkedpackets.Link) (bool, error) {
+		if link.Owner != "" && link.IsUnresolved() {
+			counts[link.Owner]++
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for owner, count := range counts {
+		if err := openLinkCounts.Set(ctx, owner, count); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/srdtrk/linkedpackets"
+	v3 "github.com/srdtrk/linkedpackets/migrations/v3"
+)
+
+const (
+	owner      = "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"
+	otherOwner = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	key := storetypes.NewKVStoreKey(linkedpackets.ModuleName)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	storeService := runtime.NewKVStoreService(key)
+
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, linkedpackets.ParamsKey, "params", codec.CollValue[linkedpackets.Params](cdc))
+	links := collections.NewMap(sb, linkedpackets.LinksKey, "links", collections.StringKey, codec.CollValue[linkedpackets.Link](cdc))
+	openLinkCounts := collections.NewMap(
+		sb, linkedpackets.OpenLinkCountsKey, "open_link_counts", collections.StringKey, collections.Uint64Value,
+	)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// write the v2 state: empty params and links in every status
+	require.NoError(t, params.Set(ctx, linkedpackets.Params{}))
+	for _, link := range []linkedpackets.Link{
+		{LinkId: "open", Owner: owner, Status: linkedpackets.LinkStatusOpen},
+		{LinkId: "pending", Owner: owner, Status: linkedpackets.LinkStatusPending},
+		{LinkId: "succeeded", Owner: owner, Status: linkedpackets.LinkStatusSucceeded},
+		{LinkId: "other", Owner: otherOwner, Status: linkedpackets.LinkStatusFailed},
+		{LinkId: "no-owner", Status: linkedpackets.LinkStatusOpen},
+	} {
+		require.NoError(t, links.Set(ctx, link.LinkId, link))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeService, cdc))
+
+	migrated, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, linkedpackets.DefaultParams(), migrated)
+
+	count, err := openLinkCounts.Get(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	found, err := openLinkCounts.Has(ctx, otherOwner)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -12,11 +12,62 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 			Service: linkedpacketsv1.Query_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
-					RpcMethod: "Counter",
-					Use:       "counter [address]",
-					Short:     "Get the current value of the counter for an address",
+					RpcMethod: "LinkEnabledChannel",
+					Use:       "link-enabled-channel [port-id] [channel-id]",
+					Short:     "Query whether packet linking is enabled on a channel",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
-						{ProtoField: "address"},
+						{ProtoField: "port_id"},
+						{ProtoField: "channel_id"},
+					},
+				},
+				{
+					RpcMethod: "LinkEnabledChannels",
+					Use:       "link-enabled-channels",
+					Short:     "Query all the channels on which packet linking is enabled",
+				},
+				{
+					RpcMethod: "Link",
+					Use:       "link [link-id]",
+					Short:     "Query a link by its identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "link_id"},
+					},
+				},
+				{
+					RpcMethod: "Links",
+					Use:       "links",
+					Short:     "Query all the links, optionally filtered by owner, status or channel",
+					Example:   "links --owner cosmos1... --status pending --port-id transfer --channel-id channel-0",
+				},
+				{
+					RpcMethod: "OpenSession",
+					Use:       "open-session [sender]",
+					Short:     "Query the link currently being sent by an address, if any",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+					},
+				},
+				{
+					RpcMethod: "LinkingPaused",
+					Use:       "linking-paused",
+					Short:     "Query whether linking is paused chain-wide or on a channel",
+					Example:   "linking-paused --port-id transfer --channel-id channel-0",
+				},
+				{
+					RpcMethod: "AccountLinkQuota",
+					Use:       "account-link-quota [account]",
+					Short:     "Query the number of links an account can still open in the current rate limit window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "account"},
+					},
+				},
+				{
+					RpcMethod: "ChannelLinkQuota",
+					Use:       "channel-link-quota [port-id] [channel-id]",
+					Short:     "Query the number of linked packets a channel can still send in the current rate limit window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "port_id"},
+						{ProtoField: "channel_id"},
 					},
 				},
 				{
@@ -27,17 +78,48 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service: linkedpacketsv1.Msg_ServiceDesc.ServiceName,
+			Service:              linkedpacketsv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
-					RpcMethod: "IncrementCounter",
-					Use:       "counter [sender]",
-					Short:     "Increments the counter by 1 for the sender",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
-						{ProtoField: "sender"},
-					},
+					RpcMethod: "InitLink",
+					Use:       "init-link",
+					Short:     "Start linking the packets sent by the signer",
+					Long: "Start linking the packets sent by the signer. The link identifier is generated " +
+						"unless it is set with --link-id. Compensating interchain account transactions can be " +
+						"provided as JSON with --compensations. A reward paid to the relayers of the link once " +
+						"every packet is acknowledged can be escrowed with --relayer-reward. Contracts called " +
+						"back once the link is resolved, or once its last packet is received on the receiving " +
+						"chain, can be set with --src-callback-address and --dest-callback-address. An interchain " +
+						"accounts host executes the packets of a link together if --atomic is set. The channels and " +
+						"number of packets of the link can be restricted with --allowed-channels and --max-packets.",
+					Example: "init-link --link-id mylink --relayer-reward 100stake --from mykey",
+				},
+				{
+					RpcMethod: "StopLink",
+					Use:       "stop-link",
+					Short:     "Stop linking the packets sent by the signer",
+					Example:   "stop-link --from mykey",
+				},
+				{
+					// The UpdateParams tx is purposely skipped, the MsgUpdateParams is gov gated.
+					RpcMethod: "UpdateParams",
+					Skip:      true,
+				},
+				{
+					// The ForceCloseLink and ForceFailLink txs are purposely skipped, they are gov gated.
+					RpcMethod: "ForceCloseLink",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ForceFailLink",
+					Skip:      true,
+				},
+				{
+					// The SetLinkingPaused tx is purposely skipped, it is gov gated.
+					RpcMethod: "SetLinkingPaused",
+					Skip:      true,
 				},
-				// The UpdateParams tx is purposely left empty, the MsgUpdateParams is gov gated.
 			},
 		},
 	}
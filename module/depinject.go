@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/srdtrk/linkedpackets"
 	modulev1 "github.com/srdtrk/linkedpackets/api/module/v1"
 	"github.com/srdtrk/linkedpackets/keeper"
 )
@@ -36,6 +37,10 @@ type ModuleInputs struct {
 	AddressCodec address.Codec
 
 	Config *modulev1.Module
+
+	AccountKeeper      linkedpackets.AccountKeeper
+	BankKeeper         linkedpackets.BankKeeper
+	DistributionKeeper linkedpackets.DistributionKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -52,8 +57,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
-	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, authority.String())
-	m := NewAppModule(in.Cdc, k)
+	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, in.BankKeeper, authority.String())
+	if in.DistributionKeeper != nil {
+		k.SetDistributionKeeper(in.DistributionKeeper)
+	}
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{Module: m, Keeper: k}
 }
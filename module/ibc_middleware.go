@@ -3,21 +3,27 @@ package module
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-metrics"
+
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 
 	"github.com/srdtrk/linkedpackets"
 	"github.com/srdtrk/linkedpackets/keeper"
@@ -32,6 +38,12 @@ type IBCMiddleware struct {
 	keeper keeper.Keeper
 }
 
+// feeKeeper defines the ICS29 fee keeper functions used if the middleware wraps an ICS29 fee middleware.
+type feeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	GetCounterpartyPayeeAddress(ctx sdk.Context, address, channelID string) (string, bool)
+}
+
 // NewIBCMiddleware creates a new IBCMiddlware given the keeper and underlying application
 func NewIBCMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) IBCMiddleware {
 	if app == nil {
@@ -105,6 +117,11 @@ func (im IBCMiddleware) OnChanOpenInit(
 		return "", err
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkChannelEnabled{PortId: portID, ChannelId: channelID})
+	if err != nil {
+		return "", err
+	}
+
 	// call underlying app's OnChanOpenInit callback with the appVersion
 	return string(versionBytes), nil
 }
@@ -139,6 +156,11 @@ func (im IBCMiddleware) OnChanOpenTry(
 		return "", err
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkChannelEnabled{PortId: portID, ChannelId: channelID})
+	if err != nil {
+		return "", err
+	}
+
 	// call underlying app's OnChanOpenTry callback with the app versions
 	appVersion, err := im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, versionMetadata.AppVersion)
 	if err != nil {
@@ -217,24 +239,263 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 }
 
 // OnRecvPacket implements the IBCMiddleware interface.
+// On link enabled channels, the acknowledgement of the underlying application is wrapped in a link acknowledgement.
+// Linked packets which break the link rules are rejected without calling the underlying application.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	isLinkEnabled, err := im.keeper.LinkEnabled.Has(ctx, collections.Join(packet.DestinationPort, packet.DestinationChannel))
+	if err != nil || !isLinkEnabled {
+		// call underlying app's OnRecvPacket callback.
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	linkData, isLinked := im.getLinkData(packet.Data)
+	appCtx := ctx
+	var receivedLink *keeper.ReceivedLink
+	if isLinked {
+		if err := im.validateLinkingNotPaused(ctx, packet.DestinationPort, packet.DestinationChannel); err != nil {
+			return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodePolicyViolation, err)
+		}
+
+		if err := im.keeper.VerifyLinkPacket(ctx, packet, linkData); err != nil {
+			return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeBrokenChain, err)
+		}
+
+		if linkData.Atomic {
+			if !im.keeper.IsAtomicExecutionEnabled(packet.DestinationPort) {
+				err := errorsmod.Wrapf(linkedpackets.ErrLinkExecutionFailed, "atomic links are not supported on port %s", packet.DestinationPort)
+				return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodePolicyViolation, err)
+			}
+
+			return im.onRecvAtomicLinkPacket(ctx, packet, relayer, linkData)
+		}
+
+		// the link is carried to the next chain by the hop sent if the packet has forward instructions
+		if im.hasMemoKey(packet.Data, linkedpackets.ForwardMemoKey) {
+			appCtx, receivedLink = keeper.WithReceivedLink(ctx, packet, linkData)
+		}
+	}
+
 	// call underlying app's OnRecvPacket callback.
-	return im.app.OnRecvPacket(ctx, packet, relayer)
+	ack := im.app.OnRecvPacket(appCtx, packet, relayer)
+	isForwarded := receivedLink != nil && receivedLink.Forwarded
+	if ack == nil && !isForwarded {
+		// the acknowledgement is wrapped when it is written asynchronously
+		return nil
+	}
+
+	if ack != nil && (!ack.Success() || !isLinked) {
+		return im.newLinkAcknowledgement(ctx, packet, relayer, ack)
+	}
+
+	if err := im.keeper.SetLinkPacketReceived(ctx, packet, linkData); err != nil {
+		return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeBrokenChain, err)
+	}
+
+	err = im.keeper.Hooks().AfterLinkPacketReceived(ctx, linkedpackets.PacketIdentifier{
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Seq:       strconv.FormatUint(packet.Sequence, 10),
+	}, linkData)
+	if err != nil {
+		return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodePolicyViolation, err)
+	}
+
+	if isForwarded {
+		// the link completes on the chain the packet is forwarded to, the packet is usually acknowledged
+		// asynchronously once its hop is
+		if ack == nil {
+			return nil
+		}
+
+		return im.newLinkAcknowledgement(ctx, packet, relayer, ack)
+	}
+
+	if err := im.keeper.ExecuteLinkReceivedCallback(ctx, packet, linkData); err != nil {
+		return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodePolicyViolation, err)
+	}
+
+	return im.newLinkAcknowledgement(ctx, packet, relayer, ack)
+}
+
+// onRecvAtomicLinkPacket buffers a packet of an atomic link received on the interchain accounts host port until the
+// last packet of its link is received. The packets of the link are then executed in a single cache context, and are all
+// given an error acknowledgement if any of them fails.
+func (im IBCMiddleware) onRecvAtomicLinkPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	linkData linkedpackets.LinkData,
+) ibcexported.Acknowledgement {
+	if !linkData.IsLastPacket {
+		if err := im.setLinkPacketReceived(ctx, packet, linkData); err != nil {
+			return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodePolicyViolation, err)
+		}
+
+		if err := im.keeper.BufferLinkPacket(ctx, keeper.BufferedPacket{Packet: packet, Relayer: relayer}, linkData); err != nil {
+			return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeExecutionFailed, err)
+		}
+
+		// the packet is acknowledged once the last packet of its link is executed
+		return nil
+	}
+
+	packets, err := im.keeper.TakeBufferedLinkPackets(ctx, packet.DestinationPort, packet.DestinationChannel, linkData.LinkID)
+	if err != nil {
+		return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeExecutionFailed, err)
+	}
+	packets = append(packets, keeper.BufferedPacket{Packet: packet, Relayer: relayer})
+
+	event := &linkedpackets.EventLinkExecuted{LinkId: linkData.LinkID}
+	for _, p := range packets {
+		event.Packets = append(event.Packets, linkedpackets.PacketIdentifier{
+			PortId:    p.Packet.DestinationPort,
+			ChannelId: p.Packet.DestinationChannel,
+			Seq:       strconv.FormatUint(p.Packet.Sequence, 10),
+		})
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	acks, err := im.executeLinkPackets(cacheCtx, packets, linkData)
+	if err != nil {
+		// the error acknowledgements are written rather than returned, since the state changes of a packet
+		// given an error acknowledgement are discarded, including the acknowledgements of the buffered packets
+		for _, p := range packets {
+			ack := im.newLinkErrorAcknowledgement(ctx, p.Packet, p.Relayer, linkedpackets.LinkAckCodeExecutionFailed, err)
+			if err := im.keeper.WriteBufferedAcknowledgement(ctx, p.Packet, ack); err != nil {
+				return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeExecutionFailed, err)
+			}
+		}
+
+		event.Error = err.Error()
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			im.keeper.Logger(ctx).Error("failed to emit link executed event", "link_id", linkData.LinkID, "error", err)
+		}
+
+		return nil
+	}
+
+	writeCache()
+
+	last := len(packets) - 1
+	for i, p := range packets[:last] {
+		if err := im.keeper.WriteBufferedAcknowledgement(ctx, p.Packet, im.newLinkAcknowledgement(ctx, p.Packet, p.Relayer, acks[i])); err != nil {
+			return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeExecutionFailed, err)
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return im.newLinkErrorAcknowledgement(ctx, packet, relayer, linkedpackets.LinkAckCodeExecutionFailed, err)
+	}
+
+	return im.newLinkAcknowledgement(ctx, packet, relayer, acks[last])
+}
+
+// executeLinkPackets executes the packets of a link with the underlying application, the last of which completes
+// the link. It returns the acknowledgements of the packets, or an error if any of them fails.
+func (im IBCMiddleware) executeLinkPackets(
+	ctx sdk.Context,
+	packets []keeper.BufferedPacket,
+	linkData linkedpackets.LinkData,
+) ([]ibcexported.Acknowledgement, error) {
+	acks := make([]ibcexported.Acknowledgement, len(packets))
+	for i, p := range packets {
+		ack := im.app.OnRecvPacket(ctx, p.Packet, p.Relayer)
+		if ack == nil || !ack.Success() {
+			return nil, errorsmod.Wrapf(
+				linkedpackets.ErrLinkExecutionFailed, "packet %d of link %s failed", p.Packet.Sequence, linkData.LinkID,
+			)
+		}
+
+		acks[i] = ack
+	}
+
+	last := packets[len(packets)-1].Packet
+	if err := im.setLinkPacketReceived(ctx, last, linkData); err != nil {
+		return nil, err
+	}
+
+	if err := im.keeper.ExecuteLinkReceivedCallback(ctx, last, linkData); err != nil {
+		return nil, err
+	}
+
+	return acks, nil
+}
+
+// setLinkPacketReceived records a received linked packet and calls the hooks of the received packet.
+func (im IBCMiddleware) setLinkPacketReceived(ctx sdk.Context, packet channeltypes.Packet, linkData linkedpackets.LinkData) error {
+	if err := im.keeper.SetLinkPacketReceived(ctx, packet, linkData); err != nil {
+		return err
+	}
+
+	return im.keeper.Hooks().AfterLinkPacketReceived(ctx, linkedpackets.PacketIdentifier{
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Seq:       strconv.FormatUint(packet.Sequence, 10),
+	}, linkData)
 }
 
 // OnAcknowledgementPacket implements the IBCMiddleware interface
+// On link enabled channels, the link acknowledgement is unwrapped and the underlying application is given its
+// original acknowledgement. Acknowledgements which are not link acknowledgements are given as is.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	isLinkEnabled, err := im.keeper.LinkEnabled.Has(ctx, collections.Join(packet.SourcePort, packet.SourceChannel))
+	if err != nil {
+		return err
+	}
+
+	linkCode := linkedpackets.LinkAckCodeOK
+	if isLinkEnabled {
+		// a counterparty which does not link packets on the channel acknowledges them with a plain app
+		// acknowledgement, which is passed as is to the underlying app
+		if linkAck, err := linkedpackets.LinkAcknowledgementFromBytes(acknowledgement); err == nil {
+			acknowledgement = linkAck.AppAcknowledgement
+			linkCode = linkAck.Code
+
+			err = im.keeper.SetPacketRelayer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, linkAck.Relayer)
+			if err != nil {
+				return err
+			}
+			if linkCode != linkedpackets.LinkAckCodeOK {
+				err = ctx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkAcknowledgement{
+					Packet: linkedpackets.PacketIdentifier{
+						PortId:    packet.SourcePort,
+						ChannelId: packet.SourceChannel,
+						Seq:       strconv.FormatUint(packet.Sequence, 10),
+					},
+					Code:   linkCode,
+					Reason: linkAck.Reason,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	// call underlying app's OnAcknowledgementPacket callback.
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	err = im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err != nil {
+		return err
+	}
+
+	outcome := linkedpackets.PacketOutcomeError
+	switch {
+	case linkCode != linkedpackets.LinkAckCodeOK:
+		outcome = linkedpackets.PacketOutcomeLinkError
+	case isSuccessAcknowledgement(acknowledgement):
+		outcome = linkedpackets.PacketOutcomeSuccess
+	}
+
+	return im.keeper.SetPacketOutcome(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, outcome)
 }
 
 // OnTimeoutPacket implements the IBCMiddleware interface
@@ -245,7 +506,12 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	relayer sdk.AccAddress,
 ) error {
 	// call underlying app's OnTimeoutPacket callback.
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	err := im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.SetPacketOutcome(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, linkedpackets.PacketOutcomeTimeout)
 }
 
 // SendPacket implements the ICS4 Wrapper interface
@@ -258,8 +524,7 @@ func (im IBCMiddleware) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (uint64, error) {
-	isLinking, err := im.keeper.Linking.Get(ctx)
-	if err != nil || !isLinking {
+	if keeper.IsLinkingSkipped(ctx) {
 		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
@@ -279,101 +544,113 @@ func (im IBCMiddleware) SendPacket(
 		return 0, err
 	}
 
-	linkId, err := im.keeper.LinkId.Get(ctx)
-	if err != nil {
-		return 0, err
+	// The packet is linked if its sender has a link in progress. The sender of an interchain account
+	// packet is the owner encoded in the controller port. Packets of other applications are never linked.
+	var sender, memo, dataType string
+	switch packetData := packetData.(type) {
+	case transfertypes.FungibleTokenPacketData:
+		sender, memo, dataType = packetData.Sender, packetData.Memo, linkedpackets.PacketDataTypeTransfer
+	case icatypes.InterchainAccountPacketData:
+		sender, memo, dataType = strings.TrimPrefix(sourcePort, icatypes.ControllerPortPrefix), packetData.Memo, linkedpackets.PacketDataTypeICA
+	default:
+		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
-	prevPacket, err := im.keeper.PrevPacket.Get(ctx)
-	if err != nil {
-		prevPacket = linkedpackets.PacketIdentifier{}
+	// a transfer sent while a linked packet with forward instructions is received is the hop forwarding it
+	if receivedLink, ok := keeper.GetReceivedLink(ctx); ok && dataType == linkedpackets.PacketDataTypeTransfer {
+		return im.sendLinkHop(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, memo, receivedLink)
 	}
 
-	linkIndex, err := im.keeper.LinkIndex.Get(ctx, linkId)
-	if err != nil {
-		linkIndex = 0
-	}
-
-	var newData []byte
-	var isLastPacket bool
-	if transferPacket, ok := packetData.(transfertypes.FungibleTokenPacketData); ok {
-		isLastPacket = strings.Contains(transferPacket.Memo, linkedpackets.LastLinkMemoKey)
-		linkData := linkedpackets.LinkData{
-			LinkID:         linkId,
-			PrevPacket:     prevPacket,
-			IsLastPacket:   isLastPacket,
-			IsInitalPacket: prevPacket == linkedpackets.PacketIdentifier{},
-			LinkIndex:      strconv.FormatUint(linkIndex, 10),
-		}
+	session, err := im.keeper.Sessions.Get(ctx, sender)
+	if errors.Is(err, collections.ErrNotFound) {
+		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	} else if err != nil {
+		return 0, err
+	}
 
-		linkDataBytes, err := json.Marshal(linkData)
-		if err != nil {
-			return 0, err
-		}
+	if err := im.validateLinkingNotPaused(ctx, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
 
-		linkDataMemo := string(linkDataBytes)
-		transferPacket.Memo = linkDataMemo
+	if err := im.keeper.ValidateLinkPacket(ctx, session, dataType); err != nil {
+		return 0, err
+	}
 
-		newData = transferPacket.GetBytes()
-	} else if icaPacket, ok := packetData.(icatypes.InterchainAccountPacketData); ok {
-		isLastPacket = strings.Contains(icaPacket.Memo, linkedpackets.LastLinkMemoKey)
+	link, err := im.keeper.Links.Get(ctx, session.LinkId)
+	if err != nil {
+		return 0, errorsmod.Wrapf(linkedpackets.ErrLinkNotFound, "link %s: %v", session.LinkId, err)
+	}
 
-		linkData := linkedpackets.LinkData{
-			LinkID:         linkId,
-			PrevPacket:     prevPacket,
-			IsLastPacket:   isLastPacket,
-			IsInitalPacket: prevPacket == linkedpackets.PacketIdentifier{},
-		}
+	if err := link.ValidatePacket(session, dataType, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
 
-		linkDataBytes, err := json.Marshal(linkData)
-		if err != nil {
-			return 0, err
-		}
+	if err := im.keeper.ConsumeChannelLinkQuota(ctx, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
 
-		linkDataMemo := string(linkDataBytes)
-		icaPacket.Memo = linkDataMemo
+	isLastPacket := strings.Contains(memo, linkedpackets.LastLinkMemoKey)
+	linkData := linkedpackets.LinkData{
+		LinkID:              session.LinkId,
+		PrevPacket:          session.PrevPacket,
+		IsLastPacket:        isLastPacket,
+		IsInitalPacket:      session.PrevPacket == linkedpackets.PacketIdentifier{},
+		LinkIndex:           strconv.FormatUint(session.LinkIndex, 10),
+		DestCallbackAddress: link.DestCallbackAddress,
+		Atomic:              link.Atomic,
+	}
 
-		newData = icaPacket.GetBytes()
-	} else {
-		return 0, errorsmod.Wrapf(linkedpackets.ErrInvalidPacketData, "packet data type: %T", packetData)
+	memo, err = linkMemo(memo, linkData)
+	if err != nil {
+		return 0, err
 	}
 
+	newData := packetDataWithMemo(packetData, memo)
 	seq, err := im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, newData)
 	if err != nil {
 		return 0, err
 	}
 
-	if isLastPacket {
-		err = im.keeper.PrevPacket.Remove(ctx)
-		if err != nil {
-			return 0, err
-		}
+	err = im.keeper.AddLinkPacket(ctx, session.LinkId, sourcePort, sourceChannel, seq)
+	if err != nil {
+		return 0, err
+	}
 
-		err = im.keeper.LinkId.Remove(ctx)
-		if err != nil {
-			return 0, err
-		}
+	packet := linkedpackets.PacketIdentifier{
+		PortId:    sourcePort,
+		ChannelId: sourceChannel,
+		Seq:       strconv.FormatUint(seq, 10),
+	}
+	err = ctx.EventManager().EmitTypedEvent(&linkedpackets.EventLinkPacketSent{
+		LinkId:     session.LinkId,
+		LinkIndex:  session.LinkIndex,
+		Packet:     packet,
+		PrevPacket: session.PrevPacket,
+		LastPacket: isLastPacket,
+	})
+	if err != nil {
+		return 0, err
+	}
 
-		err = im.keeper.Linking.Set(ctx, false)
-		if err != nil {
-			return 0, err
-		}
+	telemetry.IncrCounterWithLabels(
+		[]string{linkedpackets.ModuleName, linkedpackets.MetricKeyPacket, linkedpackets.MetricKeySent},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(coretypes.LabelSourcePort, sourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, sourceChannel),
+		},
+	)
 
-		err = im.keeper.LinkIndex.Remove(ctx, linkId)
+	if isLastPacket {
+		err = im.keeper.EndLink(ctx, sender)
 		if err != nil {
 			return 0, err
 		}
 	} else {
-		err = im.keeper.PrevPacket.Set(ctx, linkedpackets.PacketIdentifier{
-			PortId:    sourcePort,
-			ChannelId: sourceChannel,
-			Seq:       strconv.FormatUint(seq, 10),
-		})
-		if err != nil {
-			return 0, err
-		}
+		session.PrevPacket = packet
+		session.LinkIndex++
 
-		err = im.keeper.LinkIndex.Set(ctx, linkId, linkIndex+1)
+		err = im.keeper.Sessions.Set(ctx, sender, session)
 		if err != nil {
 			return 0, err
 		}
@@ -382,6 +659,68 @@ func (im IBCMiddleware) SendPacket(
 	return seq, nil
 }
 
+// sendLinkHop sends the hop forwarding a received linked packet to the next chain. Its link data is relinked to
+// the previous hop of the link sent by this chain, so that the next chain can enforce the link.
+func (im IBCMiddleware) sendLinkHop(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	packetData interface{},
+	memo string,
+	receivedLink *keeper.ReceivedLink,
+) (uint64, error) {
+	if err := im.validateLinkingNotPaused(ctx, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
+
+	if err := im.keeper.ConsumeChannelLinkQuota(ctx, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
+
+	linkData, err := im.keeper.ForwardLinkData(ctx, receivedLink)
+	if err != nil {
+		return 0, err
+	}
+
+	memo, err = linkMemo(memo, linkData)
+	if err != nil {
+		return 0, err
+	}
+
+	newData := packetDataWithMemo(packetData, memo)
+	seq, err := im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, newData)
+	if err != nil {
+		return 0, err
+	}
+
+	hop := linkedpackets.PacketIdentifier{
+		PortId:    sourcePort,
+		ChannelId: sourceChannel,
+		Seq:       strconv.FormatUint(seq, 10),
+	}
+	if err := im.keeper.SetLinkPacketForwarded(ctx, receivedLink, linkData, hop); err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// validateLinkingNotPaused returns an error if linking is paused chain-wide or on the given channel.
+func (im IBCMiddleware) validateLinkingNotPaused(ctx sdk.Context, portID, channelID string) error {
+	paused, err := im.keeper.IsLinkingPaused(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return errorsmod.Wrapf(linkedpackets.ErrLinkingPaused, "channel %s/%s", portID, channelID)
+	}
+
+	return nil
+}
+
 // WriteAcknowledgement implements the ICS4 Wrapper interface
 func (im IBCMiddleware) WriteAcknowledgement(
 	ctx sdk.Context,
@@ -389,10 +728,219 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	packet ibcexported.PacketI,
 	ack ibcexported.Acknowledgement,
 ) error {
+	isLinkEnabled, err := im.keeper.LinkEnabled.Has(ctx, collections.Join(packet.GetDestPort(), packet.GetDestChannel()))
+	if err != nil {
+		return err
+	}
+
+	// NOTE: asynchronous acknowledgements are wrapped before the lower middlewares in the stack wrap them, so a
+	// link enabled channel should not also be incentivized if the underlying application acknowledges asynchronously.
+	if isLinkEnabled {
+		ack = linkedpackets.NewLinkAcknowledgement(ack)
+	}
+
 	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
 }
 
+// newLinkErrorAcknowledgement returns a link acknowledgement for a packet rejected by the link rules. The error
+// acknowledgement given to the sending application is incentivized if fees are enabled on the channel, as it
+// would be if the packet had been passed to the lower middlewares in the stack.
+func (im IBCMiddleware) newLinkErrorAcknowledgement(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	code linkedpackets.LinkAckCode,
+	err error,
+) linkedpackets.LinkAcknowledgement {
+	telemetry.IncrCounterWithLabels(
+		[]string{linkedpackets.ModuleName, linkedpackets.MetricKeyPacket, linkedpackets.MetricKeyRejected},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
+			telemetry.NewLabel(linkedpackets.LabelLinkCode, code.String()),
+		},
+	)
+
+	var appAck ibcexported.Acknowledgement = channeltypes.NewErrorAcknowledgement(err)
+	if feeKeeper, ok := im.ics4Wrapper.(feeKeeper); ok && feeKeeper.IsFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel) {
+		// if forwardRelayer is not found the recv_fee is refunded
+		forwardRelayer, _ := feeKeeper.GetCounterpartyPayeeAddress(ctx, relayer.String(), packet.DestinationChannel)
+		appAck = ibcfeetypes.NewIncentivizedAcknowledgement(forwardRelayer, appAck.Acknowledgement(), false)
+	}
+
+	linkAck := linkedpackets.NewLinkErrorAcknowledgement(code, err, appAck)
+	linkAck.Relayer = im.linkRelayer(ctx, packet, relayer)
+
+	return linkAck
+}
+
+// newLinkAcknowledgement wraps the acknowledgement of the underlying application in a link acknowledgement which
+// records the relayer of the packet.
+func (im IBCMiddleware) newLinkAcknowledgement(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	ack ibcexported.Acknowledgement,
+) linkedpackets.LinkAcknowledgement {
+	linkAck := linkedpackets.NewLinkAcknowledgement(ack)
+	linkAck.Relayer = im.linkRelayer(ctx, packet, relayer)
+
+	return linkAck
+}
+
+// linkRelayer returns the address rewarded for relaying a received packet. The forward relayer address registered
+// with the ICS29 fee middleware is preferred, since it is an address on the sending chain.
+func (im IBCMiddleware) linkRelayer(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) string {
+	if len(relayer) == 0 {
+		return ""
+	}
+
+	if feeKeeper, ok := im.ics4Wrapper.(feeKeeper); ok && feeKeeper.IsFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel) {
+		if forwardRelayer, found := feeKeeper.GetCounterpartyPayeeAddress(ctx, relayer.String(), packet.DestinationChannel); found {
+			return forwardRelayer
+		}
+	}
+
+	return relayer.String()
+}
+
+// UnmarshalPacketData defers to the underlying application, so that middlewares wrapping this middleware, such as
+// the ibc-go callbacks middleware, can read the packet data.
+func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	return im.app.(porttypes.PacketDataUnmarshaler).UnmarshalPacketData(bz)
+}
+
 // GetAppVersion returns the application version of the underlying application
 func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
-	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	version, found := im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return "", false
+	}
+
+	// the underlying application expects its own version if the channel version is wrapped
+	metadata, err := linkedpackets.MetadataFromVersion(version)
+	if err != nil {
+		return version, true
+	}
+
+	return metadata.AppVersion, true
+}
+
+// isSuccessAcknowledgement returns true if the acknowledgement bytes represent a successful
+// acknowledgement. Both plain channel acknowledgements and ICS29 incentivized acknowledgements
+// are supported, any other acknowledgement is considered an error acknowledgement.
+func isSuccessAcknowledgement(acknowledgement []byte) bool {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		return ack.Success()
+	}
+
+	var incentivizedAck ibcfeetypes.IncentivizedAcknowledgement
+	if err := json.Unmarshal(acknowledgement, &incentivizedAck); err == nil {
+		return incentivizedAck.Success()
+	}
+
+	return false
+}
+
+// packetDataWithMemo returns the bytes of the transfer or interchain accounts packet data with the given memo.
+func packetDataWithMemo(packetData interface{}, memo string) []byte {
+	switch packetData := packetData.(type) {
+	case transfertypes.FungibleTokenPacketData:
+		packetData.Memo = memo
+		return packetData.GetBytes()
+	case icatypes.InterchainAccountPacketData:
+		packetData.Memo = memo
+		return packetData.GetBytes()
+	default:
+		panic(fmt.Errorf("unexpected packet data type %T", packetData))
+	}
+}
+
+// linkMemo returns the memo of a linked packet. If the memo of the sender is a JSON object, the link data is
+// merged into it so that the keys of other middlewares are kept, e.g. the src_callback and dest_callback keys
+// of the ibc-go callbacks middleware. Any other memo is replaced by the link data.
+func linkMemo(memo string, linkData linkedpackets.LinkData) (string, error) {
+	linkDataBytes, err := json.Marshal(linkData)
+	if err != nil {
+		return "", err
+	}
+
+	var memoFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoFields); err != nil || memoFields == nil {
+		return string(linkDataBytes), nil
+	}
+
+	var linkFields map[string]json.RawMessage
+	if err := json.Unmarshal(linkDataBytes, &linkFields); err != nil {
+		return "", err
+	}
+
+	// the last link marker of the sender is replaced by the last packet field of the link data
+	delete(memoFields, linkedpackets.LastLinkMemoKey)
+	for key, value := range linkFields {
+		memoFields[key] = value
+	}
+
+	// map keys are sorted when marshaled, so the memo is deterministic
+	bz, err := json.Marshal(memoFields)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// getLinkData returns the link data embedded in the memo of the given packet data, if any.
+func (im IBCMiddleware) getLinkData(data []byte) (linkedpackets.LinkData, bool) {
+	memo, ok := im.getMemo(data)
+	if !ok {
+		return linkedpackets.LinkData{}, false
+	}
+
+	var linkData linkedpackets.LinkData
+	if err := json.Unmarshal([]byte(memo), &linkData); err != nil || linkData.LinkID == "" {
+		return linkedpackets.LinkData{}, false
+	}
+
+	return linkData, true
+}
+
+// hasMemoKey returns true if the memo of the given packet data is a JSON object with the given key.
+func (im IBCMiddleware) hasMemoKey(data []byte, key string) bool {
+	memo, ok := im.getMemo(data)
+	if !ok {
+		return false
+	}
+
+	var memoFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoFields); err != nil {
+		return false
+	}
+
+	_, ok = memoFields[key]
+	return ok
+}
+
+// getMemo returns the memo of the given packet data if it is transfer or interchain accounts packet data.
+func (im IBCMiddleware) getMemo(data []byte) (string, bool) {
+	packetDataUnmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return "", false
+	}
+
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(data)
+	if err != nil {
+		return "", false
+	}
+
+	switch packetData := packetData.(type) {
+	case transfertypes.FungibleTokenPacketData:
+		return packetData.Memo, true
+	case icatypes.InterchainAccountPacketData:
+		return packetData.Memo, true
+	default:
+		return "", false
+	}
 }
@@ -7,36 +7,51 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/client/cli"
 	"github.com/srdtrk/linkedpackets/keeper"
+	"github.com/srdtrk/linkedpackets/simulation"
 )
 
 var (
-	_ module.AppModuleBasic = AppModule{}
-	_ module.HasGenesis     = AppModule{}
-	_ appmodule.AppModule   = AppModule{}
+	_ module.AppModuleBasic      = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 3
 
 type AppModule struct {
 	cdc    codec.Codec
 	keeper keeper.Keeper
+
+	// accountKeeper and bankKeeper are only used for simulations
+	accountKeeper linkedpackets.AccountKeeper
+	bankKeeper    linkedpackets.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+func NewAppModule(
+	cdc codec.Codec, keeper keeper.Keeper, accountKeeper linkedpackets.AccountKeeper, bankKeeper linkedpackets.BankKeeper,
+) AppModule {
 	return AppModule{
-		cdc:    cdc,
-		keeper: keeper,
+		cdc:           cdc,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
@@ -59,6 +74,12 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	}
 }
 
+// GetTxCmd returns the custom transaction commands of the linkedpackets module.
+// The commands described by AutoCLIOptions are added to it.
+func (AppModule) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
 // RegisterInterfaces registers interfaces and implementations of the linkedpackets module.
 func (AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	linkedpackets.RegisterInterfaces(registry)
@@ -67,16 +88,33 @@ func (AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock implements appmodule.HasEndBlocker. It expires the links in progress which are open for too long,
+// prunes the links resolved before the retention period and the usages of the rate limit windows which are over.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.ExpireLinks(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.PruneResolvedLinks(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.PruneRateLimits(ctx)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	linkedpackets.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	linkedpackets.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
 	// Register in place module state migration migrations
-	// m := keeper.NewMigrator(am.keeper)
-	// if err := cfg.RegisterMigration(linkedpackets.ModuleName, 1, m.Migrate1to2); err != nil {
-	// 	panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", linkedpackets.ModuleName, err))
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(linkedpackets.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", linkedpackets.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(linkedpackets.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", linkedpackets.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the module.
@@ -115,3 +153,27 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 	return cdc.MustMarshalJSON(gs)
 }
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the linkedpackets module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
+}
+
+// RegisterStoreDecoder registers a decoder for the linkedpackets module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[linkedpackets.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
+
+// WeightedOperations returns all the linkedpackets module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper,
+	)
+}
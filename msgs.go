@@ -0,0 +1,16 @@
+package linkedpackets
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+var _ codectypes.UnpackInterfacesMessage = (*MsgInitLink)(nil)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgInitLink) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, c := range msg.Compensations {
+		if err := c.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,14 +1,110 @@
 package linkedpackets
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Packet data types which can be sent as part of a link.
+const (
+	// PacketDataTypeTransfer is the ICS-20 fungible token transfer packet data type.
+	PacketDataTypeTransfer = "transfer"
+	// PacketDataTypeICA is the ICS-27 interchain accounts packet data type.
+	PacketDataTypeICA = "ica"
+)
+
+// Default parameter values.
+const (
+	DefaultMaxLinkPackets           uint64 = 100
+	DefaultMaxOpenLinksPerAccount   uint64 = 10
+	DefaultRateLimitWindowBlocks    uint64 = 100
+	DefaultMaxLinksPerAccountWindow uint64 = 10
+	// DefaultResolvedLinkRetentionBlocks keeps resolved links long enough for relayers and clients to query
+	// their final status.
+	DefaultResolvedLinkRetentionBlocks uint64 = 100
+)
+
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
 	return Params{
-		// Set default values here.
+		LinkingEnabled:         true,
+		MaxLinkPackets:         DefaultMaxLinkPackets,
+		MaxOpenLinksPerAccount: DefaultMaxOpenLinksPerAccount,
+		LinkExpiryBlocks:       0,
+		AllowedPacketDataTypes: []string{PacketDataTypeTransfer, PacketDataTypeICA},
+
+		RateLimitWindowBlocks:    DefaultRateLimitWindowBlocks,
+		MaxLinksPerAccountWindow: DefaultMaxLinksPerAccountWindow,
+
+		ResolvedLinkRetentionBlocks: DefaultResolvedLinkRetentionBlocks,
 	}
 }
 
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
-	// Sanity check goes here.
+	seen := make(map[string]bool, len(p.AllowedPacketDataTypes))
+	for _, dataType := range p.AllowedPacketDataTypes {
+		if dataType != PacketDataTypeTransfer && dataType != PacketDataTypeICA {
+			return errorsmod.Wrapf(ErrInvalidParams, "unknown packet data type %q", dataType)
+		}
+		if seen[dataType] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate packet data type %q", dataType)
+		}
+		seen[dataType] = true
+	}
+
+	if err := p.LinkDeposit.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid link deposit: %v", err)
+	}
+
+	if _, ok := ExpiredDepositAction_name[int32(p.ExpiredDepositAction)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown expired deposit action %d", p.ExpiredDepositAction)
+	}
+
+	if p.RateLimitWindowBlocks == 0 && (p.MaxLinksPerAccountWindow > 0 || p.MaxLinkedPacketsPerChannelWindow > 0) {
+		return errorsmod.Wrap(ErrInvalidParams, "rate limits require a rate limit window")
+	}
+
 	return nil
 }
+
+// IsPacketDataTypeAllowed returns true if packets of the given data type can be sent as part of a link.
+func (p Params) IsPacketDataTypeAllowed(dataType string) bool {
+	for _, allowed := range p.AllowedPacketDataTypes {
+		if allowed == dataType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RateLimitWindow returns the index of the rate limit window of the given block height.
+func (p Params) RateLimitWindow(height int64) uint64 {
+	if p.RateLimitWindowBlocks == 0 {
+		return 0
+	}
+
+	return uint64(height) / p.RateLimitWindowBlocks
+}
+
+// Quota returns the remaining quota of a rate limit with the given limit per window and usage, in the rate
+// limit window of the given block height. The quota is empty if there is no limit.
+func (p Params) Quota(limit uint64, usage RateLimitUsage, height int64) RateLimitQuota {
+	if p.RateLimitWindowBlocks == 0 || limit == 0 {
+		return RateLimitQuota{}
+	}
+
+	window := p.RateLimitWindow(height)
+	quota := RateLimitQuota{
+		Limit:           limit,
+		Remaining:       limit,
+		WindowEndHeight: (window + 1) * p.RateLimitWindowBlocks,
+	}
+	if usage.Window == window && usage.Used < limit {
+		quota.Remaining = limit - usage.Used
+	} else if usage.Window == window {
+		quota.Remaining = 0
+	}
+
+	return quota
+}
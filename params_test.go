@@ -0,0 +1,71 @@
+package linkedpackets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params linkedpackets.Params
+		expErr error
+	}{
+		{"success: default params", linkedpackets.DefaultParams(), nil},
+		{"success: empty params", linkedpackets.Params{}, nil},
+		{
+			"failure: unknown packet data type",
+			linkedpackets.Params{AllowedPacketDataTypes: []string{linkedpackets.PacketDataTypeTransfer, "foo"}},
+			linkedpackets.ErrInvalidParams,
+		},
+		{
+			"failure: duplicate packet data type",
+			linkedpackets.Params{AllowedPacketDataTypes: []string{linkedpackets.PacketDataTypeICA, linkedpackets.PacketDataTypeICA}},
+			linkedpackets.ErrInvalidParams,
+		},
+		{
+			"failure: rate limit without window",
+			linkedpackets.Params{MaxLinkedPacketsPerChannelWindow: 10},
+			linkedpackets.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParamsIsPacketDataTypeAllowed(t *testing.T) {
+	params := linkedpackets.Params{AllowedPacketDataTypes: []string{linkedpackets.PacketDataTypeTransfer}}
+	require.True(t, params.IsPacketDataTypeAllowed(linkedpackets.PacketDataTypeTransfer))
+	require.False(t, params.IsPacketDataTypeAllowed(linkedpackets.PacketDataTypeICA))
+}
+
+func TestParamsQuota(t *testing.T) {
+	params := linkedpackets.Params{RateLimitWindowBlocks: 10}
+
+	// there is no quota without a limit
+	require.Equal(t, linkedpackets.RateLimitQuota{}, params.Quota(0, linkedpackets.RateLimitUsage{}, 15))
+
+	// the usage of a previous window is not counted
+	quota := params.Quota(3, linkedpackets.RateLimitUsage{Window: 0, Used: 3}, 15)
+	require.Equal(t, linkedpackets.RateLimitQuota{Limit: 3, Remaining: 3, WindowEndHeight: 20}, quota)
+
+	quota = params.Quota(3, linkedpackets.RateLimitUsage{Window: 1, Used: 2}, 15)
+	require.Equal(t, linkedpackets.RateLimitQuota{Limit: 3, Remaining: 1, WindowEndHeight: 20}, quota)
+
+	// a usage above the limit, after the limit is lowered, leaves no quota
+	quota = params.Quota(3, linkedpackets.RateLimitUsage{Window: 1, Used: 5}, 19)
+	require.Equal(t, linkedpackets.RateLimitQuota{Limit: 3, Remaining: 0, WindowEndHeight: 20}, quota)
+}
@@ -0,0 +1,92 @@
+package linkedpackets_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func (s *LinkedPacketsTestSuite) TestLinkingPausedOnSend() {
+	testCases := []struct {
+		name  string
+		pause func()
+	}{
+		{
+			"channel paused by the authority",
+			func() {
+				s.Require().NoError(GetSimApp(s.chainA).LinkedPacketsKeeper.SetLinkingPaused(s.chainA.GetContext(), true, []linkedpackets.ChannelIdentifier{
+					{PortId: s.path.EndpointA.ChannelConfig.PortID, ChannelId: s.path.EndpointA.ChannelID},
+				}))
+			},
+		},
+		{
+			"MsgInitLink disabled by the circuit breaker",
+			func() {
+				s.Require().NoError(GetSimApp(s.chainA).CircuitKeeper.DisableList.Set(
+					s.chainA.GetContext(), sdk.MsgTypeURL(&linkedpackets.MsgInitLink{}),
+				))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupLinkedPacketsTransferTest()
+
+			s.ExecuteInitLink("mylinkid")
+			tc.pause()
+
+			// linked packets cannot be sent while linking is paused
+			s.Require().Equal(transfertypes.FungibleTokenPacketData{}, s.ExecuteTransfer(linkedpackets.LastLinkMemoKey))
+			// the failed transaction still increments the sequence of the sender on chainA
+			s.Require().NoError(s.chainA.SenderAccount.SetSequence(s.chainA.SenderAccount.GetSequence() + 1))
+
+			link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+			s.Require().NoError(err)
+			s.Require().Empty(link.Packets)
+
+			// packets which are not linked are not affected
+			_, err = s.chainA.SendMsgs(&linkedpackets.MsgStopLink{Sender: s.chainA.SenderAccount.GetAddress().String()})
+			s.Require().NoError(err)
+			s.Require().NotEmpty(s.ExecuteTransfer(""))
+		})
+	}
+}
+
+func (s *LinkedPacketsTestSuite) TestLinkingPausedOnReceive() {
+	s.SetupLinkedPacketsTransferTest()
+
+	s.Require().NoError(GetSimApp(s.chainB).LinkedPacketsKeeper.SetLinkingPaused(s.chainB.GetContext(), true, nil))
+
+	s.ExecuteInitLink("mylinkid")
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		ibctesting.TestCoin,
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 100), 0, linkedpackets.LastLinkMemoKey,
+	)
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	// linked packets are rejected while linking is paused on the receiving chain
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err = s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	linkAck, err := linkedpackets.LinkAcknowledgementFromBytes(ack)
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkAckCodePolicyViolation, linkAck.Code)
+	s.Require().Contains(linkAck.Reason, linkedpackets.ErrLinkingPaused.Error())
+}
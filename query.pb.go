@@ -6,7 +6,8 @@ package linkedpackets
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -134,22 +135,25 @@ func (m *QueryLinkEnabledChannelResponse) GetLinkEnabled() bool {
 	return false
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryLinkEnabledChannelsRequest is the request type for the Query/LinkEnabledChannels RPC
+// method.
+type QueryLinkEnabledChannelsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLinkEnabledChannelsRequest) Reset()         { *m = QueryLinkEnabledChannelsRequest{} }
+func (m *QueryLinkEnabledChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkEnabledChannelsRequest) ProtoMessage()    {}
+func (*QueryLinkEnabledChannelsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e24236a5e5d64d80, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLinkEnabledChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLinkEnabledChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLinkEnabledChannelsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -159,36 +163,46 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryLinkEnabledChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkEnabledChannelsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryLinkEnabledChannelsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryLinkEnabledChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkEnabledChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLinkEnabledChannelsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryLinkEnabledChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+// QueryLinkEnabledChannelsResponse is the response type for the Query/LinkEnabledChannels RPC
+// method.
+type QueryLinkEnabledChannelsResponse struct {
+	// channels are the link enabled channels.
+	Channels []ChannelIdentifier `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLinkEnabledChannelsResponse) Reset()         { *m = QueryLinkEnabledChannelsResponse{} }
+func (m *QueryLinkEnabledChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkEnabledChannelsResponse) ProtoMessage()    {}
+func (*QueryLinkEnabledChannelsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e24236a5e5d64d80, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLinkEnabledChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLinkEnabledChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLinkEnabledChannelsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -198,381 +212,3319 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryLinkEnabledChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkEnabledChannelsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryLinkEnabledChannelsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryLinkEnabledChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkEnabledChannelsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLinkEnabledChannelsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryLinkEnabledChannelsResponse) GetChannels() []ChannelIdentifier {
 	if m != nil {
-		return m.Params
+		return m.Channels
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryLinkEnabledChannelRequest)(nil), "srdtrk.linkedpackets.v1.QueryLinkEnabledChannelRequest")
-	proto.RegisterType((*QueryLinkEnabledChannelResponse)(nil), "srdtrk.linkedpackets.v1.QueryLinkEnabledChannelResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "srdtrk.linkedpackets.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "srdtrk.linkedpackets.v1.QueryParamsResponse")
+func (m *QueryLinkEnabledChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("srdtrk/linkedpackets/v1/query.proto", fileDescriptor_e24236a5e5d64d80)
+// QueryLinkRequest is the request type for the Query/Link RPC method.
+type QueryLinkRequest struct {
+	// link_id is the link identifier.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 }
 
-var fileDescriptor_e24236a5e5d64d80 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x8d, 0x2b, 0x11, 0xa8, 0x1f, 0x0b, 0xe6, 0x49, 0x0f, 0x05, 0x48, 0x78, 0x61, 0x00, 0x01,
-	0x8a, 0xf5, 0xca, 0x00, 0xac, 0x05, 0x86, 0x0a, 0x90, 0x20, 0x53, 0x61, 0xa9, 0xdc, 0xc4, 0x4a,
-	0xa3, 0x24, 0x76, 0x1a, 0xbb, 0x95, 0xaa, 0xaa, 0x0b, 0x13, 0x03, 0x03, 0x12, 0x3f, 0xc1, 0xc8,
-	0x67, 0x74, 0xac, 0xc4, 0xc2, 0x84, 0xa0, 0x45, 0xe2, 0x1f, 0x98, 0x50, 0x6c, 0xa3, 0x52, 0x20,
-	0x15, 0x2c, 0x91, 0x73, 0xee, 0x3d, 0xc7, 0xc7, 0xe7, 0x5e, 0x78, 0x55, 0x54, 0xb1, 0xac, 0x32,
-	0x9c, 0xa7, 0x2c, 0xa3, 0x71, 0x49, 0xa2, 0x8c, 0x4a, 0x81, 0xa7, 0x27, 0x78, 0x3c, 0xa1, 0xd5,
-	0x2c, 0x28, 0x2b, 0x2e, 0x39, 0x3a, 0xd2, 0x4d, 0xc1, 0x4e, 0x53, 0x30, 0x3d, 0x71, 0x1a, 0xd9,
-	0x72, 0x56, 0x52, 0xa1, 0xd9, 0xce, 0xa5, 0x84, 0xf3, 0x24, 0xa7, 0x98, 0x94, 0x29, 0x26, 0x8c,
-	0x71, 0x49, 0x64, 0xca, 0xd9, 0xcf, 0xea, 0xc5, 0x88, 0x8b, 0x82, 0x0b, 0x7d, 0xdf, 0x6f, 0x17,
-	0x3b, 0xe7, 0x48, 0x91, 0x32, 0x8e, 0xd5, 0xd7, 0x40, 0x87, 0x09, 0x4f, 0xb8, 0x3a, 0xe2, 0xfa,
-	0xa4, 0x51, 0xbf, 0x0f, 0xdd, 0x67, 0x35, 0xef, 0x71, 0xca, 0xb2, 0x87, 0x8c, 0x0c, 0x73, 0x1a,
-	0xdf, 0x1f, 0x11, 0xc6, 0x68, 0x1e, 0xd2, 0xf1, 0x84, 0x0a, 0x89, 0x8e, 0xe0, 0xe9, 0x92, 0x57,
-	0x72, 0x90, 0xc6, 0x17, 0xc0, 0x15, 0x70, 0xbd, 0x1d, 0xda, 0xf5, 0x6f, 0x2f, 0x46, 0x97, 0x21,
-	0x8c, 0x74, 0x6b, 0x5d, 0x6b, 0xa9, 0x5a, 0xdb, 0x20, 0xbd, 0xd8, 0x7f, 0x00, 0xbd, 0x46, 0x65,
-	0x51, 0x72, 0x26, 0x28, 0x3a, 0x86, 0x67, 0xeb, 0x00, 0x06, 0x54, 0x97, 0x95, 0xfe, 0x99, 0xf0,
-	0x20, 0xdf, 0x32, 0xfc, 0x43, 0x88, 0x94, 0xca, 0x53, 0x52, 0x91, 0x42, 0x18, 0x4f, 0xfe, 0x73,
-	0x78, 0x7e, 0x07, 0x35, 0x7a, 0x5d, 0x68, 0x97, 0x0a, 0x51, 0x4a, 0x07, 0x1d, 0x2f, 0x68, 0xc8,
-	0x3f, 0xd0, 0xc4, 0x6e, 0x7b, 0xf9, 0xc9, 0xb3, 0xde, 0x7d, 0x7b, 0x7f, 0x03, 0x84, 0x86, 0xd9,
-	0xf9, 0xde, 0x82, 0xa7, 0x94, 0x36, 0xfa, 0x02, 0x20, 0xfa, 0xd3, 0x3c, 0xba, 0xd3, 0x28, 0xba,
-	0x3f, 0x48, 0xe7, 0xee, 0xff, 0x13, 0xf5, 0xbb, 0xfc, 0xfe, 0xab, 0xda, 0xe2, 0xcb, 0x0f, 0x5f,
-	0xdf, 0xb6, 0x9e, 0xa0, 0x47, 0xb8, 0x69, 0x77, 0x4c, 0xf6, 0x02, 0xcf, 0xb7, 0x73, 0x59, 0xe0,
-	0x7a, 0x5a, 0x02, 0xcf, 0xcd, 0x0c, 0x17, 0xf8, 0xd7, 0xc4, 0xd1, 0x6b, 0x00, 0x6d, 0x9d, 0x05,
-	0xba, 0xb9, 0xdf, 0xde, 0xce, 0x00, 0x9c, 0x5b, 0xff, 0xd6, 0x6c, 0xfc, 0x5f, 0x53, 0xd6, 0x8f,
-	0x91, 0xd7, 0x68, 0x5d, 0x87, 0xdf, 0xbd, 0xb7, 0x5c, 0xbb, 0x60, 0xb5, 0x76, 0xc1, 0xe7, 0xb5,
-	0x0b, 0xde, 0x6c, 0x5c, 0x6b, 0xb5, 0x71, 0xad, 0x8f, 0x1b, 0xd7, 0x7a, 0xe1, 0x25, 0xa9, 0x1c,
-	0x4d, 0x86, 0x41, 0xc4, 0x8b, 0xbf, 0x8a, 0x0c, 0x6d, 0xb5, 0xcf, 0xb7, 0x7f, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xf4, 0xa4, 0xc1, 0x59, 0x98, 0x03, 0x00, 0x00,
+func (m *QueryLinkRequest) Reset()         { *m = QueryLinkRequest{} }
+func (m *QueryLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkRequest) ProtoMessage()    {}
+func (*QueryLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{4}
+}
+func (m *QueryLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkRequest.Merge(m, src)
+}
+func (m *QueryLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLinkRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// LinkEnabledChannel returns whether the channel allows linked packets or not.
-	LinkEnabledChannel(ctx context.Context, in *QueryLinkEnabledChannelRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelResponse, error)
-	// Params returns the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryLinkRequest) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryLinkResponse is the response type for the Query/Link RPC method.
+type QueryLinkResponse struct {
+	// link is the link with the given identifier.
+	Link Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLinkResponse) Reset()         { *m = QueryLinkResponse{} }
+func (m *QueryLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkResponse) ProtoMessage()    {}
+func (*QueryLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{5}
 }
-
-func (c *queryClient) LinkEnabledChannel(ctx context.Context, in *QueryLinkEnabledChannelRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelResponse, error) {
-	out := new(QueryLinkEnabledChannelResponse)
-	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkResponse.Merge(m, src)
+}
+func (m *QueryLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLinkResponse proto.InternalMessageInfo
+
+func (m *QueryLinkResponse) GetLink() Link {
+	if m != nil {
+		return m.Link
 	}
-	return out, nil
+	return Link{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// LinkEnabledChannel returns whether the channel allows linked packets or not.
-	LinkEnabledChannel(context.Context, *QueryLinkEnabledChannelRequest) (*QueryLinkEnabledChannelResponse, error)
-	// Params returns the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryLinksRequest is the request type for the Query/Links RPC method.
+type QueryLinksRequest struct {
+	// owner filters the links by the address which opened them, if set.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// status filters the links by status, if set.
+	Status LinkStatus `protobuf:"varint,2,opt,name=status,proto3,enum=srdtrk.linkedpackets.v1.LinkStatus" json:"status,omitempty"`
+	// port_id filters the links by the port of their packets, if set.
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id filters the links by the channel of their packets, if set.
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLinksRequest) Reset()         { *m = QueryLinksRequest{} }
+func (m *QueryLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLinksRequest) ProtoMessage()    {}
+func (*QueryLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{6}
 }
-
-func (*UnimplementedQueryServer) LinkEnabledChannel(ctx context.Context, req *QueryLinkEnabledChannelRequest) (*QueryLinkEnabledChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LinkEnabledChannel not implemented")
+func (m *QueryLinksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLinksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinksRequest.Merge(m, src)
 }
-
-func _Query_LinkEnabledChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLinkEnabledChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LinkEnabledChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LinkEnabledChannel(ctx, req.(*QueryLinkEnabledChannelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryLinksRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/srdtrk.linkedpackets.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinksRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "srdtrk.linkedpackets.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LinkEnabledChannel",
-			Handler:    _Query_LinkEnabledChannel_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "srdtrk/linkedpackets/v1/query.proto",
-}
+var xxx_messageInfo_QueryLinksRequest proto.InternalMessageInfo
 
-func (m *QueryLinkEnabledChannelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLinksRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLinkEnabledChannelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLinksRequest) GetStatus() LinkStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LinkStatusUnspecified
 }
 
-func (m *QueryLinkEnabledChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryLinksRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryLinksRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLinkEnabledChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLinksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLinkEnabledChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLinksResponse is the response type for the Query/Links RPC method.
+type QueryLinksResponse struct {
+	// links are the links matching the request filters.
+	Links []Link `protobuf:"bytes,1,rep,name=links,proto3" json:"links"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLinkEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LinkEnabled {
-		i--
-		if m.LinkEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryLinksResponse) Reset()         { *m = QueryLinksResponse{} }
+func (m *QueryLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLinksResponse) ProtoMessage()    {}
+func (*QueryLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{7}
+}
+func (m *QueryLinksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLinksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinksResponse.Merge(m, src)
+}
+func (m *QueryLinksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinksResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLinksResponse proto.InternalMessageInfo
+
+func (m *QueryLinksResponse) GetLinks() []Link {
+	if m != nil {
+		return m.Links
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLinksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryOpenSessionRequest is the request type for the Query/OpenSession RPC method.
+type QueryOpenSessionRequest struct {
+	// sender is the address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOpenSessionRequest) Reset()         { *m = QueryOpenSessionRequest{} }
+func (m *QueryOpenSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenSessionRequest) ProtoMessage()    {}
+func (*QueryOpenSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{8}
+}
+func (m *QueryOpenSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryOpenSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenSessionRequest.Merge(m, src)
+}
+func (m *QueryOpenSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenSessionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryOpenSessionRequest proto.InternalMessageInfo
+
+func (m *QueryOpenSessionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryOpenSessionResponse is the response type for the Query/OpenSession RPC method.
+type QueryOpenSessionResponse struct {
+	// link is the link currently opened by the sender. It is empty if the sender has no open link.
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *QueryOpenSessionResponse) Reset()         { *m = QueryOpenSessionResponse{} }
+func (m *QueryOpenSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenSessionResponse) ProtoMessage()    {}
+func (*QueryOpenSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{9}
+}
+func (m *QueryOpenSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenSessionResponse.Merge(m, src)
+}
+func (m *QueryOpenSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenSessionResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryOpenSessionResponse proto.InternalMessageInfo
+
+func (m *QueryOpenSessionResponse) GetLink() *Link {
+	if m != nil {
+		return m.Link
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryLinkEnabledChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{11}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func (m *QueryLinkEnabledChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryLinkingPausedRequest is the request type for the Query/LinkingPaused RPC method.
+type QueryLinkingPausedRequest struct {
+	// port_id is the optional port identifier of the channel.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the optional channel identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryLinkingPausedRequest) Reset()         { *m = QueryLinkingPausedRequest{} }
+func (m *QueryLinkingPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkingPausedRequest) ProtoMessage()    {}
+func (*QueryLinkingPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{12}
+}
+func (m *QueryLinkingPausedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLinkingPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLinkingPausedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.LinkEnabled {
-		n += 2
+}
+func (m *QueryLinkingPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkingPausedRequest.Merge(m, src)
+}
+func (m *QueryLinkingPausedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLinkingPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkingPausedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLinkingPausedRequest proto.InternalMessageInfo
+
+func (m *QueryLinkingPausedRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLinkingPausedRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryLinkingPausedResponse is the response type for the Query/LinkingPaused RPC method.
+type QueryLinkingPausedResponse struct {
+	// paused is true if linking is paused chain-wide or on the given channel.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *QueryLinkingPausedResponse) Reset()         { *m = QueryLinkingPausedResponse{} }
+func (m *QueryLinkingPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkingPausedResponse) ProtoMessage()    {}
+func (*QueryLinkingPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{13}
+}
+func (m *QueryLinkingPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLinkingPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLinkingPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLinkingPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkingPausedResponse.Merge(m, src)
+}
+func (m *QueryLinkingPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLinkingPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkingPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLinkingPausedResponse proto.InternalMessageInfo
+
+func (m *QueryLinkingPausedResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// QueryAccountLinkQuotaRequest is the request type for the Query/AccountLinkQuota RPC method.
+type QueryAccountLinkQuotaRequest struct {
+	// account is the address of the link owner.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryAccountLinkQuotaRequest) Reset()         { *m = QueryAccountLinkQuotaRequest{} }
+func (m *QueryAccountLinkQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountLinkQuotaRequest) ProtoMessage()    {}
+func (*QueryAccountLinkQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{14}
+}
+func (m *QueryAccountLinkQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountLinkQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountLinkQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountLinkQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountLinkQuotaRequest.Merge(m, src)
+}
+func (m *QueryAccountLinkQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountLinkQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountLinkQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountLinkQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryAccountLinkQuotaRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// QueryAccountLinkQuotaResponse is the response type for the Query/AccountLinkQuota RPC method.
+type QueryAccountLinkQuotaResponse struct {
+	// quota is the remaining quota of links of the account.
+	Quota RateLimitQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *QueryAccountLinkQuotaResponse) Reset()         { *m = QueryAccountLinkQuotaResponse{} }
+func (m *QueryAccountLinkQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountLinkQuotaResponse) ProtoMessage()    {}
+func (*QueryAccountLinkQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{15}
+}
+func (m *QueryAccountLinkQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountLinkQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountLinkQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountLinkQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountLinkQuotaResponse.Merge(m, src)
+}
+func (m *QueryAccountLinkQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountLinkQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountLinkQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountLinkQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryAccountLinkQuotaResponse) GetQuota() RateLimitQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return RateLimitQuota{}
+}
+
+// QueryChannelLinkQuotaRequest is the request type for the Query/ChannelLinkQuota RPC method.
+type QueryChannelLinkQuotaRequest struct {
+	// port_id is the port identifier of the channel.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelLinkQuotaRequest) Reset()         { *m = QueryChannelLinkQuotaRequest{} }
+func (m *QueryChannelLinkQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelLinkQuotaRequest) ProtoMessage()    {}
+func (*QueryChannelLinkQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{16}
+}
+func (m *QueryChannelLinkQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelLinkQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelLinkQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelLinkQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelLinkQuotaRequest.Merge(m, src)
+}
+func (m *QueryChannelLinkQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelLinkQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelLinkQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelLinkQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryChannelLinkQuotaRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryChannelLinkQuotaRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelLinkQuotaResponse is the response type for the Query/ChannelLinkQuota RPC method.
+type QueryChannelLinkQuotaResponse struct {
+	// quota is the remaining quota of linked packets of the channel.
+	Quota RateLimitQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *QueryChannelLinkQuotaResponse) Reset()         { *m = QueryChannelLinkQuotaResponse{} }
+func (m *QueryChannelLinkQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelLinkQuotaResponse) ProtoMessage()    {}
+func (*QueryChannelLinkQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e24236a5e5d64d80, []int{17}
+}
+func (m *QueryChannelLinkQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelLinkQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelLinkQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelLinkQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelLinkQuotaResponse.Merge(m, src)
+}
+func (m *QueryChannelLinkQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelLinkQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelLinkQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelLinkQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryChannelLinkQuotaResponse) GetQuota() RateLimitQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return RateLimitQuota{}
+}
+
+func init() {
+	proto.RegisterType((*QueryLinkEnabledChannelRequest)(nil), "srdtrk.linkedpackets.v1.QueryLinkEnabledChannelRequest")
+	proto.RegisterType((*QueryLinkEnabledChannelResponse)(nil), "srdtrk.linkedpackets.v1.QueryLinkEnabledChannelResponse")
+	proto.RegisterType((*QueryLinkEnabledChannelsRequest)(nil), "srdtrk.linkedpackets.v1.QueryLinkEnabledChannelsRequest")
+	proto.RegisterType((*QueryLinkEnabledChannelsResponse)(nil), "srdtrk.linkedpackets.v1.QueryLinkEnabledChannelsResponse")
+	proto.RegisterType((*QueryLinkRequest)(nil), "srdtrk.linkedpackets.v1.QueryLinkRequest")
+	proto.RegisterType((*QueryLinkResponse)(nil), "srdtrk.linkedpackets.v1.QueryLinkResponse")
+	proto.RegisterType((*QueryLinksRequest)(nil), "srdtrk.linkedpackets.v1.QueryLinksRequest")
+	proto.RegisterType((*QueryLinksResponse)(nil), "srdtrk.linkedpackets.v1.QueryLinksResponse")
+	proto.RegisterType((*QueryOpenSessionRequest)(nil), "srdtrk.linkedpackets.v1.QueryOpenSessionRequest")
+	proto.RegisterType((*QueryOpenSessionResponse)(nil), "srdtrk.linkedpackets.v1.QueryOpenSessionResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "srdtrk.linkedpackets.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "srdtrk.linkedpackets.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryLinkingPausedRequest)(nil), "srdtrk.linkedpackets.v1.QueryLinkingPausedRequest")
+	proto.RegisterType((*QueryLinkingPausedResponse)(nil), "srdtrk.linkedpackets.v1.QueryLinkingPausedResponse")
+	proto.RegisterType((*QueryAccountLinkQuotaRequest)(nil), "srdtrk.linkedpackets.v1.QueryAccountLinkQuotaRequest")
+	proto.RegisterType((*QueryAccountLinkQuotaResponse)(nil), "srdtrk.linkedpackets.v1.QueryAccountLinkQuotaResponse")
+	proto.RegisterType((*QueryChannelLinkQuotaRequest)(nil), "srdtrk.linkedpackets.v1.QueryChannelLinkQuotaRequest")
+	proto.RegisterType((*QueryChannelLinkQuotaResponse)(nil), "srdtrk.linkedpackets.v1.QueryChannelLinkQuotaResponse")
+}
+
+func init() {
+	proto.RegisterFile("srdtrk/linkedpackets/v1/query.proto", fileDescriptor_e24236a5e5d64d80)
+}
+
+var fileDescriptor_e24236a5e5d64d80 = []byte{
+	// 1072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0xb1, 0xdb, 0xbc, 0x14, 0x94, 0x4e, 0x23, 0x92, 0x2e, 0x8d, 0x93, 0x6e, 0x25,
+	0x92, 0x3a, 0x65, 0x37, 0x76, 0xdb, 0xb4, 0x55, 0x11, 0x52, 0x13, 0x7e, 0x45, 0x69, 0x45, 0xe2,
+	0x48, 0x55, 0xe1, 0x62, 0xad, 0xbd, 0xc3, 0x76, 0x15, 0x7b, 0xd6, 0xd9, 0x59, 0x17, 0x55, 0x56,
+	0x24, 0xc4, 0x89, 0x03, 0x48, 0x48, 0x70, 0xe5, 0x8e, 0x84, 0x84, 0x38, 0x70, 0xe6, 0x08, 0x3d,
+	0x56, 0x70, 0x81, 0x0b, 0x82, 0x04, 0x89, 0x23, 0xff, 0x02, 0x9a, 0x99, 0xb7, 0xde, 0xb5, 0xdd,
+	0xf5, 0x8f, 0x34, 0x97, 0x68, 0x77, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x37, 0xcf, 0x6f, 0x36, 0x70,
+	0x99, 0x07, 0x4e, 0x18, 0xec, 0x59, 0x35, 0x8f, 0xed, 0x51, 0xa7, 0x61, 0x57, 0xf7, 0x68, 0xc8,
+	0xad, 0xc7, 0x05, 0x6b, 0xbf, 0x49, 0x83, 0x27, 0x66, 0x23, 0xf0, 0x43, 0x9f, 0xcc, 0xaa, 0x20,
+	0xb3, 0x23, 0xc8, 0x7c, 0x5c, 0xd0, 0x53, 0xd1, 0xe1, 0x93, 0x06, 0xe5, 0x0a, 0xad, 0x5f, 0x74,
+	0x7d, 0xdf, 0xad, 0x51, 0xcb, 0x6e, 0x78, 0x96, 0xcd, 0x98, 0x1f, 0xda, 0xa1, 0xe7, 0xb3, 0x68,
+	0xf7, 0xd5, 0xaa, 0xcf, 0xeb, 0x3e, 0x57, 0xf5, 0xba, 0x0a, 0xeb, 0xe7, 0xec, 0xba, 0xc7, 0x7c,
+	0x4b, 0xfe, 0xc5, 0xa5, 0x19, 0xd7, 0x77, 0x7d, 0xf9, 0x68, 0x89, 0x27, 0x5c, 0xcd, 0x63, 0x96,
+	0x8a, 0xcd, 0x69, 0x3b, 0x55, 0x85, 0x86, 0x76, 0xc1, 0x6a, 0xd8, 0xae, 0xc7, 0x64, 0x49, 0x8c,
+	0xbd, 0xa0, 0x62, 0xcb, 0x2a, 0x89, 0x7a, 0x51, 0x5b, 0xc6, 0x43, 0xc8, 0xed, 0x08, 0xf0, 0x3d,
+	0x8f, 0xed, 0xbd, 0xcd, 0xec, 0x4a, 0x8d, 0x3a, 0x1b, 0x8f, 0x6c, 0xc6, 0x68, 0xad, 0x44, 0xf7,
+	0x9b, 0x94, 0x87, 0x64, 0x16, 0x4e, 0x37, 0xfc, 0x20, 0x2c, 0x7b, 0xce, 0x9c, 0xb6, 0xa8, 0x2d,
+	0x4f, 0x96, 0xb2, 0xe2, 0x75, 0xd3, 0x21, 0xf3, 0x00, 0x55, 0x15, 0x2a, 0xf6, 0xc6, 0xe5, 0xde,
+	0x24, 0xae, 0x6c, 0x3a, 0xc6, 0x5b, 0xb0, 0x90, 0x9a, 0x99, 0x37, 0x7c, 0xc6, 0x29, 0xb9, 0x04,
+	0x67, 0x85, 0x8f, 0x65, 0xaa, 0xb6, 0x65, 0xfe, 0x33, 0xa5, 0xa9, 0x5a, 0x8c, 0x30, 0xbc, 0xd4,
+	0x2c, 0x3c, 0x22, 0xf8, 0x0e, 0x40, 0xac, 0x58, 0xe6, 0x98, 0x2a, 0xbe, 0x66, 0xa2, 0x4a, 0x61,
+	0x8f, 0xa9, 0x0c, 0x46, 0x7b, 0xcc, 0x6d, 0xdb, 0xa5, 0x88, 0x2d, 0x25, 0x90, 0xc6, 0x4f, 0x1a,
+	0x2c, 0xa6, 0xd7, 0x42, 0xca, 0x3b, 0x70, 0x06, 0x25, 0xf2, 0x39, 0x6d, 0xf1, 0xd4, 0xf2, 0x54,
+	0x31, 0x6f, 0xa6, 0xf4, 0x8a, 0xb9, 0x11, 0x79, 0x41, 0x59, 0xe8, 0x7d, 0xe4, 0xd1, 0x60, 0x7d,
+	0xf2, 0xe9, 0x9f, 0x0b, 0x63, 0xdf, 0xfe, 0xfb, 0x43, 0x5e, 0x2b, 0xb5, 0xd3, 0x90, 0x77, 0x3b,
+	0xf8, 0x8f, 0x4b, 0xfe, 0x4b, 0x03, 0xf9, 0x2b, 0x3e, 0x1d, 0x02, 0x56, 0x60, 0xba, 0xcd, 0x3f,
+	0x71, 0x7a, 0xd2, 0xe2, 0xf8, 0xf4, 0xc4, 0xeb, 0xa6, 0x63, 0xec, 0xc0, 0xb9, 0x44, 0x30, 0xaa,
+	0x7b, 0x03, 0x26, 0xc4, 0x36, 0x9a, 0x38, 0x9f, 0xaa, 0x4c, 0x80, 0x92, 0x62, 0x24, 0xca, 0xf8,
+	0x64, 0x3c, 0x91, 0xb3, 0x7d, 0x3c, 0x26, 0x64, 0xfc, 0x8f, 0x19, 0x0d, 0x54, 0xfd, 0xf5, 0xb9,
+	0x5f, 0x7f, 0x7c, 0x7d, 0x06, 0xc5, 0xdd, 0x75, 0x9c, 0x80, 0x72, 0xbe, 0x1b, 0x06, 0x1e, 0x73,
+	0x4b, 0x2a, 0x8c, 0xdc, 0x81, 0x2c, 0x0f, 0xed, 0xb0, 0xc9, 0xa5, 0x15, 0x2f, 0x17, 0x2f, 0xf7,
+	0x65, 0xb1, 0x2b, 0x43, 0x4b, 0x08, 0x49, 0x36, 0xeb, 0xa9, 0x3e, 0xcd, 0x3a, 0xd1, 0xd5, 0xac,
+	0x5d, 0x3d, 0x94, 0x39, 0x76, 0x0f, 0x7d, 0xa3, 0x01, 0x49, 0x5a, 0x80, 0xbe, 0xbe, 0x09, 0x19,
+	0xc1, 0x3e, 0x6a, 0x99, 0xe1, 0x8d, 0x55, 0xb0, 0x93, 0x6b, 0x91, 0x2d, 0x98, 0x95, 0xf4, 0xde,
+	0x6f, 0x50, 0xb6, 0x4b, 0x39, 0xf7, 0x7c, 0x16, 0x9d, 0xd3, 0x2a, 0x64, 0x39, 0x65, 0xce, 0x10,
+	0x07, 0x85, 0x71, 0xc6, 0x7d, 0x98, 0xeb, 0x4d, 0x86, 0x8a, 0x0b, 0x23, 0x74, 0x12, 0xb6, 0xcf,
+	0x0c, 0x5a, 0xb7, 0x6d, 0x07, 0x76, 0x3d, 0x6a, 0x1f, 0xe3, 0x03, 0x38, 0xdf, 0xb1, 0x8a, 0xf9,
+	0xd7, 0x21, 0xdb, 0x90, 0x2b, 0x58, 0x61, 0x21, 0xb5, 0x82, 0x02, 0x26, 0x4d, 0x45, 0xa4, 0xb1,
+	0x0b, 0x17, 0xda, 0x67, 0xe5, 0x31, 0x77, 0xdb, 0x6e, 0x72, 0xea, 0xbc, 0xe8, 0xd8, 0xbb, 0x0e,
+	0xfa, 0xf3, 0x92, 0x22, 0xed, 0x57, 0x04, 0x6d, 0xb1, 0x82, 0xb3, 0x0e, 0xdf, 0x8c, 0x12, 0x5c,
+	0x94, 0xa8, 0xbb, 0xd5, 0xaa, 0xdf, 0x64, 0xa1, 0x00, 0xef, 0x34, 0xfd, 0xd0, 0x8e, 0xd8, 0x14,
+	0xe1, 0xb4, 0xad, 0xb6, 0x06, 0x9e, 0x4e, 0x14, 0x68, 0x78, 0x30, 0x9f, 0x92, 0x13, 0xc9, 0xbc,
+	0x07, 0x99, 0x7d, 0xb1, 0x80, 0x16, 0x2e, 0xa5, 0x5a, 0x58, 0xb2, 0x43, 0x7a, 0xcf, 0xab, 0x7b,
+	0xa1, 0xc4, 0x77, 0xf4, 0xa7, 0x4c, 0x60, 0x3c, 0x40, 0xfa, 0x38, 0xf1, 0x7a, 0xe8, 0x1f, 0xd7,
+	0xcc, 0x48, 0x42, 0x6f, 0xde, 0x93, 0x96, 0x50, 0xfc, 0xef, 0x2c, 0x64, 0x64, 0x2d, 0xf2, 0xb7,
+	0x06, 0xa4, 0xf7, 0x0a, 0x20, 0x37, 0x53, 0x73, 0xf7, 0xbf, 0x40, 0xf5, 0x5b, 0xa3, 0x03, 0x95,
+	0x3a, 0xe3, 0xe1, 0x67, 0x82, 0xe1, 0xa7, 0xbf, 0xfd, 0xf3, 0xd5, 0xf8, 0x7d, 0xb2, 0x65, 0xa5,
+	0x7d, 0x7a, 0x44, 0x37, 0x89, 0xd5, 0x8a, 0xbd, 0x3c, 0xb0, 0x84, 0xc3, 0xdc, 0x6a, 0xa1, 0xef,
+	0x07, 0x56, 0xf2, 0xa6, 0x25, 0xbf, 0x68, 0x70, 0xbe, 0xb7, 0x30, 0x27, 0x23, 0x73, 0x8d, 0x7e,
+	0xa7, 0xfa, 0xed, 0x63, 0x20, 0x51, 0xe6, 0x9d, 0x58, 0xe6, 0x2a, 0x31, 0x53, 0x65, 0x26, 0x05,
+	0x94, 0xdb, 0xb7, 0xe7, 0xd7, 0x1a, 0x4c, 0x88, 0xe4, 0xe4, 0xca, 0x60, 0x02, 0x11, 0xd7, 0xfc,
+	0x30, 0xa1, 0x48, 0xee, 0x46, 0x4c, 0x2e, 0x4f, 0x96, 0xfb, 0x92, 0xe3, 0x56, 0x0b, 0xef, 0xda,
+	0x03, 0xf2, 0x85, 0x06, 0x19, 0x91, 0x87, 0x93, 0x21, 0x8a, 0xb5, 0x4d, 0x5c, 0x19, 0x2a, 0x16,
+	0x99, 0xad, 0xc4, 0xcc, 0x16, 0x49, 0xae, 0x3f, 0x33, 0xf2, 0x9d, 0x06, 0x53, 0x89, 0x39, 0x4d,
+	0x56, 0xfb, 0x57, 0xea, 0xbd, 0x1f, 0xf4, 0xc2, 0x08, 0x08, 0x64, 0x78, 0x33, 0x66, 0x78, 0x95,
+	0xe4, 0x53, 0x19, 0x72, 0x05, 0xe3, 0x56, 0x4b, 0x5d, 0x2c, 0x07, 0xe4, 0x7b, 0x0d, 0x5e, 0xea,
+	0x18, 0xa0, 0xa4, 0x38, 0xd8, 0x99, 0xee, 0x11, 0xae, 0x5f, 0x1b, 0x09, 0x83, 0x9c, 0xaf, 0xc7,
+	0x9c, 0xaf, 0x90, 0xa5, 0xbe, 0xae, 0x7a, 0xcc, 0x2d, 0xab, 0xf9, 0x4d, 0x7e, 0xd6, 0x60, 0xba,
+	0x7b, 0xce, 0x92, 0x1b, 0xfd, 0xeb, 0xa7, 0xcc, 0x7a, 0x7d, 0x6d, 0x54, 0x18, 0x32, 0xdf, 0x88,
+	0x99, 0xdf, 0x22, 0x6b, 0xa9, 0xcc, 0xf1, 0x7a, 0xe0, 0x56, 0x0b, 0x9f, 0x70, 0x34, 0xc8, 0x31,
+	0x48, 0xfe, 0xd0, 0x60, 0xba, 0x7b, 0xda, 0x0e, 0x12, 0x92, 0x32, 0xf5, 0xf5, 0xb5, 0x51, 0x61,
+	0x28, 0xe4, 0x41, 0x2c, 0x64, 0x8b, 0x6c, 0x9e, 0xc4, 0xd8, 0x53, 0xda, 0x3e, 0xd7, 0x20, 0xab,
+	0xbe, 0x06, 0xc8, 0x80, 0x1f, 0x5a, 0xc7, 0x27, 0x88, 0x7e, 0x75, 0xb8, 0x60, 0x64, 0xbf, 0x24,
+	0x89, 0x5f, 0x22, 0x0b, 0xa9, 0xc4, 0xd5, 0xe7, 0xc7, 0xfa, 0xed, 0xa7, 0x87, 0x39, 0xed, 0xd9,
+	0x61, 0x4e, 0xfb, 0xeb, 0x30, 0xa7, 0x7d, 0x79, 0x94, 0x1b, 0x7b, 0x76, 0x94, 0x1b, 0xfb, 0xfd,
+	0x28, 0x37, 0xf6, 0xe1, 0x82, 0xeb, 0x85, 0x8f, 0x9a, 0x15, 0xb3, 0xea, 0xd7, 0x9f, 0x9b, 0xa4,
+	0x92, 0x95, 0xff, 0xbc, 0x5d, 0xfb, 0x7f, 0x00, 0xdb, 0x8f, 0x68, 0x03, 0xcc, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// LinkEnabledChannel returns whether the channel allows linked packets or not.
+	LinkEnabledChannel(ctx context.Context, in *QueryLinkEnabledChannelRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelResponse, error)
+	// LinkEnabledChannels returns all the channels which allow linked packets.
+	LinkEnabledChannels(ctx context.Context, in *QueryLinkEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelsResponse, error)
+	// Link returns the link with the given identifier, with its packets and status.
+	Link(ctx context.Context, in *QueryLinkRequest, opts ...grpc.CallOption) (*QueryLinkResponse, error)
+	// Links returns the links opened on this chain, optionally filtered by owner, status or channel.
+	Links(ctx context.Context, in *QueryLinksRequest, opts ...grpc.CallOption) (*QueryLinksResponse, error)
+	// OpenSession returns the link currently opened by the given sender, if any.
+	OpenSession(ctx context.Context, in *QueryOpenSessionRequest, opts ...grpc.CallOption) (*QueryOpenSessionResponse, error)
+	// LinkingPaused returns whether linking is paused chain-wide or, if a channel is given, on the channel.
+	LinkingPaused(ctx context.Context, in *QueryLinkingPausedRequest, opts ...grpc.CallOption) (*QueryLinkingPausedResponse, error)
+	// AccountLinkQuota returns the number of links the given account can still open in the current rate limit window.
+	AccountLinkQuota(ctx context.Context, in *QueryAccountLinkQuotaRequest, opts ...grpc.CallOption) (*QueryAccountLinkQuotaResponse, error)
+	// ChannelLinkQuota returns the number of linked packets which can still be sent on the given channel in the
+	// current rate limit window.
+	ChannelLinkQuota(ctx context.Context, in *QueryChannelLinkQuotaRequest, opts ...grpc.CallOption) (*QueryChannelLinkQuotaResponse, error)
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) LinkEnabledChannel(ctx context.Context, in *QueryLinkEnabledChannelRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelResponse, error) {
+	out := new(QueryLinkEnabledChannelResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LinkEnabledChannels(ctx context.Context, in *QueryLinkEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryLinkEnabledChannelsResponse, error) {
+	out := new(QueryLinkEnabledChannelsResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Link(ctx context.Context, in *QueryLinkRequest, opts ...grpc.CallOption) (*QueryLinkResponse, error) {
+	out := new(QueryLinkResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/Link", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Links(ctx context.Context, in *QueryLinksRequest, opts ...grpc.CallOption) (*QueryLinksResponse, error) {
+	out := new(QueryLinksResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/Links", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpenSession(ctx context.Context, in *QueryOpenSessionRequest, opts ...grpc.CallOption) (*QueryOpenSessionResponse, error) {
+	out := new(QueryOpenSessionResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/OpenSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LinkingPaused(ctx context.Context, in *QueryLinkingPausedRequest, opts ...grpc.CallOption) (*QueryLinkingPausedResponse, error) {
+	out := new(QueryLinkingPausedResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/LinkingPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLinkQuota(ctx context.Context, in *QueryAccountLinkQuotaRequest, opts ...grpc.CallOption) (*QueryAccountLinkQuotaResponse, error) {
+	out := new(QueryAccountLinkQuotaResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/AccountLinkQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelLinkQuota(ctx context.Context, in *QueryChannelLinkQuotaRequest, opts ...grpc.CallOption) (*QueryChannelLinkQuotaResponse, error) {
+	out := new(QueryChannelLinkQuotaResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/ChannelLinkQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// LinkEnabledChannel returns whether the channel allows linked packets or not.
+	LinkEnabledChannel(context.Context, *QueryLinkEnabledChannelRequest) (*QueryLinkEnabledChannelResponse, error)
+	// LinkEnabledChannels returns all the channels which allow linked packets.
+	LinkEnabledChannels(context.Context, *QueryLinkEnabledChannelsRequest) (*QueryLinkEnabledChannelsResponse, error)
+	// Link returns the link with the given identifier, with its packets and status.
+	Link(context.Context, *QueryLinkRequest) (*QueryLinkResponse, error)
+	// Links returns the links opened on this chain, optionally filtered by owner, status or channel.
+	Links(context.Context, *QueryLinksRequest) (*QueryLinksResponse, error)
+	// OpenSession returns the link currently opened by the given sender, if any.
+	OpenSession(context.Context, *QueryOpenSessionRequest) (*QueryOpenSessionResponse, error)
+	// LinkingPaused returns whether linking is paused chain-wide or, if a channel is given, on the channel.
+	LinkingPaused(context.Context, *QueryLinkingPausedRequest) (*QueryLinkingPausedResponse, error)
+	// AccountLinkQuota returns the number of links the given account can still open in the current rate limit window.
+	AccountLinkQuota(context.Context, *QueryAccountLinkQuotaRequest) (*QueryAccountLinkQuotaResponse, error)
+	// ChannelLinkQuota returns the number of linked packets which can still be sent on the given channel in the
+	// current rate limit window.
+	ChannelLinkQuota(context.Context, *QueryChannelLinkQuotaRequest) (*QueryChannelLinkQuotaResponse, error)
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) LinkEnabledChannel(ctx context.Context, req *QueryLinkEnabledChannelRequest) (*QueryLinkEnabledChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkEnabledChannel not implemented")
+}
+func (*UnimplementedQueryServer) LinkEnabledChannels(ctx context.Context, req *QueryLinkEnabledChannelsRequest) (*QueryLinkEnabledChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkEnabledChannels not implemented")
+}
+func (*UnimplementedQueryServer) Link(ctx context.Context, req *QueryLinkRequest) (*QueryLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Link not implemented")
+}
+func (*UnimplementedQueryServer) Links(ctx context.Context, req *QueryLinksRequest) (*QueryLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Links not implemented")
+}
+func (*UnimplementedQueryServer) OpenSession(ctx context.Context, req *QueryOpenSessionRequest) (*QueryOpenSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (*UnimplementedQueryServer) LinkingPaused(ctx context.Context, req *QueryLinkingPausedRequest) (*QueryLinkingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkingPaused not implemented")
+}
+func (*UnimplementedQueryServer) AccountLinkQuota(ctx context.Context, req *QueryAccountLinkQuotaRequest) (*QueryAccountLinkQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLinkQuota not implemented")
+}
+func (*UnimplementedQueryServer) ChannelLinkQuota(ctx context.Context, req *QueryChannelLinkQuotaRequest) (*QueryChannelLinkQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelLinkQuota not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_LinkEnabledChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkEnabledChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkEnabledChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkEnabledChannel(ctx, req.(*QueryLinkEnabledChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LinkEnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkEnabledChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkEnabledChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/LinkEnabledChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkEnabledChannels(ctx, req.(*QueryLinkEnabledChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Link_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Link(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/Link",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Link(ctx, req.(*QueryLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Links_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Links(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/Links",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Links(ctx, req.(*QueryLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/OpenSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenSession(ctx, req.(*QueryOpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LinkingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkingPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/LinkingPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkingPaused(ctx, req.(*QueryLinkingPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLinkQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLinkQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLinkQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/AccountLinkQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLinkQuota(ctx, req.(*QueryAccountLinkQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelLinkQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelLinkQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelLinkQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/ChannelLinkQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelLinkQuota(ctx, req.(*QueryChannelLinkQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "srdtrk.linkedpackets.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LinkEnabledChannel",
+			Handler:    _Query_LinkEnabledChannel_Handler,
+		},
+		{
+			MethodName: "LinkEnabledChannels",
+			Handler:    _Query_LinkEnabledChannels_Handler,
+		},
+		{
+			MethodName: "Link",
+			Handler:    _Query_Link_Handler,
+		},
+		{
+			MethodName: "Links",
+			Handler:    _Query_Links_Handler,
+		},
+		{
+			MethodName: "OpenSession",
+			Handler:    _Query_OpenSession_Handler,
+		},
+		{
+			MethodName: "LinkingPaused",
+			Handler:    _Query_LinkingPaused_Handler,
+		},
+		{
+			MethodName: "AccountLinkQuota",
+			Handler:    _Query_AccountLinkQuota_Handler,
+		},
+		{
+			MethodName: "ChannelLinkQuota",
+			Handler:    _Query_ChannelLinkQuota_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "srdtrk/linkedpackets/v1/query.proto",
+}
+
+func (m *QueryLinkEnabledChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkEnabledChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkEnabledChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkEnabledChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkEnabledChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinkEnabled {
+		i--
+		if m.LinkEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkEnabledChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkEnabledChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkEnabledChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkEnabledChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkEnabledChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkEnabledChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkingPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkingPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkingPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLinkingPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLinkingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLinkingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLinkQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLinkQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLinkQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLinkQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLinkQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLinkQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelLinkQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelLinkQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelLinkQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelLinkQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelLinkQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelLinkQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLinkEnabledChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinkEnabledChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLinkEnabledChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinkEnabledChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Link.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLinksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLinkingPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinkingPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAccountLinkQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountLinkQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelLinkQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelLinkQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLinkEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinkEnabledChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LinkEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinkEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinkEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelIdentifier{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LinkStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, Link{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryLinkEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLinkingPausedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -595,10 +3547,10 @@ func (m *QueryLinkEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLinkEnabledChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLinkingPausedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLinkEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLinkingPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -686,7 +3638,7 @@ func (m *QueryLinkEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLinkEnabledChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLinkingPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -709,15 +3661,15 @@ func (m *QueryLinkEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLinkEnabledChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLinkingPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLinkEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLinkingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -734,7 +3686,7 @@ func (m *QueryLinkEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LinkEnabled = bool(v != 0)
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -756,7 +3708,7 @@ func (m *QueryLinkEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountLinkQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -779,12 +3731,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountLinkQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountLinkQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -806,7 +3790,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountLinkQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,15 +3813,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountLinkQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountLinkQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -864,7 +3848,204 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelLinkQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelLinkQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelLinkQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelLinkQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelLinkQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelLinkQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,38 @@
+package linkedpackets_test
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func (s *LinkedPacketsTestSuite) TestChannelLinkRateLimit() {
+	s.SetupLinkedPacketsTransferTest()
+
+	app := GetSimApp(s.chainA)
+	params := linkedpackets.DefaultParams()
+	params.MaxLinkedPacketsPerChannelWindow = 1
+	s.Require().NoError(app.LinkedPacketsKeeper.Params.Set(s.chainA.GetContext(), params))
+
+	s.ExecuteInitLink("mylinkid")
+	s.Require().NotEmpty(s.ExecuteTransfer(""))
+
+	quota, err := app.LinkedPacketsKeeper.ChannelLinkQuota(s.chainA.GetContext(), s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), quota.Limit)
+	s.Require().Zero(quota.Remaining)
+
+	// the channel already sent its linked packets of the window
+	s.Require().Equal(transfertypes.FungibleTokenPacketData{}, s.ExecuteTransfer(linkedpackets.LastLinkMemoKey))
+	// the failed transaction still increments the sequence of the sender on chainA
+	s.Require().NoError(s.chainA.SenderAccount.SetSequence(s.chainA.SenderAccount.GetSequence() + 1))
+
+	link, err := app.LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+	s.Require().NoError(err)
+	s.Require().Len(link.Packets, 1)
+
+	// packets which are not linked are not rate limited
+	_, err = s.chainA.SendMsgs(&linkedpackets.MsgStopLink{Sender: s.chainA.SenderAccount.GetAddress().String()})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(s.ExecuteTransfer(""))
+}
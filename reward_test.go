@@ -0,0 +1,69 @@
+package linkedpackets_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+func (s *LinkedPacketsTestSuite) TestRelayerReward() {
+	reward := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	testCases := []struct {
+		name     string
+		setup    func()
+		expPayee func() sdk.AccAddress
+	}{
+		{
+			"success: reward paid to the relayer on the receiving chain",
+			s.SetupLinkedPacketsTransferTest,
+			func() sdk.AccAddress { return s.chainB.SenderAccount.GetAddress() },
+		},
+		{
+			"success: reward paid to the forward relayer registered with the fee middleware",
+			func() {
+				s.SetupLinkedPacketsFeeTransferTest()
+
+				GetSimApp(s.chainB).IBCFeeKeeper.SetCounterpartyPayeeAddress(
+					s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress().String(),
+					s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), s.path.EndpointB.ChannelID,
+				)
+			},
+			func() sdk.AccAddress { return s.chainA.SenderAccounts[1].SenderAccount.GetAddress() },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			tc.setup()
+
+			app := GetSimApp(s.chainA)
+			rewarded := tc.expPayee()
+			balance := app.BankKeeper.GetBalance(s.chainA.GetContext(), rewarded, sdk.DefaultBondDenom)
+
+			res, err := s.chainA.SendMsgs(&linkedpackets.MsgInitLink{
+				Sender:        s.chainA.SenderAccount.GetAddress().String(),
+				LinkId:        "mylinkid",
+				RelayerReward: reward,
+			})
+			s.Require().NoError(err)
+			s.Require().NotEmpty(res)
+
+			s.ExecuteTransfer("1")
+			s.Require().Equal(balance, app.BankKeeper.GetBalance(s.chainA.GetContext(), rewarded, sdk.DefaultBondDenom))
+
+			s.ExecuteTransfer(linkedpackets.LastLinkMemoKey)
+			s.Require().Equal(balance.Add(reward[0]), app.BankKeeper.GetBalance(s.chainA.GetContext(), rewarded, sdk.DefaultBondDenom))
+
+			link, err := app.LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+			s.Require().NoError(err)
+			s.Require().Equal(linkedpackets.LinkStatusSucceeded, link.Status)
+			s.Require().True(link.RelayerReward.IsZero())
+			// the relayer is recorded from the acknowledgements of the receiving chain
+			for _, p := range link.Packets {
+				s.Require().Equal(rewarded.String(), p.Relayer)
+			}
+		})
+	}
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -104,6 +105,10 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
 	"github.com/cosmos/ibc-go/modules/capability"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -141,6 +146,9 @@ import (
 
 const appName = "SimApp"
 
+// maxCallbackGas is the maximum amount of gas a contract callback can consume.
+const maxCallbackGas = uint64(1_000_000)
+
 // IBC application testing ports
 const (
 	MockFeePort string = ibcmock.ModuleName + ibcfeetypes.ModuleName
@@ -162,7 +170,7 @@ var (
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		ibcmock.ModuleName:             nil,
-		linkedpackets.ModuleName:       nil,
+		linkedpackets.ModuleName:       {authtypes.Burner},
 	}
 )
 
@@ -204,6 +212,7 @@ type SimApp struct {
 	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	ICAHostKeeper         icahostkeeper.Keeper
 	LinkedPacketsKeeper   linkedpacketskeeper.Keeper
+	PacketForwardKeeper   *packetforwardkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
@@ -211,6 +220,10 @@ type SimApp struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	CircuitKeeper         circuitkeeper.Keeper
 
+	// contractKeeper forwards the callbacks of the ibc-go callbacks middleware to the contract keeper set with
+	// SetContractKeeper.
+	contractKeeper *forwardingContractKeeper
+
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
@@ -309,15 +322,13 @@ func NewSimApp(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
-	preparePropHandler := linkedpacketsabci.NewPrepareProposalHandler(logger, txConfig, appCodec, mempool)
-	bApp.SetPrepareProposal(preparePropHandler.PrepareProposalHandler())
-
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, crisistypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, group.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
 		authzkeeper.StoreKey, ibcfeetypes.StoreKey, consensusparamtypes.StoreKey, circuittypes.StoreKey, linkedpackets.StoreKey,
+		packetforwardtypes.StoreKey,
 	)
 
 	// register streaming services
@@ -462,9 +473,20 @@ func NewSimApp(
 	// Linked Packets Module keeper
 	app.LinkedPacketsKeeper = linkedpacketskeeper.NewKeeper(
 		appCodec, authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
-		runtime.NewKVStoreService(keys[linkedpackets.StoreKey]),
+		runtime.NewKVStoreService(keys[linkedpackets.StoreKey]), app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.LinkedPacketsKeeper.SetDistributionKeeper(app.DistrKeeper)
+	// disabling MsgInitLink with the circuit breaker also pauses the linking of packets
+	app.LinkedPacketsKeeper.SetCircuitBreaker(&app.CircuitKeeper)
+	// the error acknowledgements of force closed links are incentivized on fee enabled channels
+	app.LinkedPacketsKeeper.SetFeeKeeper(app.IBCFeeKeeper)
+	// NOTE: hooks must be set before the keeper is passed to the IBC middleware stacks below
+	app.LinkedPacketsKeeper.SetHooks(
+		linkedpackets.NewMultiLinkHooks(
+		// register the link hooks
+		),
+	)
 
 	// ICA Controller keeper
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
@@ -474,6 +496,8 @@ func NewSimApp(
 		scopedICAControllerKeeper, app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// NOTE: a pointer is passed since the ICS4Wrapper of the ICA controller keeper is set after the middleware stack is created
+	app.LinkedPacketsKeeper.SetICAControllerKeeper(app.IBCKeeper.ChannelKeeper, &app.ICAControllerKeeper)
 
 	// ICA Host keeper
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
@@ -483,6 +507,8 @@ func NewSimApp(
 		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// the interchain accounts transactions of atomic links are executed together by the host
+	app.LinkedPacketsKeeper.SetICAHostChannelKeeper(app.IBCKeeper.ChannelKeeper)
 
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
@@ -499,6 +525,18 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Packet Forward Middleware keeper
+	// NOTE: the acknowledgements of forwarded packets are written through a linked packets middleware, so that they
+	// are wrapped in link acknowledgements like the acknowledgements of the transfer stack. The transfer keeper is
+	// set once its ICS4Wrapper is set below.
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec, keys[packetforwardtypes.StoreKey],
+		nil, // set later on with SetTransferKeeper
+		app.IBCKeeper.ChannelKeeper, app.DistrKeeper, app.BankKeeper,
+		linkedpacketsmod.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper, app.LinkedPacketsKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -518,16 +556,34 @@ func NewSimApp(
 	// channel.RecvPacket -> fee.OnRecvPacket -> transfer.OnRecvPacket
 
 	// transfer stack contains (from top to bottom):
+	// - IBC Callbacks Middleware
+	// - Linked Packets Middleware
 	// - IBC Fee Middleware
+	// - Packet Forward Middleware
 	// - Transfer
 
 	// create IBC module from bottom to top of stack
+	// NOTE: the linked packets middleware wraps the packet forward middleware, so that the hops forwarding linked
+	// packets carry their link to the next chain
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = newPacketForwardMiddleware(
+		transferStack, app.PacketForwardKeeper, transfer.NewIBCModule(app.TransferKeeper),
+	)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 	transferStack = linkedpacketsmod.NewIBCMiddleware(transferStack, app.IBCFeeKeeper, app.LinkedPacketsKeeper)
-	// Since the linked packets middleware itself is an ics4wrapper, it needs to be passed to the transfer keeper
+	// the callbacks middleware wraps the linked packets middleware, so that it reads the sender memo on send,
+	// while the src_callback and dest_callback keys are kept in the memo of linked packets
+	// NOTE: the simapp has no VM, so its contract keeper is only set by the tests with SetContractKeeper. Real
+	// applications should use the contract keeper of their VM for both the ibc-go callbacks middleware and the
+	// link callbacks.
+	app.contractKeeper = &forwardingContractKeeper{}
+	transferStack = ibccallbacks.NewIBCMiddleware(
+		transferStack, transferStack.(porttypes.ICS4Wrapper), app.contractKeeper, maxCallbackGas,
+	)
+	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the transfer keeper
 	app.TransferKeeper.WithICS4Wrapper(transferStack.(porttypes.ICS4Wrapper))
+	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 
 	// Add transfer stack to IBC Router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
@@ -549,12 +605,17 @@ func NewSimApp(
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
 	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
 
+	// Since the linked packets middleware itself is an ics4wrapper, it needs to be passed to the ica host keeper.
+	// The host IBC module holds a copy of the ica host keeper, so the ics4wrapper must be set before the host
+	// stack is created for the host to read the unwrapped application version of linked packets channels.
+	app.ICAHostKeeper.WithICS4Wrapper(
+		linkedpacketsmod.NewIBCMiddleware(icahost.NewIBCModule(app.ICAHostKeeper), app.IBCFeeKeeper, app.LinkedPacketsKeeper),
+	)
+
 	var icaHostStack porttypes.IBCModule
 	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
 	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
 	icaHostStack = linkedpacketsmod.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper, app.LinkedPacketsKeeper)
-	// Since the linked packets middleware itself is an ics4wrapper, it needs to be passed to the ica host keeper
-	app.ICAHostKeeper.WithICS4Wrapper(icaHostStack.(porttypes.ICS4Wrapper))
 
 	// Add host, controller & ica auth modules to IBC router
 	ibcRouter.
@@ -626,10 +687,11 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
-		linkedpacketsmod.NewAppModule(appCodec, app.LinkedPacketsKeeper),
+		linkedpacketsmod.NewAppModule(appCodec, app.LinkedPacketsKeeper, app.AccountKeeper, app.BankKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
-		ibctm.AppModuleBasic{},
-		solomachine.AppModuleBasic{},
+		ibctm.NewAppModule(),
+		solomachine.NewAppModule(),
 		mockModule,
 	)
 
@@ -704,7 +766,7 @@ func NewSimApp(
 		banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibcexported.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName, linkedpackets.ModuleName,
-		icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
+		packetforwardtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, group.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -758,6 +820,13 @@ func NewSimApp(
 	app.SetEndBlocker(app.EndBlocker)
 	app.setAnteHandler(txConfig)
 
+	// the proposal handler is set once the IBC keeper is created, as it groups the packets of the links received
+	// from the same chain with the channel keeper
+	preparePropHandler := linkedpacketsabci.NewPrepareProposalHandler(
+		logger, txConfig, appCodec, app.IBCKeeper.ChannelKeeper, mempool,
+	)
+	app.SetPrepareProposal(preparePropHandler.PrepareProposalHandler())
+
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -931,6 +1000,9 @@ func (app *SimApp) AutoCliOpts() autocli.AppOptions {
 		AddressCodec:          authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
 		ValidatorAddressCodec: authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		ConsensusAddressCodec: authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		TxConfigOpts: authtx.ConfigOptions{
+			EnabledSignModes: append([]signingtypes.SignMode(nil), authtx.DefaultSignModes...),
+		},
 	}
 }
 
@@ -1048,6 +1120,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
 
 	return paramsKeeper
 }
@@ -1079,6 +1152,27 @@ func (app *SimApp) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetLinkedPacketsKeeper implements the testutil.LinkedPacketsApp interface.
+func (app *SimApp) GetLinkedPacketsKeeper() linkedpacketskeeper.Keeper {
+	return app.LinkedPacketsKeeper
+}
+
+// SetContractKeeper sets the contract keeper called back by the ibc-go callbacks middleware and by the linked
+// packets module.
+//
+// NOTE: This is solely used for testing purposes.
+func (app *SimApp) SetContractKeeper(contractKeeper ContractKeeper) {
+	app.contractKeeper.contractKeeper = contractKeeper
+	app.LinkedPacketsKeeper.SetContractKeeper(contractKeeper, maxCallbackGas)
+}
+
+// GetContractKeeper returns the contract keeper set with SetContractKeeper, if any.
+//
+// NOTE: This is solely used for testing purposes.
+func (app *SimApp) GetContractKeeper() ContractKeeper {
+	return app.contractKeeper.contractKeeper
+}
+
 // GetMemKey returns the MemStoreKey for the provided mem key.
 //
 // NOTE: This is solely used for testing purposes.
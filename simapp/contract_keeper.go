@@ -0,0 +1,157 @@
+package simapp
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// MockFailingContract is the address of a contract whose callbacks always fail.
+const MockFailingContract = "failing_contract"
+
+var (
+	_ ContractKeeper               = (*MockContractKeeper)(nil)
+	_ callbacktypes.ContractKeeper = (*forwardingContractKeeper)(nil)
+)
+
+// ContractKeeper is called back by both the ibc-go callbacks middleware and the linked packets module.
+type ContractKeeper interface {
+	callbacktypes.ContractKeeper
+	linkedpackets.ContractKeeper
+}
+
+// forwardingContractKeeper forwards the callbacks of the ibc-go callbacks middleware to a contract keeper, so that
+// the contract keeper can be set after the middleware is created. The callbacks succeed without calling any
+// contract until a contract keeper is set.
+type forwardingContractKeeper struct {
+	contractKeeper ContractKeeper
+}
+
+// IBCSendPacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *forwardingContractKeeper) IBCSendPacketCallback(
+	ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	packetData []byte, contractAddress, packetSenderAddress string,
+) error {
+	if k.contractKeeper == nil {
+		return nil
+	}
+
+	return k.contractKeeper.IBCSendPacketCallback(
+		ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, contractAddress, packetSenderAddress,
+	)
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *forwardingContractKeeper) IBCOnAcknowledgementPacketCallback(
+	ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress,
+	contractAddress, packetSenderAddress string,
+) error {
+	if k.contractKeeper == nil {
+		return nil
+	}
+
+	return k.contractKeeper.IBCOnAcknowledgementPacketCallback(
+		ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress,
+	)
+}
+
+// IBCOnTimeoutPacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *forwardingContractKeeper) IBCOnTimeoutPacketCallback(
+	ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, contractAddress, packetSenderAddress string,
+) error {
+	if k.contractKeeper == nil {
+		return nil
+	}
+
+	return k.contractKeeper.IBCOnTimeoutPacketCallback(ctx, packet, relayer, contractAddress, packetSenderAddress)
+}
+
+// IBCReceivePacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *forwardingContractKeeper) IBCReceivePacketCallback(
+	ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement, contractAddress string,
+) error {
+	if k.contractKeeper == nil {
+		return nil
+	}
+
+	return k.contractKeeper.IBCReceivePacketCallback(ctx, packet, ack, contractAddress)
+}
+
+// MockCallback is a callback received by the MockContractKeeper.
+type MockCallback struct {
+	// Type is the callback type of the ibc-go callbacks middleware or of the linked packets module.
+	Type            string
+	ContractAddress string
+	// LinkID is the identifier of the link of a link callback.
+	LinkID string
+}
+
+// MockContractKeeper records the packet callbacks of the ibc-go callbacks middleware and the link callbacks of
+// the linked packets module. Callbacks of MockFailingContract fail and are not recorded.
+// NOTE: it is only meant for testing, real applications should use the contract keeper of their VM.
+type MockContractKeeper struct {
+	// Callbacks are the successful callbacks, in the order they were received.
+	Callbacks []MockCallback
+}
+
+// NewMockContractKeeper creates a new MockContractKeeper.
+func NewMockContractKeeper() *MockContractKeeper {
+	return &MockContractKeeper{}
+}
+
+// IBCSendPacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *MockContractKeeper) IBCSendPacketCallback(
+	_ sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, contractAddress, _ string,
+) error {
+	return k.record(string(callbacktypes.CallbackTypeSendPacket), contractAddress, "")
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *MockContractKeeper) IBCOnAcknowledgementPacketCallback(
+	_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, contractAddress, _ string,
+) error {
+	return k.record(string(callbacktypes.CallbackTypeAcknowledgementPacket), contractAddress, "")
+}
+
+// IBCOnTimeoutPacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *MockContractKeeper) IBCOnTimeoutPacketCallback(
+	_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, contractAddress, _ string,
+) error {
+	return k.record(string(callbacktypes.CallbackTypeTimeoutPacket), contractAddress, "")
+}
+
+// IBCReceivePacketCallback implements the ibc-go callbacks ContractKeeper interface.
+func (k *MockContractKeeper) IBCReceivePacketCallback(
+	_ sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress string,
+) error {
+	return k.record(string(callbacktypes.CallbackTypeReceivePacket), contractAddress, "")
+}
+
+// IBCOnLinkResolvedCallback implements the linked packets ContractKeeper interface.
+func (k *MockContractKeeper) IBCOnLinkResolvedCallback(_ sdk.Context, link linkedpackets.Link, contractAddress string) error {
+	return k.record(linkedpackets.CallbackTypeLinkResolved, contractAddress, link.LinkId)
+}
+
+// IBCOnLinkReceivedCallback implements the linked packets ContractKeeper interface.
+func (k *MockContractKeeper) IBCOnLinkReceivedCallback(
+	_ sdk.Context, _ channeltypes.Packet, linkData linkedpackets.LinkData, contractAddress string,
+) error {
+	return k.record(linkedpackets.CallbackTypeLinkReceived, contractAddress, linkData.LinkID)
+}
+
+func (k *MockContractKeeper) record(callbackType, contractAddress, linkID string) error {
+	if contractAddress == MockFailingContract {
+		return errors.New("mock contract failed")
+	}
+
+	k.Callbacks = append(k.Callbacks, MockCallback{Type: callbackType, ContractAddress: contractAddress, LinkID: linkID})
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package simapp
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+// packetForwardMiddleware is the packet forward middleware with the PacketDataUnmarshaler interface required by
+// the middlewares wrapping it. The packet data is unmarshaled by the transfer application it forwards for.
+type packetForwardMiddleware struct {
+	packetforward.IBCMiddleware
+	porttypes.PacketDataUnmarshaler
+}
+
+// newPacketForwardMiddleware creates a packet forward middleware which does not retry timed out forwards.
+func newPacketForwardMiddleware(
+	app porttypes.IBCModule, k *packetforwardkeeper.Keeper, unmarshaler porttypes.PacketDataUnmarshaler,
+) packetForwardMiddleware {
+	return packetForwardMiddleware{
+		IBCMiddleware: packetforward.NewIBCMiddleware(
+			app, k, 0,
+			packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+			time.Duration(0), // the refund timeout is unused
+		),
+		PacketDataUnmarshaler: unmarshaler,
+	}
+}
@@ -25,4 +25,12 @@ const (
 	// feegrant
 	DefaultWeightGrantFeeAllowance  int = 100
 	DefaultWeightRevokeFeeAllowance int = 100
+
+	// linkedpackets
+	DefaultWeightMsgInitLink                  int = 100
+	DefaultWeightMsgStopLink                  int = 50
+	DefaultWeightMsgUpdateLinkedPacketsParams int = 100
+	DefaultWeightMsgForceCloseLink            int = 20
+	DefaultWeightMsgForceFailLink             int = 20
+	DefaultWeightMsgSetLinkingPaused          int = 20
 )
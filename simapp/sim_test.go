@@ -0,0 +1,174 @@
+package simapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+// SimAppChainID hardcoded chainID for simulation
+const SimAppChainID = "simulation-app"
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// writeOperationsOpt returns a BaseApp option to write the state changes of the simulation operations before
+// every commit. The simulator delivers the operations of a block after FinalizeBlock has written its state, so
+// their state changes would otherwise be discarded by the commit.
+func writeOperationsOpt(bapp *baseapp.BaseApp) {
+	bapp.SetPrecommiter(func(ctx sdk.Context) {
+		ctx.MultiStore().(storetypes.CacheMultiStore).Write()
+	})
+}
+
+// TestFullAppSimulation runs a randomized simulation of the app. Without the -Enabled flag, it runs a short
+// simulation so that go test exercises the modules of the app, including the linked packets module.
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	if !simcli.FlagEnabledValue {
+		if testing.Short() {
+			t.Skip("skipping application simulation in short mode")
+		}
+
+		config.NumBlocks = 20
+		config.BlockSize = 50
+		config.Commit = true
+	}
+
+	db, dir, logger, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, true)
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	// NOTE: the faux merkle mode is not used, its store adapter loses the empty values written at genesis, such
+	// as the empty fee pool of x/distribution.
+	app := NewSimApp(logger, db, nil, true, appOptions, writeOperationsOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, appName, app.Name())
+
+	// run randomized simulation
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(app, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+// TestAppStateDeterminism runs the same randomized simulations several times and checks that they always
+// reach the same app hash. Without the -Enabled flag, it runs short simulations.
+func TestAppStateDeterminism(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = SimAppChainID
+	config.Commit = true
+
+	numSeeds, numTimesToRunPerSeed := 3, 5
+	seed := rand.Int63
+	if !simcli.FlagEnabledValue {
+		if testing.Short() {
+			t.Skip("skipping application simulation in short mode")
+		}
+
+		config.NumBlocks = 10
+		config.BlockSize = 50
+		numSeeds, numTimesToRunPerSeed = 2, 2
+		// the short simulations always run the same seeds, as some randomized genesis states have no bonded validator
+		seed = rand.New(rand.NewSource(config.Seed)).Int63
+	}
+
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = seed()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			logger := log.NewNopLogger()
+			if simcli.FlagVerboseValue {
+				logger = log.NewTestLogger(t)
+			}
+
+			db := dbm.NewMemDB()
+			app := NewSimApp(logger, db, nil, true, appOptions, writeOperationsOpt, baseapp.SetChainID(SimAppChainID))
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err := simulation.SimulateFromSeed(
+				t,
+				os.Stdout,
+				app.BaseApp,
+				simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+				simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+				simtestutil.SimulationOperations(app, app.AppCodec(), config),
+				BlockedAddresses(),
+				config,
+				app.AppCodec(),
+			)
+			require.NoError(t, err)
+
+			if config.Commit {
+				simtestutil.PrintStats(db)
+			}
+
+			appHash := app.LastCommitID().Hash
+			appHashList[j] = appHash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
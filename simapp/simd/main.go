@@ -7,8 +7,8 @@ import (
 
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 
-	"github.com/cosmos/ibc-go/v8/testing/simapp"
-	"github.com/cosmos/ibc-go/v8/testing/simapp/simd/cmd"
+	"github.com/srdtrk/linkedpackets/simapp"
+	"github.com/srdtrk/linkedpackets/simapp/simd/cmd"
 )
 
 func main() {
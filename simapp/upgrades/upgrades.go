@@ -32,6 +32,10 @@ const (
 	V7_1 = "v7.1"
 	// V8 defines the upgrade name for the ibc-go/v8 upgrade handler.
 	V8 = "v8"
+	// LinkedPacketsV2 defines the upgrade name for the upgrade handler migrating the linkedpackets store to v2.
+	LinkedPacketsV2 = "linkedpackets-v2"
+	// LinkedPacketsV3 defines the upgrade name for the upgrade handler migrating the linkedpackets store to v3.
+	LinkedPacketsV3 = "linkedpackets-v3"
 )
 
 // CreateDefaultUpgradeHandler creates an upgrade handler which can be used for regular upgrade tests
@@ -114,3 +118,11 @@ func CreateV8UpgradeHandler(mm *module.Manager, configurator module.Configurator
 		return mm.RunMigrations(ctx, configurator, vm)
 	}
 }
+
+// CreateLinkedPacketsV2UpgradeHandler creates an upgrade handler which migrates the linkedpackets store from
+// v1 to v2. The migration itself is registered by the module and run by the module manager.
+func CreateLinkedPacketsV2UpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
@@ -0,0 +1,145 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// Simulation parameter constants
+const (
+	LinkingEnabled           = "linking_enabled"
+	MaxLinkPackets           = "max_link_packets"
+	MaxOpenLinksPerAccount   = "max_open_links_per_account"
+	LinkExpiryBlocks         = "link_expiry_blocks"
+	LinkDeposit              = "link_deposit"
+	ExpiredDepositAction     = "expired_deposit_action"
+	RateLimitWindowBlocks    = "rate_limit_window_blocks"
+	MaxLinksPerAccountWindow = "max_links_per_account_window"
+	MaxLinkedPacketsWindow   = "max_linked_packets_per_channel_window"
+	ResolvedLinkRetention    = "resolved_link_retention_blocks"
+)
+
+// RandomLinkingEnabled returns true 90% of the time, so that most simulations open links.
+func RandomLinkingEnabled(r *rand.Rand) bool {
+	return r.Intn(10) != 0
+}
+
+// RandomLimit returns a random limit up to max, or zero (no limit) 20% of the time.
+func RandomLimit(r *rand.Rand, max int) uint64 {
+	if r.Intn(5) == 0 {
+		return 0
+	}
+
+	return uint64(r.Intn(max) + 1)
+}
+
+// RandomLinkDeposit returns a random link deposit of the bond denom, or an empty deposit half of the time.
+func RandomLinkDeposit(r *rand.Rand, bondDenom string) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(r.Int63n(1000)+1)))
+}
+
+// RandomExpiredDepositAction returns a random action for the deposits of expired links.
+func RandomExpiredDepositAction(r *rand.Rand) linkedpackets.ExpiredDepositAction {
+	return linkedpackets.ExpiredDepositAction(r.Intn(len(linkedpackets.ExpiredDepositAction_name)))
+}
+
+// RandomRateLimitWindow returns a random number of blocks of the rate limit windows.
+func RandomRateLimitWindow(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100) + 1)
+}
+
+// RandomParams returns random params of the linked packets module. The packet data types which can be linked
+// are left to their default.
+func RandomParams(r *rand.Rand, bondDenom string) linkedpackets.Params {
+	params := linkedpackets.DefaultParams()
+	params.LinkingEnabled = RandomLinkingEnabled(r)
+	params.MaxLinkPackets = RandomLimit(r, 100)
+	params.MaxOpenLinksPerAccount = RandomLimit(r, 10)
+	params.LinkExpiryBlocks = RandomLimit(r, 50)
+	params.LinkDeposit = RandomLinkDeposit(r, bondDenom)
+	params.ExpiredDepositAction = RandomExpiredDepositAction(r)
+	params.RateLimitWindowBlocks = RandomRateLimitWindow(r)
+	params.MaxLinksPerAccountWindow = RandomLimit(r, 10)
+	params.MaxLinkedPacketsPerChannelWindow = RandomLimit(r, 100)
+	params.ResolvedLinkRetentionBlocks = RandomLimit(r, 50)
+
+	return params
+}
+
+// RandomizedGenState generates a random GenesisState for the linked packets module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		linkingEnabled                                    bool
+		maxLinkPackets, maxOpenLinks, linkExpiryBlocks    uint64
+		linkDeposit                                       sdk.Coins
+		expiredDepositAction                              linkedpackets.ExpiredDepositAction
+		rateLimitWindow, maxLinksWindow, maxPacketsWindow uint64
+		resolvedLinkRetention                             uint64
+	)
+
+	simState.AppParams.GetOrGenerate(LinkingEnabled, &linkingEnabled, simState.Rand, func(r *rand.Rand) {
+		linkingEnabled = RandomLinkingEnabled(r)
+	})
+	simState.AppParams.GetOrGenerate(MaxLinkPackets, &maxLinkPackets, simState.Rand, func(r *rand.Rand) {
+		maxLinkPackets = RandomLimit(r, 100)
+	})
+	simState.AppParams.GetOrGenerate(MaxOpenLinksPerAccount, &maxOpenLinks, simState.Rand, func(r *rand.Rand) {
+		maxOpenLinks = RandomLimit(r, 10)
+	})
+	simState.AppParams.GetOrGenerate(LinkExpiryBlocks, &linkExpiryBlocks, simState.Rand, func(r *rand.Rand) {
+		linkExpiryBlocks = RandomLimit(r, 50)
+	})
+	simState.AppParams.GetOrGenerate(LinkDeposit, &linkDeposit, simState.Rand, func(r *rand.Rand) {
+		linkDeposit = RandomLinkDeposit(r, simState.BondDenom)
+	})
+	simState.AppParams.GetOrGenerate(ExpiredDepositAction, &expiredDepositAction, simState.Rand, func(r *rand.Rand) {
+		expiredDepositAction = RandomExpiredDepositAction(r)
+	})
+	simState.AppParams.GetOrGenerate(RateLimitWindowBlocks, &rateLimitWindow, simState.Rand, func(r *rand.Rand) {
+		rateLimitWindow = RandomRateLimitWindow(r)
+	})
+	simState.AppParams.GetOrGenerate(MaxLinksPerAccountWindow, &maxLinksWindow, simState.Rand, func(r *rand.Rand) {
+		maxLinksWindow = RandomLimit(r, 10)
+	})
+	simState.AppParams.GetOrGenerate(MaxLinkedPacketsWindow, &maxPacketsWindow, simState.Rand, func(r *rand.Rand) {
+		maxPacketsWindow = RandomLimit(r, 100)
+	})
+	simState.AppParams.GetOrGenerate(ResolvedLinkRetention, &resolvedLinkRetention, simState.Rand, func(r *rand.Rand) {
+		resolvedLinkRetention = RandomLimit(r, 50)
+	})
+
+	params := linkedpackets.DefaultParams()
+	params.LinkingEnabled = linkingEnabled
+	params.MaxLinkPackets = maxLinkPackets
+	params.MaxOpenLinksPerAccount = maxOpenLinks
+	params.LinkExpiryBlocks = linkExpiryBlocks
+	params.LinkDeposit = linkDeposit
+	params.ExpiredDepositAction = expiredDepositAction
+	params.RateLimitWindowBlocks = rateLimitWindow
+	params.MaxLinksPerAccountWindow = maxLinksWindow
+	params.MaxLinkedPacketsPerChannelWindow = maxPacketsWindow
+	params.ResolvedLinkRetentionBlocks = resolvedLinkRetention
+
+	genesis := linkedpackets.NewGenesisState()
+	genesis.Params = params
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", linkedpackets.ModuleName, bz)
+
+	simState.GenState[linkedpackets.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
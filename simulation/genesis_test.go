@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/srdtrk/linkedpackets"
+	linkedpacketsmodule "github.com/srdtrk/linkedpackets/module"
+	"github.com/srdtrk/linkedpackets/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(linkedpacketsmodule.AppModule{})
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          encCfg.Codec,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var genesis linkedpackets.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[linkedpackets.ModuleName], &genesis)
+
+	require.NoError(t, genesis.Validate())
+	require.NotZero(t, genesis.Params.RateLimitWindowBlocks)
+	require.Equal(t, linkedpackets.DefaultParams().AllowedPacketDataTypes, genesis.Params.AllowedPacketDataTypes)
+}
+
+func TestRandomParams(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, simulation.RandomParams(r, sdk.DefaultBondDenom).Validate())
+	}
+}
@@ -0,0 +1,235 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgInitLink = "op_weight_msg_init_link"
+	OpWeightMsgStopLink = "op_weight_msg_stop_link"
+
+	DefaultWeightMsgInitLink = 100 // from simappparams.DefaultWeightMsgInitLink
+	DefaultWeightMsgStopLink = 50  // from simappparams.DefaultWeightMsgStopLink
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	_ codec.JSONCodec,
+	txGen client.TxConfig,
+	ak linkedpackets.AccountKeeper,
+	bk linkedpackets.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgInitLink, weightMsgStopLink int
+	appParams.GetOrGenerate(OpWeightMsgInitLink, &weightMsgInitLink, nil, func(_ *rand.Rand) {
+		weightMsgInitLink = DefaultWeightMsgInitLink
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgStopLink, &weightMsgStopLink, nil, func(_ *rand.Rand) {
+		weightMsgStopLink = DefaultWeightMsgStopLink
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgInitLink,
+			SimulateMsgInitLink(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgStopLink,
+			SimulateMsgStopLink(txGen, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgInitLink generates a MsgInitLink with random options, escrowing a random relayer reward. The
+// operation is skipped if the random account cannot open a link.
+func SimulateMsgInitLink(
+	txGen client.TxConfig,
+	ak linkedpackets.AccountKeeper,
+	bk linkedpackets.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&linkedpackets.MsgInitLink{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		owner := simAccount.Address.String()
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		if !params.LinkingEnabled {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "linking is disabled"), nil, nil
+		}
+
+		paused, err := k.IsLinkingPaused(ctx, "", "")
+		if err != nil {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to get the pause status"), nil, err
+		}
+		if paused {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "linking is paused"), nil, nil
+		}
+
+		hasSession, err := k.Sessions.Has(ctx, owner)
+		if err != nil {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to get the session"), nil, err
+		}
+		if hasSession {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "link already in progress"), nil, nil
+		}
+
+		openLinks, err := k.OpenLinkCounts.Get(ctx, owner)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to get the open links"), nil, err
+		}
+		if params.MaxOpenLinksPerAccount > 0 && openLinks >= params.MaxOpenLinksPerAccount {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "open link limit reached"), nil, nil
+		}
+
+		quota, err := k.AccountLinkQuota(ctx, owner)
+		if err != nil {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to get the link quota"), nil, err
+		}
+		if quota.Limit > 0 && quota.Remaining == 0 {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "link rate limit reached"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		available, hasNeg := spendable.SafeSub(params.LinkDeposit...)
+		if hasNeg {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "insufficient funds for the link deposit"), nil, nil
+		}
+
+		linkID := ""
+		if r.Intn(2) == 0 {
+			linkID = simtypes.RandStringOfLength(r, 10)
+			hasLink, err := k.Links.Has(ctx, linkID)
+			if err != nil {
+				return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to get the link"), nil, err
+			}
+			if hasLink {
+				return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "link already exists"), nil, nil
+			}
+		}
+
+		var relayerReward sdk.Coins
+		if r.Intn(2) == 0 {
+			relayerReward = simtypes.RandSubsetCoins(r, available)
+		}
+
+		msg := &linkedpackets.MsgInitLink{
+			Sender:          owner,
+			LinkId:          linkID,
+			RelayerReward:   relayerReward,
+			Atomic:          r.Intn(2) == 0,
+			AllowedChannels: randomChannels(r),
+			MaxPackets:      RandomLimit(r, 10),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			CoinsSpentInMsg: params.LinkDeposit.Add(relayerReward...),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      linkedpackets.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgStopLink generates a MsgStopLink from the owner of a random link in progress. The operation is
+// skipped if no link is in progress.
+func SimulateMsgStopLink(
+	txGen client.TxConfig,
+	ak linkedpackets.AccountKeeper,
+	bk linkedpackets.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&linkedpackets.MsgStopLink{})
+
+		var owners []simtypes.Account
+		if err := k.Sessions.Walk(ctx, nil, func(owner string, _ linkedpackets.LinkSession) (bool, error) {
+			addr, err := sdk.AccAddressFromBech32(owner)
+			if err != nil {
+				return true, err
+			}
+			if simAccount, found := simtypes.FindAccount(accs, addr); found {
+				owners = append(owners, simAccount)
+			}
+
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "unable to walk the sessions"), nil, err
+		}
+		if len(owners) == 0 {
+			return simtypes.NoOpMsg(linkedpackets.ModuleName, msgType, "no link in progress"), nil, nil
+		}
+
+		simAccount := owners[r.Intn(len(owners))]
+		msg := &linkedpackets.MsgStopLink{Sender: simAccount.Address.String()}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      linkedpackets.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomChannels returns up to 3 distinct random transfer channels, or no channel half of the time.
+func randomChannels(r *rand.Rand) []linkedpackets.ChannelIdentifier {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	n := r.Intn(3) + 1
+	channels := make([]linkedpackets.ChannelIdentifier, 0, n)
+	for _, i := range r.Perm(10)[:n] {
+		channels = append(channels, linkedpackets.ChannelIdentifier{
+			PortId:    transfertypes.PortID,
+			ChannelId: channeltypes.FormatChannelIdentifier(uint64(i)),
+		})
+	}
+
+	return channels
+}
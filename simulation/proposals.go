@@ -0,0 +1,125 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgUpdateParams     = "op_weight_msg_update_params"
+	OpWeightMsgForceCloseLink   = "op_weight_msg_force_close_link"
+	OpWeightMsgForceFailLink    = "op_weight_msg_force_fail_link"
+	OpWeightMsgSetLinkingPaused = "op_weight_msg_set_linking_paused"
+
+	DefaultWeightMsgUpdateParams     = 100 // from simappparams.DefaultWeightMsgUpdateLinkedPacketsParams
+	DefaultWeightMsgForceCloseLink   = 20  // from simappparams.DefaultWeightMsgForceCloseLink
+	DefaultWeightMsgForceFailLink    = 20  // from simappparams.DefaultWeightMsgForceFailLink
+	DefaultWeightMsgSetLinkingPaused = 20  // from simappparams.DefaultWeightMsgSetLinkingPaused
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgForceCloseLink,
+			DefaultWeightMsgForceCloseLink,
+			SimulateMsgForceCloseLink(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgForceFailLink,
+			DefaultWeightMsgForceFailLink,
+			SimulateMsgForceFailLink(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgSetLinkingPaused,
+			DefaultWeightMsgSetLinkingPaused,
+			SimulateMsgSetLinkingPaused,
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams
+func SimulateMsgUpdateParams(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &linkedpackets.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    RandomParams(r, sdk.DefaultBondDenom),
+	}
+}
+
+// SimulateMsgForceCloseLink returns a MsgForceCloseLink of a random link, or nil if there is no link.
+func SimulateMsgForceCloseLink(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		linkID, found := randomLinkID(r, ctx, k)
+		if !found {
+			return nil
+		}
+
+		var authority sdk.AccAddress = address.Module("gov")
+
+		return &linkedpackets.MsgForceCloseLink{
+			Authority: authority.String(),
+			LinkId:    linkID,
+		}
+	}
+}
+
+// SimulateMsgForceFailLink returns a MsgForceFailLink of a random link, or nil if there is no link.
+func SimulateMsgForceFailLink(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		linkID, found := randomLinkID(r, ctx, k)
+		if !found {
+			return nil
+		}
+
+		var authority sdk.AccAddress = address.Module("gov")
+
+		return &linkedpackets.MsgForceFailLink{
+			Authority: authority.String(),
+			LinkId:    linkID,
+		}
+	}
+}
+
+// SimulateMsgSetLinkingPaused returns a MsgSetLinkingPaused which pauses or resumes linking chain-wide or on
+// random channels. Linking is resumed more often than it is paused, so that links keep being opened.
+func SimulateMsgSetLinkingPaused(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &linkedpackets.MsgSetLinkingPaused{
+		Authority: authority.String(),
+		Paused:    r.Intn(3) == 0,
+		Channels:  randomChannels(r),
+	}
+}
+
+// randomLinkID returns the identifier of a random link opened on the chain.
+func randomLinkID(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (string, bool) {
+	var linkIDs []string
+	if err := k.Links.Walk(ctx, nil, func(linkID string, _ linkedpackets.Link) (bool, error) {
+		linkIDs = append(linkIDs, linkID)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(linkIDs) == 0 {
+		return "", false
+	}
+
+	return linkIDs[r.Intn(len(linkIDs))], true
+}
@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+	"github.com/srdtrk/linkedpackets/simulation"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(r, 3)
+	authority := sdk.AccAddress(address.Module("gov")).String()
+
+	weightedProposalMsgs := simulation.ProposalMsgs(keeper.Keeper{})
+	require.Len(t, weightedProposalMsgs, 4)
+
+	w0 := weightedProposalMsgs[0]
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w0.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdateParams, w0.DefaultWeight())
+
+	msg := w0.MsgSimulatorFn()(r, ctx, accounts)
+	msgUpdateParams, ok := msg.(*linkedpackets.MsgUpdateParams)
+	require.True(t, ok)
+	require.Equal(t, authority, msgUpdateParams.Authority)
+	require.NoError(t, msgUpdateParams.Params.Validate())
+
+	require.Equal(t, simulation.OpWeightMsgForceCloseLink, weightedProposalMsgs[1].AppParamsKey())
+	require.Equal(t, simulation.OpWeightMsgForceFailLink, weightedProposalMsgs[2].AppParamsKey())
+
+	w3 := weightedProposalMsgs[3]
+	require.Equal(t, simulation.OpWeightMsgSetLinkingPaused, w3.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgSetLinkingPaused, w3.DefaultWeight())
+
+	msg = w3.MsgSimulatorFn()(r, ctx, accounts)
+	msgSetLinkingPaused, ok := msg.(*linkedpackets.MsgSetLinkingPaused)
+	require.True(t, ok)
+	require.Equal(t, authority, msgSetLinkingPaused.Authority)
+	require.NoError(t, linkedpackets.ValidateChannels(msgSetLinkingPaused.Channels))
+}
@@ -0,0 +1,22 @@
+package linkedpackets
+
+// linked packets telemetry metric keys and labels. Every metric key is prefixed with the module name.
+const (
+	MetricKeyLink     = "link"
+	MetricKeyPacket   = "packet"
+	MetricKeyProposal = "proposal"
+
+	MetricKeyOpened         = "opened"
+	MetricKeyClosed         = "closed"
+	MetricKeyResolved       = "resolved"
+	MetricKeyLength         = "length"
+	MetricKeyCompletionTime = "completion_time_ms"
+	MetricKeySent           = "sent"
+	MetricKeyOutcome        = "outcome"
+	MetricKeyRejected       = "rejected"
+	MetricKeyPartialLinks   = "partial_links"
+
+	LabelStatus   = "status"
+	LabelOutcome  = "outcome"
+	LabelLinkCode = "link_ack_code"
+)
@@ -0,0 +1,31 @@
+// Package testutil provides ibctesting helpers to test app stacks which include the linked packets middleware:
+// builders of link enabled paths, a relayer of the packets of a link and assertions on the state of links.
+package testutil
+
+import (
+	"fmt"
+
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets/keeper"
+)
+
+// LinkedPacketsApp defines a testing app which includes the linked packets middleware. The apps of the chains
+// given to the helpers of this package must implement it.
+type LinkedPacketsApp interface {
+	ibctesting.TestingApp
+
+	// GetLinkedPacketsKeeper returns the keeper of the linked packets middleware.
+	GetLinkedPacketsKeeper() keeper.Keeper
+}
+
+// GetLinkedPacketsKeeper returns the linked packets keeper of the given chain. It panics if the app of the chain
+// does not implement LinkedPacketsApp.
+func GetLinkedPacketsKeeper(chain *ibctesting.TestChain) keeper.Keeper {
+	app, ok := chain.App.(LinkedPacketsApp)
+	if !ok {
+		panic(fmt.Errorf("app of chain %s does not implement LinkedPacketsApp", chain.ChainID))
+	}
+
+	return app.GetLinkedPacketsKeeper()
+}
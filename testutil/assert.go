@@ -0,0 +1,65 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// RequireLinkEnabled asserts that packet linking is enabled on the given channel of the chain.
+func RequireLinkEnabled(t testing.TB, chain *ibctesting.TestChain, portID, channelID string) {
+	t.Helper()
+
+	enabled, err := GetLinkedPacketsKeeper(chain).LinkEnabled.Has(chain.GetContext(), collections.Join(portID, channelID))
+	require.NoError(t, err)
+	require.True(t, enabled, "linking is not enabled on channel %s/%s of %s", portID, channelID, chain.ChainID)
+}
+
+// RequireLinkStatus asserts that the link with the given identifier exists on the chain with the given status. It
+// returns the link.
+func RequireLinkStatus(t testing.TB, chain *ibctesting.TestChain, linkID string, status linkedpackets.LinkStatus) linkedpackets.Link {
+	t.Helper()
+
+	link, err := GetLinkedPacketsKeeper(chain).Links.Get(chain.GetContext(), linkID)
+	require.NoError(t, err, "link %s of %s", linkID, chain.ChainID)
+	require.Equal(t, status, link.Status, "status of link %s of %s", linkID, chain.ChainID)
+
+	return link
+}
+
+// RequirePacketOutcomes asserts that the link with the given identifier exists on the chain, and that its packets
+// have the given outcomes in the order they were added to the link.
+func RequirePacketOutcomes(t testing.TB, chain *ibctesting.TestChain, linkID string, outcomes ...linkedpackets.PacketOutcome) {
+	t.Helper()
+
+	link, err := GetLinkedPacketsKeeper(chain).Links.Get(chain.GetContext(), linkID)
+	require.NoError(t, err, "link %s of %s", linkID, chain.ChainID)
+	require.Len(t, link.Packets, len(outcomes), "packets of link %s of %s", linkID, chain.ChainID)
+	for i, outcome := range outcomes {
+		require.Equal(t, outcome, link.Packets[i].Outcome, "outcome of packet %d of link %s of %s", i, linkID, chain.ChainID)
+	}
+}
+
+// RequireNoSession asserts that the given owner is not sending a link on the chain.
+func RequireNoSession(t testing.TB, chain *ibctesting.TestChain, owner string) {
+	t.Helper()
+
+	hasSession, err := GetLinkedPacketsKeeper(chain).Sessions.Has(chain.GetContext(), owner)
+	require.NoError(t, err)
+	require.False(t, hasSession, "%s is sending a link on %s", owner, chain.ChainID)
+}
+
+// RequireLinkAck asserts that the given acknowledgement is a link acknowledgement with the given code.
+func RequireLinkAck(t testing.TB, ack []byte, code linkedpackets.LinkAckCode) {
+	t.Helper()
+
+	linkAck, err := linkedpackets.LinkAcknowledgementFromBytes(ack)
+	require.NoError(t, err)
+	require.Equal(t, code, linkAck.Code, "link acknowledgement reason: %s", linkAck.Reason)
+}
@@ -0,0 +1,139 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets"
+)
+
+// LinkedVersion returns the channel version negotiating packet linking on top of the given application version.
+func LinkedVersion(appVersion string) string {
+	bz, err := json.Marshal(linkedpackets.Metadata{LinkedPacketsVersion: linkedpackets.Version, AppVersion: appVersion})
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
+
+// NewLinkedPath returns a path between chainA and chainB whose channel binds the given port on both chains and
+// negotiates packet linking on top of the given application version. The path is not set up.
+func NewLinkedPath(chainA, chainB *ibctesting.TestChain, portID, appVersion string) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+
+	version := LinkedVersion(appVersion)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointB.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+// NewLinkedTransferPath returns a link enabled transfer path between chainA and chainB. The path is not set up.
+func NewLinkedTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	return NewLinkedPath(chainA, chainB, transfertypes.PortID, transfertypes.Version)
+}
+
+// NewLinkedFeeTransferPath returns a transfer path between chainA and chainB with both packet linking and the fee
+// middleware enabled. The path is not set up.
+func NewLinkedFeeTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+
+	return NewLinkedPath(chainA, chainB, transfertypes.PortID, feeVersion)
+}
+
+// SetupLinkedICAPath sets up a link enabled interchain accounts channel on the given path, with the interchain
+// account of the given owner controlled from chainA and hosted on chainB. It returns the address of the interchain
+// account on chainB.
+func SetupLinkedICAPath(coordinator *ibctesting.Coordinator, path *ibctesting.Path, owner string) (string, error) {
+	return setupLinkedICAPath(coordinator, path, owner, false)
+}
+
+// SetupLinkedFeeICAPath is like SetupLinkedICAPath, but the fee middleware is also enabled on the channel.
+func SetupLinkedFeeICAPath(coordinator *ibctesting.Coordinator, path *ibctesting.Path, owner string) (string, error) {
+	return setupLinkedICAPath(coordinator, path, owner, true)
+}
+
+func setupLinkedICAPath(coordinator *ibctesting.Coordinator, path *ibctesting.Path, owner string, fee bool) (string, error) {
+	coordinator.SetupConnections(path)
+
+	controllerPortID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	appVersion := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	if fee {
+		appVersion = string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+			FeeVersion: ibcfeetypes.Version,
+			AppVersion: appVersion,
+		}))
+	}
+
+	version := LinkedVersion(appVersion)
+	path.SetChannelOrdered()
+	path.EndpointA.ChannelConfig.PortID = controllerPortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	// the controller opens the channel when the interchain account is registered, so the channel open init of
+	// the endpoint is skipped
+	res, err := path.EndpointA.Chain.SendMsgs(icacontrollertypes.NewMsgRegisterInterchainAccount(
+		path.EndpointA.ConnectionID, owner, version,
+	))
+	if err != nil {
+		return "", err
+	}
+
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.Events)
+	if err != nil {
+		return "", err
+	}
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return "", err
+	}
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return "", err
+	}
+	if err := path.EndpointB.ChanOpenConfirm(); err != nil {
+		return "", err
+	}
+
+	// the host returns the address of the interchain account in the negotiated version
+	metadata, err := linkedpackets.MetadataFromVersion(path.EndpointA.GetChannel().Version)
+	if err != nil {
+		return "", err
+	}
+
+	appVersion = metadata.AppVersion
+	if fee {
+		var feeMetadata ibcfeetypes.Metadata
+		if err := ibcfeetypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &feeMetadata); err != nil {
+			return "", err
+		}
+		appVersion = feeMetadata.AppVersion
+	}
+
+	var icaMetadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &icaMetadata); err != nil {
+		return "", err
+	}
+	if icaMetadata.Address == "" {
+		return "", fmt.Errorf("interchain account of %s was not registered", owner)
+	}
+
+	return icaMetadata.Address, nil
+}
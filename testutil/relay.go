@@ -0,0 +1,128 @@
+package testutil
+
+import (
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// RelayLink relays the given packets of a link sent from endpoint A of the path in the order they were added to the
+// link: every packet is received on endpoint B, then the acknowledged packets are acknowledged on endpoint A. It
+// returns the acknowledgements written by endpoint B by packet sequence. The packets of atomic links are only
+// acknowledged once the last packet of their link is received, and packets acknowledged asynchronously are not
+// acknowledged at all.
+func RelayLink(path *ibctesting.Path, linkID string, packets []channeltypes.Packet) (map[uint64][]byte, error) {
+	packets, err := linkOrder(path, linkID, packets)
+	if err != nil {
+		return nil, err
+	}
+
+	// the client of every endpoint is updated before each message since relaying a packet commits a block on
+	// both chains
+	acks := make(map[uint64][]byte)
+	for _, packet := range packets {
+		if err := path.EndpointB.UpdateClient(); err != nil {
+			return nil, err
+		}
+
+		res, err := path.EndpointB.RecvPacketWithResult(packet)
+		if err != nil {
+			return nil, err
+		}
+
+		for seq, ack := range AcknowledgementsFromEvents(res.GetEvents()) {
+			acks[seq] = ack
+		}
+	}
+
+	for _, packet := range packets {
+		ack, ok := acks[packet.Sequence]
+		if !ok {
+			continue
+		}
+
+		if err := path.EndpointA.UpdateClient(); err != nil {
+			return nil, err
+		}
+
+		if err := path.EndpointA.AcknowledgePacket(packet, ack); err != nil {
+			return nil, err
+		}
+	}
+
+	return acks, nil
+}
+
+// linkOrder returns the given packets in the order they were added to the link with the given identifier on
+// chain A of the path. It returns an error if a packet was not sent on the channel of endpoint A as part of the
+// link.
+func linkOrder(path *ibctesting.Path, linkID string, packets []channeltypes.Packet) ([]channeltypes.Packet, error) {
+	chain := path.EndpointA.Chain
+	link, err := GetLinkedPacketsKeeper(chain).Links.Get(chain.GetContext(), linkID)
+	if err != nil {
+		return nil, fmt.Errorf("link %s: %w", linkID, err)
+	}
+
+	indexes := make(map[uint64]int, len(link.Packets))
+	for i, p := range link.Packets {
+		if p.Packet.PortId != path.EndpointA.ChannelConfig.PortID || p.Packet.ChannelId != path.EndpointA.ChannelID {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(p.Packet.Seq, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		indexes[seq] = i
+	}
+
+	ordered := make([]channeltypes.Packet, len(link.Packets))
+	found := make([]bool, len(link.Packets))
+	for _, packet := range packets {
+		i, ok := indexes[packet.Sequence]
+		if !ok || packet.SourcePort != path.EndpointA.ChannelConfig.PortID || packet.SourceChannel != path.EndpointA.ChannelID {
+			return nil, fmt.Errorf("packet %s/%s/%d is not a packet of link %s sent on the path",
+				packet.SourcePort, packet.SourceChannel, packet.Sequence, linkID)
+		}
+
+		ordered[i] = packet
+		found[i] = true
+	}
+
+	var result []channeltypes.Packet
+	for i, packet := range ordered {
+		if found[i] {
+			result = append(result, packet)
+		}
+	}
+
+	return result, nil
+}
+
+// AcknowledgementsFromEvents returns the acknowledgements written in the given events by packet sequence.
+func AcknowledgementsFromEvents(events []abci.Event) map[uint64][]byte {
+	acks := make(map[uint64][]byte)
+	for _, ev := range events {
+		if ev.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		var seq uint64
+		var ack []byte
+		for _, attr := range ev.Attributes {
+			switch attr.Key {
+			case channeltypes.AttributeKeySequence:
+				seq, _ = strconv.ParseUint(attr.Value, 10, 64)
+			case channeltypes.AttributeKeyAck: //nolint:staticcheck // DEPRECATED
+				ack = []byte(attr.Value)
+			}
+		}
+		acks[seq] = ack
+	}
+
+	return acks
+}
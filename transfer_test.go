@@ -1,6 +1,7 @@
 package linkedpackets_test
 
 import (
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,7 +10,10 @@ import (
 	transfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/srdtrk/linkedpackets/testutil"
 )
 
 func (s *LinkedPacketsTestSuite) TestTransfer() {
@@ -56,20 +60,208 @@ func (s *LinkedPacketsTestSuite) TestLinkSuccess() {
 	s.Require().NotNil(packetFinal)
 	s.Require().Equal(`{"link_id":"mylinkid","prev_packet":{"port_id":"transfer","channel_id":"channel-0","seq":"3"},"last_packet":true,"initial_packet":false,"link_index":"3"}`, packetFinal.Memo)
 
-	found, err := GetSimApp(s.chainA).LinkedPacketsKeeper.LinkId.Has(s.chainA.GetContext())
+	found, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Sessions.Has(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress().String())
 	s.Require().NoError(err)
 	s.Require().False(found)
+}
+
+func (s *LinkedPacketsTestSuite) TestLinkResolution() {
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expStatus   linkedpackets.LinkStatus
+		expOutcomes []linkedpackets.PacketOutcome
+	}{
+		{
+			"success: all packets acknowledged",
+			func() {
+				s.ExecuteTransfer("1")
+				s.ExecuteTransfer(linkedpackets.LastLinkMemoKey)
+			},
+			linkedpackets.LinkStatusSucceeded,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeSuccess},
+		},
+		{
+			"success: last packet timed out",
+			func() {
+				s.ExecuteTransfer("1")
+				s.ExecuteTransferTimeout(linkedpackets.LastLinkMemoKey)
+			},
+			linkedpackets.LinkStatusPartiallyFailed,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeTimeout},
+		},
+		{
+			"success: only packet timed out",
+			func() {
+				s.ExecuteTransferTimeout(linkedpackets.LastLinkMemoKey)
+			},
+			linkedpackets.LinkStatusFailed,
+			[]linkedpackets.PacketOutcome{linkedpackets.PacketOutcomeTimeout},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupLinkedPacketsTransferTest()
+
+			s.ExecuteInitLink("mylinkid")
+
+			tc.malleate()
+
+			link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus, link.Status)
+			s.Require().Equal(s.chainA.SenderAccount.GetAddress().String(), link.Owner)
+			s.Require().Len(link.Packets, len(tc.expOutcomes))
+			for i, outcome := range tc.expOutcomes {
+				s.Require().Equal(outcome, link.Packets[i].Outcome)
+			}
+		})
+	}
+}
+
+func (s *LinkedPacketsTestSuite) TestBrokenLink() {
+	s.SetupLinkedPacketsTransferTest()
+
+	s.ExecuteInitLink("mylinkid")
+
+	var packets []channeltypes.Packet
+	for _, memo := range []string{"", linkedpackets.LastLinkMemoKey} {
+		msg := transfertypes.NewMsgTransfer(
+			s.path.EndpointA.ChannelConfig.PortID,
+			s.path.EndpointA.ChannelID,
+			ibctesting.TestCoin,
+			s.chainA.SenderAccount.GetAddress().String(),
+			s.chainB.SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(1, 100), 0, memo,
+		)
+
+		res, err := s.chainA.SendMsgs(msg)
+		s.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		s.Require().NoError(err)
+		packets = append(packets, packet)
+	}
+
+	// relay the last packet of the link before its previous packet
+	s.Require().NoError(s.path.RelayPacket(packets[1]))
+	s.Require().NoError(s.path.RelayPacket(packets[0]))
 
-	found, err = GetSimApp(s.chainA).LinkedPacketsKeeper.PrevPacket.Has(s.chainA.GetContext())
+	link, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Links.Get(s.chainA.GetContext(), "mylinkid")
 	s.Require().NoError(err)
-	s.Require().False(found)
+	s.Require().Equal(linkedpackets.LinkStatusPartiallyFailed, link.Status)
+	s.Require().Equal(linkedpackets.PacketOutcomeSuccess, link.Packets[0].Outcome)
+	s.Require().Equal(linkedpackets.PacketOutcomeLinkError, link.Packets[1].Outcome)
+
+	// the tokens of the rejected packet are refunded
+	escrowAddress := transfertypes.GetEscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	escrowBalance := GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	s.Require().Equal(ibctesting.TestCoin, escrowBalance)
+}
+
+// TestMultiChannelLink checks that a link sent over several channels to the same chain is no longer recorded on any
+// of its channels once its last packet is received.
+func (s *LinkedPacketsTestSuite) TestMultiChannelLink() {
+	s.SetupLinkedPacketsTransferTest()
+
+	// a second link enabled transfer channel on the same connection
+	pathOne := s.path
+	pathTwo := testutil.NewLinkedTransferPath(s.chainA, s.chainB)
+	pathTwo.EndpointA.ClientID = pathOne.EndpointA.ClientID
+	pathTwo.EndpointB.ClientID = pathOne.EndpointB.ClientID
+	pathTwo.EndpointA.ConnectionID = pathOne.EndpointA.ConnectionID
+	pathTwo.EndpointB.ConnectionID = pathOne.EndpointB.ConnectionID
+	s.coordinator.CreateChannels(pathTwo)
+
+	s.ExecuteInitLink("mylinkid")
+
+	s.ExecuteTransfer("")
+	s.path = pathTwo
+	s.ExecuteTransfer("")
+
+	keeperB := GetSimApp(s.chainB).LinkedPacketsKeeper
+	for _, path := range []*ibctesting.Path{pathOne, pathTwo} {
+		found, err := keeperB.ReceivedLinkPackets.Has(
+			s.chainB.GetContext(), collections.Join3(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "mylinkid"),
+		)
+		s.Require().NoError(err)
+		s.Require().True(found)
+	}
+
+	s.path = pathOne
+	s.ExecuteTransfer(linkedpackets.LastLinkMemoKey)
+
+	for _, path := range []*ibctesting.Path{pathOne, pathTwo} {
+		found, err := keeperB.ReceivedLinkPackets.Has(
+			s.chainB.GetContext(), collections.Join3(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "mylinkid"),
+		)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	}
+
+	testutil.RequireLinkStatus(s.T(), s.chainA, "mylinkid", linkedpackets.LinkStatusSucceeded)
+}
+
+func (s *LinkedPacketsTestSuite) TestRelayLink() {
+	s.SetupLinkedPacketsTransferTest()
+
+	s.ExecuteInitLink("mylinkid")
+
+	var packets []channeltypes.Packet
+	for _, memo := range []string{"", linkedpackets.LastLinkMemoKey} {
+		msg := transfertypes.NewMsgTransfer(
+			s.path.EndpointA.ChannelConfig.PortID,
+			s.path.EndpointA.ChannelID,
+			ibctesting.TestCoin,
+			s.chainA.SenderAccount.GetAddress().String(),
+			s.chainB.SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(1, 100), 0, memo,
+		)
+
+		res, err := s.chainA.SendMsgs(msg)
+		s.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		s.Require().NoError(err)
+		packets = append(packets, packet)
+	}
+
+	// packets which are not part of the link are not relayed
+	unknown := packets[0]
+	unknown.Sequence = 100
+	_, err := testutil.RelayLink(s.path, "mylinkid", []channeltypes.Packet{unknown})
+	s.Require().ErrorContains(err, "is not a packet of link mylinkid")
+
+	// the packets are relayed in the order of the link
+	acks, err := testutil.RelayLink(s.path, "mylinkid", []channeltypes.Packet{packets[1], packets[0]})
+	s.Require().NoError(err)
+	s.Require().Len(acks, len(packets))
+	for _, ack := range acks {
+		testutil.RequireLinkAck(s.T(), ack, linkedpackets.LinkAckCodeOK)
+	}
+
+	testutil.RequireLinkStatus(s.T(), s.chainA, "mylinkid", linkedpackets.LinkStatusSucceeded)
+	testutil.RequirePacketOutcomes(s.T(), s.chainA, "mylinkid", linkedpackets.PacketOutcomeSuccess, linkedpackets.PacketOutcomeSuccess)
+	testutil.RequireNoSession(s.T(), s.chainA, s.chainA.SenderAccount.GetAddress().String())
+}
+
+// TestLegacyAcknowledgement checks that the packets of a link enabled channel are resolved with the plain app
+// acknowledgements of a counterparty which does not link packets on the channel.
+func (s *LinkedPacketsTestSuite) TestLegacyAcknowledgement() {
+	s.SetupLinkedPacketsTransferTest()
 
-	isLinking, err := GetSimApp(s.chainA).LinkedPacketsKeeper.Linking.Get(s.chainA.GetContext())
+	err := GetSimApp(s.chainB).LinkedPacketsKeeper.LinkEnabled.Remove(
+		s.chainB.GetContext(), collections.Join(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID),
+	)
 	s.Require().NoError(err)
-	s.Require().False(isLinking)
 
-	_, err = GetSimApp(s.chainA).LinkedPacketsKeeper.LinkIndex.Get(s.chainA.GetContext(), "mylinkid")
-	s.Require().Error(err)
+	s.ExecuteInitLink("mylinkid")
+	s.ExecuteTransfer(linkedpackets.LastLinkMemoKey)
+
+	testutil.RequireLinkStatus(s.T(), s.chainA, "mylinkid", linkedpackets.LinkStatusSucceeded)
+	testutil.RequirePacketOutcomes(s.T(), s.chainA, "mylinkid", linkedpackets.PacketOutcomeSuccess)
 }
 
 func (s *LinkedPacketsTestSuite) TestTransferTimeout() {
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -35,8 +37,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgInitLink struct {
 	// sender is the message sender.
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// link_id is the link identifier.
+	// link_id is the link identifier. If empty, a link identifier is generated.
 	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// compensations are the optional compensating interchain account transactions of the link members.
+	// If a member of the link fails, the compensations of the members that succeeded are sent in reverse
+	// link index order.
+	Compensations []Compensation `protobuf:"bytes,3,rep,name=compensations,proto3" json:"compensations"`
+	// relayer_reward is the optional reward escrowed from the sender and paid to the relayers of the link
+	// once every member of the link is acknowledged.
+	RelayerReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=relayer_reward,json=relayerReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_reward"`
+	// src_callback_address is the optional address of the contract called on this chain once the link is
+	// resolved.
+	SrcCallbackAddress string `protobuf:"bytes,5,opt,name=src_callback_address,json=srcCallbackAddress,proto3" json:"src_callback_address,omitempty"`
+	// dest_callback_address is the optional address of the contract called on the receiving chain once the
+	// last packet of the link is received.
+	DestCallbackAddress string `protobuf:"bytes,6,opt,name=dest_callback_address,json=destCallbackAddress,proto3" json:"dest_callback_address,omitempty"`
+	// atomic requests the interchain accounts host to execute the packets of the link together once its last
+	// packet is received, and to fail all of them if any of them fails. The packets of an atomic link must be
+	// interchain accounts packets sent on the same channel.
+	Atomic bool `protobuf:"varint,7,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// allowed_channels optionally restricts the channels on which the packets of the link can be sent.
+	AllowedChannels []ChannelIdentifier `protobuf:"bytes,8,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	// max_packets is the optional maximum number of packets sent as part of the link. Zero means no limit
+	// besides the limit of the module parameters.
+	MaxPackets uint64 `protobuf:"varint,9,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
 }
 
 func (m *MsgInitLink) Reset()         { *m = MsgInitLink{} }
@@ -86,8 +110,59 @@ func (m *MsgInitLink) GetLinkId() string {
 	return ""
 }
 
+func (m *MsgInitLink) GetCompensations() []Compensation {
+	if m != nil {
+		return m.Compensations
+	}
+	return nil
+}
+
+func (m *MsgInitLink) GetRelayerReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerReward
+	}
+	return nil
+}
+
+func (m *MsgInitLink) GetSrcCallbackAddress() string {
+	if m != nil {
+		return m.SrcCallbackAddress
+	}
+	return ""
+}
+
+func (m *MsgInitLink) GetDestCallbackAddress() string {
+	if m != nil {
+		return m.DestCallbackAddress
+	}
+	return ""
+}
+
+func (m *MsgInitLink) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+func (m *MsgInitLink) GetAllowedChannels() []ChannelIdentifier {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *MsgInitLink) GetMaxPackets() uint64 {
+	if m != nil {
+		return m.MaxPackets
+	}
+	return 0
+}
+
 // MsgInitLinkResponse defines the Msg/InitLink response type.
 type MsgInitLinkResponse struct {
+	// link_id is the identifier of the started link.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 }
 
 func (m *MsgInitLinkResponse) Reset()         { *m = MsgInitLinkResponse{} }
@@ -123,6 +198,13 @@ func (m *MsgInitLinkResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInitLinkResponse proto.InternalMessageInfo
 
+func (m *MsgInitLinkResponse) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
+}
+
 // MsgStopLink defines the message stopping packet linking.
 type MsgStopLink struct {
 	// sender is the message sender.
@@ -301,6 +383,328 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgForceCloseLink is the Msg/ForceCloseLink request type.
+type MsgForceCloseLink struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// link_id is the identifier of the link to close.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// port_id and channel_id identify the channel the link is received on by this chain. They are left empty to
+	// close the link sent by this chain instead.
+	PortId    string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgForceCloseLink) Reset()         { *m = MsgForceCloseLink{} }
+func (m *MsgForceCloseLink) String() string { return proto.CompactTextString(m) }
+func (*MsgForceCloseLink) ProtoMessage()    {}
+func (*MsgForceCloseLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd60cf9e8ce3e36, []int{6}
+}
+func (m *MsgForceCloseLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceCloseLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceCloseLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceCloseLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceCloseLink.Merge(m, src)
+}
+func (m *MsgForceCloseLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceCloseLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceCloseLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceCloseLink proto.InternalMessageInfo
+
+func (m *MsgForceCloseLink) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceCloseLink) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
+}
+
+func (m *MsgForceCloseLink) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgForceCloseLink) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgForceCloseLinkResponse defines the Msg/ForceCloseLink response type.
+type MsgForceCloseLinkResponse struct {
+}
+
+func (m *MsgForceCloseLinkResponse) Reset()         { *m = MsgForceCloseLinkResponse{} }
+func (m *MsgForceCloseLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceCloseLinkResponse) ProtoMessage()    {}
+func (*MsgForceCloseLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd60cf9e8ce3e36, []int{7}
+}
+func (m *MsgForceCloseLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceCloseLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceCloseLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceCloseLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceCloseLinkResponse.Merge(m, src)
+}
+func (m *MsgForceCloseLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceCloseLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceCloseLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceCloseLinkResponse proto.InternalMessageInfo
+
+// MsgForceFailLink is the Msg/ForceFailLink request type.
+type MsgForceFailLink struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// link_id is the identifier of the link to fail.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// port_id and channel_id identify the channel the link is received on by this chain. They are left empty to
+	// fail the link sent by this chain instead.
+	PortId    string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgForceFailLink) Reset()         { *m = MsgForceFailLink{} }
+func (m *MsgForceFailLink) String() string { return proto.CompactTextString(m) }
+func (*MsgForceFailLink) ProtoMessage()    {}
+func (*MsgForceFailLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd60cf9e8ce3e36, []int{8}
+}
+func (m *MsgForceFailLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceFailLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceFailLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceFailLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceFailLink.Merge(m, src)
+}
+func (m *MsgForceFailLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceFailLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceFailLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceFailLink proto.InternalMessageInfo
+
+func (m *MsgForceFailLink) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceFailLink) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
+}
+
+func (m *MsgForceFailLink) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgForceFailLink) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgForceFailLinkResponse defines the Msg/ForceFailLink response type.
+type MsgForceFailLinkResponse struct {
+}
+
+func (m *MsgForceFailLinkResponse) Reset()         { *m = MsgForceFailLinkResponse{} }
+func (m *MsgForceFailLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceFailLinkResponse) ProtoMessage()    {}
+func (*MsgForceFailLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd60cf9e8ce3e36, []int{9}
+}
+func (m *MsgForceFailLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceFailLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceFailLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceFailLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceFailLinkResponse.Merge(m, src)
+}
+func (m *MsgForceFailLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceFailLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceFailLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceFailLinkResponse proto.InternalMessageInfo
+
+// MsgSetLinkingPaused is the Msg/SetLinkingPaused request type.
+type MsgSetLinkingPaused struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused pauses linking if true and resumes it otherwise.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// channels are the channels on which linking is paused or resumed. Linking is paused or resumed chain-wide
+	// if empty. Resuming linking chain-wide does not resume the channels paused individually.
+	Channels []ChannelIdentifier `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *MsgSetLinkingPaused) Reset()         { *m = MsgSetLinkingPaused{} }
+func (m *MsgSetLinkingPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLinkingPaused) ProtoMessage()    {}
+func (*MsgSetLinkingPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd60cf9e8ce3e36, []int{10}
+}
+func (m *MsgSetLinkingPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLinkingPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLinkingPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLinkingPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLinkingPaused.Merge(m, src)
+}
+func (m *MsgSetLinkingPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLinkingPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLinkingPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLinkingPaused proto.InternalMessageInfo
+
+func (m *MsgSetLinkingPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetLinkingPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MsgSetLinkingPaused) GetChannels() []ChannelIdentifier {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// MsgSetLinkingPausedResponse defines the Msg/SetLinkingPaused response type.
+type MsgSetLinkingPausedResponse struct {
+}
+
+func (m *MsgSetLinkingPausedResponse) Reset()         { *m = MsgSetLinkingPausedResponse{} }
+func (m *MsgSetLinkingPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLinkingPausedResponse) ProtoMessage()    {}
+func (*MsgSetLinkingPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd60cf9e8ce3e36, []int{11}
+}
+func (m *MsgSetLinkingPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLinkingPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLinkingPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLinkingPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLinkingPausedResponse.Merge(m, src)
+}
+func (m *MsgSetLinkingPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLinkingPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLinkingPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLinkingPausedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgInitLink)(nil), "srdtrk.linkedpackets.v1.MsgInitLink")
 	proto.RegisterType((*MsgInitLinkResponse)(nil), "srdtrk.linkedpackets.v1.MsgInitLinkResponse")
@@ -308,41 +712,76 @@ func init() {
 	proto.RegisterType((*MsgStopLinkResponse)(nil), "srdtrk.linkedpackets.v1.MsgStopLinkResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "srdtrk.linkedpackets.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "srdtrk.linkedpackets.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgForceCloseLink)(nil), "srdtrk.linkedpackets.v1.MsgForceCloseLink")
+	proto.RegisterType((*MsgForceCloseLinkResponse)(nil), "srdtrk.linkedpackets.v1.MsgForceCloseLinkResponse")
+	proto.RegisterType((*MsgForceFailLink)(nil), "srdtrk.linkedpackets.v1.MsgForceFailLink")
+	proto.RegisterType((*MsgForceFailLinkResponse)(nil), "srdtrk.linkedpackets.v1.MsgForceFailLinkResponse")
+	proto.RegisterType((*MsgSetLinkingPaused)(nil), "srdtrk.linkedpackets.v1.MsgSetLinkingPaused")
+	proto.RegisterType((*MsgSetLinkingPausedResponse)(nil), "srdtrk.linkedpackets.v1.MsgSetLinkingPausedResponse")
 }
 
 func init() { proto.RegisterFile("srdtrk/linkedpackets/v1/tx.proto", fileDescriptor_2dd60cf9e8ce3e36) }
 
 var fileDescriptor_2dd60cf9e8ce3e36 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x2e, 0x4a, 0x29,
-	0x29, 0xca, 0xd6, 0xcf, 0xc9, 0xcc, 0xcb, 0x4e, 0x4d, 0x29, 0x48, 0x4c, 0xce, 0x4e, 0x2d, 0x29,
-	0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x43, 0x51, 0xa1, 0x57, 0x66, 0x28, 0x25, 0x9e, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f,
-	0x5b, 0x9c, 0x0e, 0xd2, 0x90, 0x5b, 0x9c, 0x0e, 0xd1, 0x21, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x15,
-	0x52, 0xc6, 0x69, 0x79, 0x65, 0x41, 0x6a, 0x31, 0x54, 0x91, 0x24, 0xc4, 0x9a, 0x78, 0x88, 0x81,
-	0x10, 0x0e, 0x44, 0x4a, 0xa9, 0x80, 0x8b, 0xdb, 0xb7, 0x38, 0xdd, 0x33, 0x2f, 0xb3, 0xc4, 0x27,
-	0x33, 0x2f, 0x5b, 0x48, 0x8c, 0x8b, 0xad, 0x38, 0x35, 0x2f, 0x25, 0xb5, 0x48, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0x33, 0x08, 0xca, 0x13, 0x12, 0xe7, 0x62, 0x07, 0xd9, 0x10, 0x9f, 0x99, 0x22, 0xc1,
-	0x04, 0x91, 0x00, 0x71, 0x3d, 0x53, 0xac, 0x0c, 0x9a, 0x9e, 0x6f, 0xd0, 0x82, 0xaa, 0xea, 0x7a,
-	0xbe, 0x41, 0x0b, 0x7b, 0x60, 0x20, 0x59, 0xa1, 0x24, 0xca, 0x25, 0x8c, 0xc4, 0x0d, 0x4a, 0x2d,
-	0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0x0a, 0x07, 0x3b, 0x24, 0xb8, 0x24, 0xbf, 0x00, 0x9f, 0x43,
-	0x88, 0xb7, 0x0f, 0x66, 0x12, 0xd4, 0x3e, 0x18, 0x17, 0x6e, 0xdf, 0x31, 0x46, 0x2e, 0x7e, 0xdf,
-	0xe2, 0xf4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x33,
-	0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x88, 0xbd, 0x4e, 0x12, 0x97,
-	0xb6, 0xe8, 0x8a, 0x40, 0x43, 0xcc, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x89, 0x8b, 0xad, 0x00, 0x6c, 0x02, 0x38, 0x70, 0xb8,
-	0x8d, 0xe4, 0xf5, 0x70, 0x44, 0xb8, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56,
-	0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x69, 0x65, 0x0e, 0xf2, 0x18, 0xc2, 0x4c, 0x90, 0xdf,
-	0x54, 0x70, 0xf9, 0x0d, 0xd9, 0xd1, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x42, 0x30, 0x3f, 0x1a, 0xed,
-	0x60, 0xe2, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe3, 0xe2, 0x80, 0xc7, 0xb0, 0x0a, 0x4e, 0xb7,
-	0x21, 0xc5, 0x8a, 0x94, 0x0e, 0x31, 0xaa, 0x60, 0xf6, 0x80, 0xcc, 0x87, 0x47, 0x1c, 0x5e, 0xf3,
-	0x61, 0xaa, 0xf0, 0x9b, 0x8f, 0x1e, 0x57, 0x42, 0x59, 0x5c, 0x3c, 0x28, 0xf1, 0xa4, 0x81, 0x4f,
-	0x37, 0xb2, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xec, 0x92, 0x62, 0x6d, 0x00, 0x45, 0x8b, 0x93,
-	0xe5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc9, 0xa7, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x63, 0x8b, 0xa0, 0x24, 0x36, 0x70, 0xce, 0x32, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x18, 0x64, 0x91, 0xe8, 0x18, 0x04, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x62, 0xc7, 0xb1, 0x5f, 0x9a, 0x36, 0xdd, 0xa4, 0xf1, 0x66, 0xab, 0xda, 0x96, 0x69,
+	0x91, 0xb1, 0xda, 0x75, 0x6c, 0x10, 0xd0, 0x70, 0xaa, 0x23, 0x55, 0x8a, 0xd4, 0x48, 0x61, 0x2b,
+	0x84, 0x04, 0x52, 0xad, 0xf1, 0xee, 0xb0, 0x59, 0xbc, 0xbb, 0xb3, 0x9a, 0x99, 0xa4, 0xc9, 0x0d,
+	0xc1, 0x8d, 0x13, 0x5c, 0xf9, 0x05, 0x88, 0x53, 0x0e, 0xfc, 0x05, 0x50, 0x8f, 0x15, 0x12, 0x82,
+	0x13, 0xa0, 0xe4, 0x90, 0x2b, 0x3f, 0x01, 0xcd, 0xee, 0xec, 0x76, 0x6d, 0x67, 0x8d, 0xc9, 0xa9,
+	0x97, 0xc4, 0x6f, 0xde, 0xf7, 0xbe, 0x37, 0xdf, 0x9b, 0xf7, 0x9e, 0x0d, 0x0d, 0x46, 0x6d, 0x4e,
+	0x47, 0x1d, 0xcf, 0x0d, 0x46, 0xd8, 0x0e, 0x91, 0x35, 0xc2, 0x9c, 0x75, 0x8e, 0xba, 0x1d, 0x7e,
+	0x6c, 0x84, 0x94, 0x70, 0xa2, 0x56, 0x63, 0x84, 0x31, 0x86, 0x30, 0x8e, 0xba, 0x7a, 0xd5, 0x22,
+	0xcc, 0x27, 0xac, 0xe3, 0x33, 0x47, 0x04, 0xf8, 0xcc, 0x89, 0x23, 0xf4, 0x75, 0x87, 0x38, 0x24,
+	0xfa, 0xd8, 0x11, 0x9f, 0xe4, 0xe9, 0x4d, 0xe4, 0xbb, 0x01, 0xe9, 0x44, 0x7f, 0xe5, 0x51, 0x4d,
+	0x32, 0x0c, 0x11, 0xc3, 0x9d, 0xa3, 0xee, 0x10, 0x73, 0xd4, 0xed, 0x58, 0xc4, 0x0d, 0xa4, 0xff,
+	0xcd, 0xdc, 0xcb, 0x9d, 0x84, 0x98, 0x49, 0xd0, 0x66, 0x4c, 0x32, 0x88, 0x13, 0xc6, 0x46, 0xec,
+	0x6a, 0xfe, 0x5c, 0x84, 0xe5, 0x3d, 0xe6, 0xec, 0x06, 0x2e, 0x7f, 0xe2, 0x06, 0x23, 0x75, 0x03,
+	0x4a, 0x0c, 0x07, 0x36, 0xa6, 0x9a, 0xd2, 0x50, 0x5a, 0x15, 0x53, 0x5a, 0x6a, 0x15, 0x96, 0x44,
+	0x8a, 0x81, 0x6b, 0x6b, 0x6f, 0xc4, 0x0e, 0x61, 0xee, 0xda, 0xea, 0x47, 0xb0, 0x62, 0x11, 0x3f,
+	0xc4, 0x01, 0x43, 0xdc, 0x25, 0x01, 0xd3, 0x0a, 0x8d, 0x42, 0x6b, 0xb9, 0x77, 0xcf, 0xc8, 0xa9,
+	0x89, 0xb1, 0x93, 0x41, 0xf7, 0x8b, 0x2f, 0xfe, 0xac, 0x2f, 0x98, 0xe3, 0x0c, 0xea, 0xd7, 0x0a,
+	0x5c, 0xa7, 0xd8, 0x43, 0x27, 0x98, 0x0e, 0x28, 0x7e, 0x8e, 0xa8, 0xad, 0x15, 0x23, 0xd2, 0x4d,
+	0x43, 0xde, 0x5d, 0x54, 0xc3, 0x90, 0xd5, 0x30, 0x76, 0x88, 0x1b, 0xf4, 0x1f, 0x09, 0xa2, 0x1f,
+	0xff, 0xaa, 0xb7, 0x1c, 0x97, 0x1f, 0x1c, 0x0e, 0x0d, 0x8b, 0xf8, 0x52, 0xa8, 0xfc, 0xf7, 0x80,
+	0xd9, 0x23, 0x59, 0x14, 0x11, 0xc0, 0xbe, 0xbf, 0x38, 0x6d, 0x5f, 0xf3, 0xb0, 0x83, 0xac, 0x93,
+	0x81, 0xa8, 0x27, 0x33, 0x57, 0x64, 0x4e, 0x33, 0x4a, 0xa9, 0x6e, 0xc1, 0x3a, 0xa3, 0xd6, 0xc0,
+	0x42, 0x9e, 0x37, 0x44, 0xd6, 0x68, 0x80, 0x6c, 0x9b, 0x62, 0xc6, 0xb4, 0xc5, 0x48, 0xbe, 0xca,
+	0xa8, 0xb5, 0x23, 0x5d, 0x8f, 0x62, 0x8f, 0xda, 0x83, 0x5b, 0x36, 0x66, 0x7c, 0x3a, 0xa4, 0x14,
+	0x85, 0xac, 0x09, 0xe7, 0x64, 0xcc, 0x06, 0x94, 0x10, 0x27, 0xbe, 0x6b, 0x69, 0x4b, 0x0d, 0xa5,
+	0x55, 0x36, 0xa5, 0xa5, 0x7e, 0x06, 0xab, 0xc8, 0xf3, 0xc8, 0x73, 0x6c, 0x0f, 0xac, 0x03, 0x14,
+	0x04, 0xd8, 0x63, 0x5a, 0x39, 0x2a, 0x42, 0x3b, 0xbf, 0xb2, 0x31, 0x70, 0xd7, 0xc6, 0x01, 0x77,
+	0x3f, 0x77, 0x31, 0x95, 0xe5, 0xbd, 0x21, 0x99, 0xa4, 0x9f, 0xa9, 0x75, 0x58, 0xf6, 0xd1, 0xf1,
+	0x40, 0x86, 0x6a, 0x95, 0x86, 0xd2, 0x2a, 0x9a, 0xe0, 0xa3, 0xe3, 0xfd, 0xf8, 0x64, 0x7b, 0xeb,
+	0xab, 0x8b, 0xd3, 0xb6, 0x7c, 0xfa, 0x6f, 0x2e, 0x4e, 0xdb, 0x97, 0x8f, 0x40, 0xa6, 0x6f, 0x9a,
+	0x06, 0xac, 0x65, 0x4c, 0x13, 0xb3, 0x90, 0x04, 0x0c, 0x67, 0xdb, 0x46, 0xc9, 0xb6, 0x4d, 0xf3,
+	0x93, 0xa8, 0xed, 0x9e, 0x72, 0x12, 0xce, 0x6a, 0xbb, 0xf9, 0x2f, 0x92, 0x30, 0x35, 0x6f, 0xc1,
+	0x5a, 0xc6, 0x4c, 0x2e, 0xd2, 0xfc, 0x45, 0x81, 0x1b, 0x7b, 0xcc, 0xf9, 0x38, 0xb4, 0x11, 0xc7,
+	0xfb, 0x88, 0x22, 0x9f, 0xa9, 0xef, 0x41, 0x05, 0x1d, 0xf2, 0x03, 0x42, 0x5d, 0x7e, 0x12, 0xe7,
+	0xed, 0x6b, 0xbf, 0xfe, 0xf4, 0x60, 0x5d, 0x36, 0x99, 0x7c, 0xa2, 0xa7, 0x9c, 0xba, 0x81, 0x63,
+	0xbe, 0x82, 0xaa, 0x7d, 0x28, 0x85, 0x11, 0x43, 0x34, 0x0a, 0xcb, 0xbd, 0x7a, 0xee, 0x8b, 0xc4,
+	0x89, 0xfa, 0x15, 0xf1, 0x0c, 0x3f, 0x5c, 0x9c, 0xb6, 0x15, 0x53, 0x46, 0x6e, 0xbf, 0x2f, 0x84,
+	0xbd, 0xe2, 0x14, 0xda, 0xee, 0xe6, 0x69, 0xcb, 0x5e, 0xba, 0xb9, 0x09, 0xd5, 0x89, 0xa3, 0x54,
+	0xe3, 0xef, 0x0a, 0xdc, 0xdc, 0x63, 0xce, 0x63, 0x42, 0x2d, 0xbc, 0xe3, 0x11, 0x86, 0xa3, 0xd2,
+	0x5e, 0x55, 0x65, 0xee, 0xc4, 0x57, 0x61, 0x29, 0x24, 0x94, 0x0b, 0x47, 0x21, 0x76, 0x08, 0x73,
+	0xd7, 0x56, 0xef, 0x00, 0xc8, 0x5e, 0x15, 0xbe, 0x62, 0xe4, 0xab, 0x58, 0x49, 0x53, 0x6e, 0x3f,
+	0x9c, 0x96, 0xfc, 0x56, 0x9e, 0xe4, 0x71, 0x0d, 0xcd, 0xdb, 0xb0, 0x39, 0x75, 0x98, 0xca, 0xfe,
+	0x4d, 0x81, 0xd5, 0xc4, 0xfb, 0x18, 0xb9, 0xde, 0xeb, 0xa5, 0xfa, 0x83, 0x69, 0xd5, 0xf7, 0x66,
+	0xaa, 0x4e, 0x24, 0x34, 0x75, 0xd0, 0x26, 0xcf, 0x52, 0xcd, 0xff, 0x28, 0x71, 0x9b, 0xe3, 0x68,
+	0xdc, 0xdc, 0xc0, 0xd9, 0x47, 0x87, 0x0c, 0xdb, 0x57, 0x96, 0xbd, 0x21, 0x5a, 0x5a, 0x30, 0x44,
+	0xaa, 0xcb, 0xa6, 0xb4, 0xd4, 0x27, 0x50, 0x4e, 0xd7, 0x4f, 0xe1, 0x8a, 0xeb, 0x27, 0x65, 0xd8,
+	0xfe, 0x70, 0xba, 0x16, 0xad, 0xdc, 0x81, 0x9e, 0x90, 0xd6, 0xbc, 0x03, 0xb7, 0x2f, 0x39, 0x4e,
+	0x2a, 0xd2, 0xfb, 0x6e, 0x11, 0x0a, 0x7b, 0xcc, 0x51, 0x9f, 0x41, 0x39, 0xfd, 0x32, 0xbb, 0x9b,
+	0x7b, 0xd7, 0xcc, 0xae, 0xd2, 0xef, 0xcf, 0x83, 0x4a, 0x37, 0xda, 0x33, 0x28, 0xa7, 0x5b, 0x6b,
+	0x26, 0x7f, 0x82, 0xd2, 0xef, 0xcf, 0x83, 0x4a, 0xf9, 0xbf, 0x80, 0x6b, 0x63, 0x4b, 0xaa, 0x35,
+	0x2b, 0x3a, 0x8b, 0xd4, 0xb7, 0xe6, 0x45, 0xa6, 0xb9, 0x42, 0xb8, 0x3e, 0xb1, 0x2c, 0xda, 0xb3,
+	0x38, 0xc6, 0xb1, 0x7a, 0x6f, 0x7e, 0x6c, 0x9a, 0xd1, 0x87, 0x95, 0xf1, 0x39, 0x7d, 0xfb, 0x3f,
+	0x49, 0x12, 0xa8, 0xde, 0x9d, 0x1b, 0x9a, 0xa6, 0x3b, 0x82, 0xd5, 0xa9, 0x11, 0x99, 0xfd, 0x1c,
+	0x13, 0x68, 0xfd, 0xdd, 0xff, 0x83, 0x4e, 0xf2, 0xea, 0x8b, 0x5f, 0x8a, 0x65, 0xdf, 0x7f, 0xf8,
+	0xe2, 0xac, 0xa6, 0xbc, 0x3c, 0xab, 0x29, 0x7f, 0x9f, 0xd5, 0x94, 0x6f, 0xcf, 0x6b, 0x0b, 0x2f,
+	0xcf, 0x6b, 0x0b, 0x7f, 0x9c, 0xd7, 0x16, 0x3e, 0xad, 0x67, 0x7e, 0xa6, 0x5c, 0x36, 0x01, 0xc3,
+	0x52, 0xf4, 0xf3, 0xec, 0x9d, 0x7f, 0x07, 0x00, 0x9f, 0x49, 0x48, 0x53, 0x7d, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +802,15 @@ type MsgClient interface {
 	StopLink(ctx context.Context, in *MsgStopLink, opts ...grpc.CallOption) (*MsgStopLinkResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ForceCloseLink closes a stuck link sent by this chain, or discards the state of a stuck link received on a
+	// channel of this chain. It is gated by the module authority.
+	ForceCloseLink(ctx context.Context, in *MsgForceCloseLink, opts ...grpc.CallOption) (*MsgForceCloseLinkResponse, error)
+	// ForceFailLink closes a stuck link sent by this chain and fails its pending packets, or discards the state of a
+	// stuck link received on a channel of this chain. It is gated by the module authority.
+	ForceFailLink(ctx context.Context, in *MsgForceFailLink, opts ...grpc.CallOption) (*MsgForceFailLinkResponse, error)
+	// SetLinkingPaused pauses or resumes linking chain-wide or on some channels. It is gated by the module
+	// authority.
+	SetLinkingPaused(ctx context.Context, in *MsgSetLinkingPaused, opts ...grpc.CallOption) (*MsgSetLinkingPausedResponse, error)
 }
 
 type msgClient struct {
@@ -400,6 +848,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ForceCloseLink(ctx context.Context, in *MsgForceCloseLink, opts ...grpc.CallOption) (*MsgForceCloseLinkResponse, error) {
+	out := new(MsgForceCloseLinkResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Msg/ForceCloseLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceFailLink(ctx context.Context, in *MsgForceFailLink, opts ...grpc.CallOption) (*MsgForceFailLinkResponse, error) {
+	out := new(MsgForceFailLinkResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Msg/ForceFailLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLinkingPaused(ctx context.Context, in *MsgSetLinkingPaused, opts ...grpc.CallOption) (*MsgSetLinkingPausedResponse, error) {
+	out := new(MsgSetLinkingPausedResponse)
+	err := c.cc.Invoke(ctx, "/srdtrk.linkedpackets.v1.Msg/SetLinkingPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// InitLink starts the packet linking with this Tx.
@@ -408,6 +883,15 @@ type MsgServer interface {
 	StopLink(context.Context, *MsgStopLink) (*MsgStopLinkResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ForceCloseLink closes a stuck link sent by this chain, or discards the state of a stuck link received on a
+	// channel of this chain. It is gated by the module authority.
+	ForceCloseLink(context.Context, *MsgForceCloseLink) (*MsgForceCloseLinkResponse, error)
+	// ForceFailLink closes a stuck link sent by this chain and fails its pending packets, or discards the state of a
+	// stuck link received on a channel of this chain. It is gated by the module authority.
+	ForceFailLink(context.Context, *MsgForceFailLink) (*MsgForceFailLinkResponse, error)
+	// SetLinkingPaused pauses or resumes linking chain-wide or on some channels. It is gated by the module
+	// authority.
+	SetLinkingPaused(context.Context, *MsgSetLinkingPaused) (*MsgSetLinkingPausedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -423,6 +907,15 @@ func (*UnimplementedMsgServer) StopLink(ctx context.Context, req *MsgStopLink) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ForceCloseLink(ctx context.Context, req *MsgForceCloseLink) (*MsgForceCloseLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCloseLink not implemented")
+}
+func (*UnimplementedMsgServer) ForceFailLink(ctx context.Context, req *MsgForceFailLink) (*MsgForceFailLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceFailLink not implemented")
+}
+func (*UnimplementedMsgServer) SetLinkingPaused(ctx context.Context, req *MsgSetLinkingPaused) (*MsgSetLinkingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkingPaused not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -482,12 +975,66 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "srdtrk.linkedpackets.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InitLink",
+func _Msg_ForceCloseLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceCloseLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceCloseLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Msg/ForceCloseLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceCloseLink(ctx, req.(*MsgForceCloseLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceFailLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceFailLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceFailLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Msg/ForceFailLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceFailLink(ctx, req.(*MsgForceFailLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLinkingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLinkingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLinkingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/srdtrk.linkedpackets.v1.Msg/SetLinkingPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLinkingPaused(ctx, req.(*MsgSetLinkingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "srdtrk.linkedpackets.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitLink",
 			Handler:    _Msg_InitLink_Handler,
 		},
 		{
@@ -498,6 +1045,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ForceCloseLink",
+			Handler:    _Msg_ForceCloseLink_Handler,
+		},
+		{
+			MethodName: "ForceFailLink",
+			Handler:    _Msg_ForceFailLink_Handler,
+		},
+		{
+			MethodName: "SetLinkingPaused",
+			Handler:    _Msg_SetLinkingPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "srdtrk/linkedpackets/v1/tx.proto",
@@ -523,6 +1082,77 @@ func (m *MsgInitLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPackets != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DestCallbackAddress) > 0 {
+		i -= len(m.DestCallbackAddress)
+		copy(dAtA[i:], m.DestCallbackAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestCallbackAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SrcCallbackAddress) > 0 {
+		i -= len(m.SrcCallbackAddress)
+		copy(dAtA[i:], m.SrcCallbackAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcCallbackAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RelayerReward) > 0 {
+		for iNdEx := len(m.RelayerReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Compensations) > 0 {
+		for iNdEx := len(m.Compensations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Compensations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.LinkId) > 0 {
 		i -= len(m.LinkId)
 		copy(dAtA[i:], m.LinkId)
@@ -560,6 +1190,13 @@ func (m *MsgInitLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -679,96 +1316,1154 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgForceCloseLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgInitLink) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgForceCloseLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceCloseLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.LinkId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInitLinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgForceCloseLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceCloseLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceCloseLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStopLink) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgForceFailLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceFailLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceFailLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStopLinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgForceFailLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceFailLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceFailLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetLinkingPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLinkingPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLinkingPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetLinkingPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLinkingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLinkingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInitLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Compensations) > 0 {
+		for _, e := range m.Compensations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RelayerReward) > 0 {
+		for _, e := range m.RelayerReward {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SrcCallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestCallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Atomic {
+		n += 2
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxPackets != 0 {
+		n += 1 + sovTx(uint64(m.MaxPackets))
+	}
+	return n
+}
+
+func (m *MsgInitLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStopLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStopLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceCloseLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceCloseLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceFailLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceFailLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLinkingPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetLinkingPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgInitLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compensations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compensations = append(m.Compensations, Compensation{})
+			if err := m.Compensations[len(m.Compensations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerReward = append(m.RelayerReward, types.Coin{})
+			if err := m.RelayerReward[len(m.RelayerReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcCallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcCallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestCallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestCallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, ChannelIdentifier{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStopLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStopLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStopLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStopLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStopLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStopLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgInitLink) Unmarshal(dAtA []byte) error {
+func (m *MsgForceCloseLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -791,15 +2486,15 @@ func (m *MsgInitLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceCloseLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceCloseLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -827,7 +2522,7 @@ func (m *MsgInitLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -861,6 +2556,70 @@ func (m *MsgInitLink) Unmarshal(dAtA []byte) error {
 			}
 			m.LinkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -882,7 +2641,7 @@ func (m *MsgInitLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitLinkResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceCloseLinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,10 +2664,10 @@ func (m *MsgInitLinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitLinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceCloseLinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceCloseLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -932,7 +2691,7 @@ func (m *MsgInitLinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStopLink) Unmarshal(dAtA []byte) error {
+func (m *MsgForceFailLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,15 +2714,15 @@ func (m *MsgStopLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStopLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceFailLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStopLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceFailLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -991,7 +2750,103 @@ func (m *MsgStopLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1014,7 +2869,7 @@ func (m *MsgStopLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStopLinkResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceFailLinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,10 +2892,10 @@ func (m *MsgStopLinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStopLinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceFailLinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStopLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceFailLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1064,7 +2919,7 @@ func (m *MsgStopLinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLinkingPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,10 +2942,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLinkingPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLinkingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1126,8 +2981,28 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1154,7 +3029,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Channels = append(m.Channels, ChannelIdentifier{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1179,7 +3055,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLinkingPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,10 +3078,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLinkingPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLinkingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,12 +4,29 @@ import "encoding/json"
 
 const LastLinkMemoKey = "last_link"
 
+// ForwardMemoKey is the memo key of the forward instructions of the packet forward middleware. The packets sent
+// while a linked packet with forward instructions is received are hops of the link.
+const ForwardMemoKey = "forward"
+
+const (
+	// CallbackTypeLinkResolved is the type of the callback made on the sending chain once a link is resolved.
+	CallbackTypeLinkResolved = "link_resolved"
+	// CallbackTypeLinkReceived is the type of the callback made on the receiving chain once the last packet
+	// of a link is received.
+	CallbackTypeLinkReceived = "link_received"
+)
+
 type LinkData struct {
 	LinkID         string           `json:"link_id"`
 	PrevPacket     PacketIdentifier `json:"prev_packet"`
 	IsLastPacket   bool             `json:"last_packet"`
 	IsInitalPacket bool             `json:"initial_packet"`
 	LinkIndex      string           `json:"link_index"`
+	// DestCallbackAddress is the address of the contract called on the receiving chain once the last packet
+	// of the link is received, if any.
+	DestCallbackAddress string `json:"dest_callback_address,omitempty"`
+	// Atomic is true if the packets of the link are executed together once the last packet is received.
+	Atomic bool `json:"atomic,omitempty"`
 }
 
 func (ld LinkData) String() string {
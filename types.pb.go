@@ -6,18 +6,25 @@ package linkedpackets
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +32,217 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExpiredDepositAction defines what happens to the deposit of a link which expires before it is closed.
+type ExpiredDepositAction int32
+
+const (
+	// EXPIRED_DEPOSIT_ACTION_BURN burns the deposit.
+	ExpiredDepositActionBurn ExpiredDepositAction = 0
+	// EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL sends the deposit to the community
+	// pool.
+	ExpiredDepositActionCommunityPool ExpiredDepositAction = 1
+)
+
+var ExpiredDepositAction_name = map[int32]string{
+	0: "EXPIRED_DEPOSIT_ACTION_BURN",
+	1: "EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL",
+}
+
+var ExpiredDepositAction_value = map[string]int32{
+	"EXPIRED_DEPOSIT_ACTION_BURN":           0,
+	"EXPIRED_DEPOSIT_ACTION_COMMUNITY_POOL": 1,
+}
+
+func (x ExpiredDepositAction) String() string {
+	return proto.EnumName(ExpiredDepositAction_name, int32(x))
+}
+
+func (ExpiredDepositAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{0}
+}
+
+// LinkStatus defines the lifecycle status of a link on the sending chain.
+type LinkStatus int32
+
+const (
+	// LINK_STATUS_UNSPECIFIED defines a no-op status.
+	LinkStatusUnspecified LinkStatus = 0
+	// LINK_STATUS_OPEN defines a link which is still accepting packets.
+	LinkStatusOpen LinkStatus = 1
+	// LINK_STATUS_PENDING defines a closed link which is waiting for the outcome
+	// of one or more of its packets.
+	LinkStatusPending LinkStatus = 2
+	// LINK_STATUS_SUCCEEDED defines a link whose packets were all acknowledged
+	// successfully.
+	LinkStatusSucceeded LinkStatus = 3
+	// LINK_STATUS_FAILED defines a link whose packets all failed, either with an
+	// error acknowledgement or a timeout.
+	LinkStatusFailed LinkStatus = 4
+	// LINK_STATUS_PARTIALLY_FAILED defines a link where some packets succeeded
+	// and some failed.
+	LinkStatusPartiallyFailed LinkStatus = 5
+)
+
+var LinkStatus_name = map[int32]string{
+	0: "LINK_STATUS_UNSPECIFIED",
+	1: "LINK_STATUS_OPEN",
+	2: "LINK_STATUS_PENDING",
+	3: "LINK_STATUS_SUCCEEDED",
+	4: "LINK_STATUS_FAILED",
+	5: "LINK_STATUS_PARTIALLY_FAILED",
+}
+
+var LinkStatus_value = map[string]int32{
+	"LINK_STATUS_UNSPECIFIED":      0,
+	"LINK_STATUS_OPEN":             1,
+	"LINK_STATUS_PENDING":          2,
+	"LINK_STATUS_SUCCEEDED":        3,
+	"LINK_STATUS_FAILED":           4,
+	"LINK_STATUS_PARTIALLY_FAILED": 5,
+}
+
+func (x LinkStatus) String() string {
+	return proto.EnumName(LinkStatus_name, int32(x))
+}
+
+func (LinkStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{1}
+}
+
+// PacketOutcome defines the outcome of a single packet within a link.
+type PacketOutcome int32
+
+const (
+	// PACKET_OUTCOME_UNSPECIFIED defines a packet that has neither been
+	// acknowledged nor timed out yet.
+	PacketOutcomePending PacketOutcome = 0
+	// PACKET_OUTCOME_SUCCESS defines a packet acknowledged with a success
+	// acknowledgement.
+	PacketOutcomeSuccess PacketOutcome = 1
+	// PACKET_OUTCOME_ERROR defines a packet acknowledged with an error
+	// acknowledgement.
+	PacketOutcomeError PacketOutcome = 2
+	// PACKET_OUTCOME_TIMEOUT defines a packet that timed out.
+	PacketOutcomeTimeout PacketOutcome = 3
+	// PACKET_OUTCOME_LINK_ERROR defines a packet rejected by the link rules of
+	// the receiving chain.
+	PacketOutcomeLinkError PacketOutcome = 4
+	// PACKET_OUTCOME_FORCE_FAILED defines a packet whose link was force failed by
+	// the authority before the packet was acknowledged.
+	PacketOutcomeForceFailed PacketOutcome = 5
+)
+
+var PacketOutcome_name = map[int32]string{
+	0: "PACKET_OUTCOME_UNSPECIFIED",
+	1: "PACKET_OUTCOME_SUCCESS",
+	2: "PACKET_OUTCOME_ERROR",
+	3: "PACKET_OUTCOME_TIMEOUT",
+	4: "PACKET_OUTCOME_LINK_ERROR",
+	5: "PACKET_OUTCOME_FORCE_FAILED",
+}
+
+var PacketOutcome_value = map[string]int32{
+	"PACKET_OUTCOME_UNSPECIFIED":  0,
+	"PACKET_OUTCOME_SUCCESS":      1,
+	"PACKET_OUTCOME_ERROR":        2,
+	"PACKET_OUTCOME_TIMEOUT":      3,
+	"PACKET_OUTCOME_LINK_ERROR":   4,
+	"PACKET_OUTCOME_FORCE_FAILED": 5,
+}
+
+func (x PacketOutcome) String() string {
+	return proto.EnumName(PacketOutcome_name, int32(x))
+}
+
+func (PacketOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{2}
+}
+
+// LinkAckCode defines the result of the link rules applied by the receiving chain to a packet.
+type LinkAckCode int32
+
+const (
+	// LINK_ACK_CODE_UNSPECIFIED defines an unknown result.
+	LinkAckCodeUnspecified LinkAckCode = 0
+	// LINK_ACK_CODE_OK defines a packet accepted by the link rules and passed to
+	// the application.
+	LinkAckCodeOK LinkAckCode = 1
+	// LINK_ACK_CODE_BROKEN_CHAIN defines a linked packet whose previous packet
+	// was not received.
+	LinkAckCodeBrokenChain LinkAckCode = 2
+	// LINK_ACK_CODE_EXPIRED defines a linked packet received after its link
+	// expired.
+	LinkAckCodeExpired LinkAckCode = 3
+	// LINK_ACK_CODE_POLICY_VIOLATION defines a linked packet rejected by a
+	// policy of the receiving chain.
+	LinkAckCodePolicyViolation LinkAckCode = 4
+	// LINK_ACK_CODE_EXECUTION_FAILED defines a linked packet executed atomically
+	// with the other packets of its link, one of which failed.
+	LinkAckCodeExecutionFailed LinkAckCode = 5
+	// LINK_ACK_CODE_FORCE_CLOSED defines a buffered linked packet whose link was
+	// force closed by the authority of the receiving chain before it was executed.
+	LinkAckCodeForceClosed LinkAckCode = 6
+)
+
+var LinkAckCode_name = map[int32]string{
+	0: "LINK_ACK_CODE_UNSPECIFIED",
+	1: "LINK_ACK_CODE_OK",
+	2: "LINK_ACK_CODE_BROKEN_CHAIN",
+	3: "LINK_ACK_CODE_EXPIRED",
+	4: "LINK_ACK_CODE_POLICY_VIOLATION",
+	5: "LINK_ACK_CODE_EXECUTION_FAILED",
+	6: "LINK_ACK_CODE_FORCE_CLOSED",
+}
+
+var LinkAckCode_value = map[string]int32{
+	"LINK_ACK_CODE_UNSPECIFIED":      0,
+	"LINK_ACK_CODE_OK":               1,
+	"LINK_ACK_CODE_BROKEN_CHAIN":     2,
+	"LINK_ACK_CODE_EXPIRED":          3,
+	"LINK_ACK_CODE_POLICY_VIOLATION": 4,
+	"LINK_ACK_CODE_EXECUTION_FAILED": 5,
+	"LINK_ACK_CODE_FORCE_CLOSED":     6,
+}
+
+func (x LinkAckCode) String() string {
+	return proto.EnumName(LinkAckCode_name, int32(x))
+}
+
+func (LinkAckCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{3}
+}
+
 // Params defines the parameters of the module.
 type Params struct {
+	// linking_enabled enables opening links and sending linked packets on this chain.
+	LinkingEnabled bool `protobuf:"varint,1,opt,name=linking_enabled,json=linkingEnabled,proto3" json:"linking_enabled,omitempty"`
+	// max_link_packets is the maximum number of packets sent as part of a link. Zero means no limit.
+	MaxLinkPackets uint64 `protobuf:"varint,2,opt,name=max_link_packets,json=maxLinkPackets,proto3" json:"max_link_packets,omitempty"`
+	// max_open_links_per_account is the maximum number of links an account can have open or pending at the
+	// same time. Zero means no limit.
+	MaxOpenLinksPerAccount uint64 `protobuf:"varint,3,opt,name=max_open_links_per_account,json=maxOpenLinksPerAccount,proto3" json:"max_open_links_per_account,omitempty"`
+	// link_expiry_blocks is the number of blocks after which an open link no longer accepts packets. Zero means
+	// links never expire.
+	LinkExpiryBlocks uint64 `protobuf:"varint,4,opt,name=link_expiry_blocks,json=linkExpiryBlocks,proto3" json:"link_expiry_blocks,omitempty"`
+	// allowed_packet_data_types are the packet data types which can be sent as part of a link.
+	AllowedPacketDataTypes []string `protobuf:"bytes,5,rep,name=allowed_packet_data_types,json=allowedPacketDataTypes,proto3" json:"allowed_packet_data_types,omitempty"`
+	// link_deposit is the refundable deposit escrowed from the owner when a link is opened. An empty deposit
+	// means opening a link is free.
+	LinkDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=link_deposit,json=linkDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"link_deposit"`
+	// expired_deposit_action defines what happens to the deposit of a link which expires.
+	ExpiredDepositAction ExpiredDepositAction `protobuf:"varint,7,opt,name=expired_deposit_action,json=expiredDepositAction,proto3,enum=srdtrk.linkedpackets.v1.ExpiredDepositAction" json:"expired_deposit_action,omitempty"`
+	// rate_limit_window_blocks is the number of blocks of the windows over which the rate limits are counted. Zero
+	// disables the rate limits.
+	RateLimitWindowBlocks uint64 `protobuf:"varint,8,opt,name=rate_limit_window_blocks,json=rateLimitWindowBlocks,proto3" json:"rate_limit_window_blocks,omitempty"`
+	// max_links_per_account_window is the maximum number of links an account can open per rate limit window. Zero
+	// means no limit.
+	MaxLinksPerAccountWindow uint64 `protobuf:"varint,9,opt,name=max_links_per_account_window,json=maxLinksPerAccountWindow,proto3" json:"max_links_per_account_window,omitempty"`
+	// max_linked_packets_per_channel_window is the maximum number of linked packets sent on a channel per rate
+	// limit window. Zero means no limit.
+	MaxLinkedPacketsPerChannelWindow uint64 `protobuf:"varint,10,opt,name=max_linked_packets_per_channel_window,json=maxLinkedPacketsPerChannelWindow,proto3" json:"max_linked_packets_per_channel_window,omitempty"`
+	// resolved_link_retention_blocks is the number of blocks a resolved link is kept so that it can be queried
+	// before it is pruned. Zero means resolved links are pruned at the end of the block they are resolved in.
+	ResolvedLinkRetentionBlocks uint64 `protobuf:"varint,11,opt,name=resolved_link_retention_blocks,json=resolvedLinkRetentionBlocks,proto3" json:"resolved_link_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,6 +278,83 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetLinkingEnabled() bool {
+	if m != nil {
+		return m.LinkingEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxLinkPackets() uint64 {
+	if m != nil {
+		return m.MaxLinkPackets
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOpenLinksPerAccount() uint64 {
+	if m != nil {
+		return m.MaxOpenLinksPerAccount
+	}
+	return 0
+}
+
+func (m *Params) GetLinkExpiryBlocks() uint64 {
+	if m != nil {
+		return m.LinkExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedPacketDataTypes() []string {
+	if m != nil {
+		return m.AllowedPacketDataTypes
+	}
+	return nil
+}
+
+func (m *Params) GetLinkDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LinkDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetExpiredDepositAction() ExpiredDepositAction {
+	if m != nil {
+		return m.ExpiredDepositAction
+	}
+	return ExpiredDepositActionBurn
+}
+
+func (m *Params) GetRateLimitWindowBlocks() uint64 {
+	if m != nil {
+		return m.RateLimitWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLinksPerAccountWindow() uint64 {
+	if m != nil {
+		return m.MaxLinksPerAccountWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLinkedPacketsPerChannelWindow() uint64 {
+	if m != nil {
+		return m.MaxLinkedPacketsPerChannelWindow
+	}
+	return 0
+}
+
+func (m *Params) GetResolvedLinkRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ResolvedLinkRetentionBlocks
+	}
+	return 0
+}
+
 // Metadata defines the linked-packets specific metadata encoded into the channel version bytestring
 // See ICS004: https://github.com/cosmos/ibc/tree/master/spec/core/ics-004-channel-and-packet-semantics#Versioning
 type Metadata struct {
@@ -118,27 +411,49 @@ func (m *Metadata) GetAppVersion() string {
 	return ""
 }
 
-// Counter defines a counter object.
-// It is used only for genesis purposes. Collections does not need to use it.
-type Counter struct {
-	// count defines the count of the counter.
-	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// address defines the address that is associated with the count.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// link_enabled_channels are the channels on which packet linking is enabled.
+	LinkEnabledChannels []ChannelIdentifier `protobuf:"bytes,3,rep,name=link_enabled_channels,json=linkEnabledChannels,proto3" json:"link_enabled_channels"`
+	// links are the links sent by this chain.
+	Links []Link `protobuf:"bytes,6,rep,name=links,proto3" json:"links"`
+	// packet_links are the links of the sent packets.
+	PacketLinks []PacketLink `protobuf:"bytes,7,rep,name=packet_links,json=packetLinks,proto3" json:"packet_links"`
+	// link_sequence is the sequence used to generate link identifiers.
+	LinkSequence uint64 `protobuf:"varint,8,opt,name=link_sequence,json=linkSequence,proto3" json:"link_sequence,omitempty"`
+	// sessions are the links being sent.
+	Sessions []LinkSession `protobuf:"bytes,10,rep,name=sessions,proto3" json:"sessions"`
+	// forwarded_link_packets are the last hops forwarding the linked packets received by this chain.
+	ForwardedLinkPackets []ForwardedLinkPacket `protobuf:"bytes,11,rep,name=forwarded_link_packets,json=forwardedLinkPackets,proto3" json:"forwarded_link_packets"`
+	// buffered_links are the links whose received interchain accounts packets are buffered until their last packet.
+	BufferedLinks []BufferedLink `protobuf:"bytes,12,rep,name=buffered_links,json=bufferedLinks,proto3" json:"buffered_links"`
+	// linking_paused is true if linking is paused chain-wide by the authority.
+	LinkingPaused bool `protobuf:"varint,13,opt,name=linking_paused,json=linkingPaused,proto3" json:"linking_paused,omitempty"`
+	// paused_channels are the channels on which linking is paused by the authority.
+	PausedChannels []ChannelIdentifier `protobuf:"bytes,14,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels"`
+	// account_rate_limits are the links opened by accounts in their last rate limit window.
+	AccountRateLimits []AccountRateLimit `protobuf:"bytes,15,rep,name=account_rate_limits,json=accountRateLimits,proto3" json:"account_rate_limits"`
+	// channel_rate_limits are the linked packets sent on channels in their last rate limit window.
+	ChannelRateLimits []ChannelRateLimit `protobuf:"bytes,16,rep,name=channel_rate_limits,json=channelRateLimits,proto3" json:"channel_rate_limits"`
+	// received_link_packets are the last packets received by this chain of the links whose last packet has not
+	// been received yet.
+	ReceivedLinkPackets []ReceivedLinkPacket `protobuf:"bytes,17,rep,name=received_link_packets,json=receivedLinkPackets,proto3" json:"received_link_packets"`
 }
 
-func (m *Counter) Reset()         { *m = Counter{} }
-func (m *Counter) String() string { return proto.CompactTextString(m) }
-func (*Counter) ProtoMessage()    {}
-func (*Counter) Descriptor() ([]byte, []int) {
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_aff971497b0248c1, []int{2}
 }
-func (m *Counter) XXX_Unmarshal(b []byte) error {
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Counter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -148,52 +463,129 @@ func (m *Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Counter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Counter.Merge(m, src)
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
 }
-func (m *Counter) XXX_Size() int {
+func (m *GenesisState) XXX_Size() int {
 	return m.Size()
 }
-func (m *Counter) XXX_DiscardUnknown() {
-	xxx_messageInfo_Counter.DiscardUnknown(m)
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetLinkEnabledChannels() []ChannelIdentifier {
+	if m != nil {
+		return m.LinkEnabledChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLinks() []Link {
+	if m != nil {
+		return m.Links
+	}
+	return nil
 }
 
-var xxx_messageInfo_Counter proto.InternalMessageInfo
+func (m *GenesisState) GetPacketLinks() []PacketLink {
+	if m != nil {
+		return m.PacketLinks
+	}
+	return nil
+}
 
-func (m *Counter) GetCount() uint64 {
+func (m *GenesisState) GetLinkSequence() uint64 {
 	if m != nil {
-		return m.Count
+		return m.LinkSequence
 	}
 	return 0
 }
 
-func (m *Counter) GetAddress() string {
+func (m *GenesisState) GetSessions() []LinkSession {
 	if m != nil {
-		return m.Address
+		return m.Sessions
 	}
-	return ""
+	return nil
 }
 
-// GenesisState is the state that must be provided at genesis.
-type GenesisState struct {
-	// counter defines the counter object.
-	Counters []Counter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters"`
-	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+func (m *GenesisState) GetForwardedLinkPackets() []ForwardedLinkPacket {
+	if m != nil {
+		return m.ForwardedLinkPackets
+	}
+	return nil
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
+func (m *GenesisState) GetBufferedLinks() []BufferedLink {
+	if m != nil {
+		return m.BufferedLinks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLinkingPaused() bool {
+	if m != nil {
+		return m.LinkingPaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedChannels() []ChannelIdentifier {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountRateLimits() []AccountRateLimit {
+	if m != nil {
+		return m.AccountRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.ChannelRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceivedLinkPackets() []ReceivedLinkPacket {
+	if m != nil {
+		return m.ReceivedLinkPackets
+	}
+	return nil
+}
+
+// RateLimitUsage defines how much of a rate limit is used in a rate limit window.
+type RateLimitUsage struct {
+	// window is the index of the rate limit window, the block height divided by the window length.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// used is the number of links or linked packets counted in the window.
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_aff971497b0248c1, []int{3}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -203,51 +595,52 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *RateLimitUsage) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
 
-func (m *GenesisState) GetCounters() []Counter {
+func (m *RateLimitUsage) GetWindow() uint64 {
 	if m != nil {
-		return m.Counters
+		return m.Window
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetParams() Params {
+func (m *RateLimitUsage) GetUsed() uint64 {
 	if m != nil {
-		return m.Params
+		return m.Used
 	}
-	return Params{}
+	return 0
 }
 
-// PacketIdentifier is the identifier for a packet.
-type PacketIdentifier struct {
-	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Seq       string `protobuf:"bytes,3,opt,name=seq,proto3" json:"seq,omitempty"`
+// AccountRateLimit defines the links opened by an account in its last rate limit window.
+type AccountRateLimit struct {
+	// account is the address of the link owner.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// usage is the number of links opened in the window.
+	Usage RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
 }
 
-func (m *PacketIdentifier) Reset()         { *m = PacketIdentifier{} }
-func (m *PacketIdentifier) String() string { return proto.CompactTextString(m) }
-func (*PacketIdentifier) ProtoMessage()    {}
-func (*PacketIdentifier) Descriptor() ([]byte, []int) {
+func (m *AccountRateLimit) Reset()         { *m = AccountRateLimit{} }
+func (m *AccountRateLimit) String() string { return proto.CompactTextString(m) }
+func (*AccountRateLimit) ProtoMessage()    {}
+func (*AccountRateLimit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_aff971497b0248c1, []int{4}
 }
-func (m *PacketIdentifier) XXX_Unmarshal(b []byte) error {
+func (m *AccountRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PacketIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PacketIdentifier.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountRateLimit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -257,395 +650,6072 @@ func (m *PacketIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PacketIdentifier) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PacketIdentifier.Merge(m, src)
+func (m *AccountRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRateLimit.Merge(m, src)
 }
-func (m *PacketIdentifier) XXX_Size() int {
+func (m *AccountRateLimit) XXX_Size() int {
 	return m.Size()
 }
-func (m *PacketIdentifier) XXX_DiscardUnknown() {
-	xxx_messageInfo_PacketIdentifier.DiscardUnknown(m)
+func (m *AccountRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRateLimit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PacketIdentifier proto.InternalMessageInfo
+var xxx_messageInfo_AccountRateLimit proto.InternalMessageInfo
 
-func (m *PacketIdentifier) GetPortId() string {
+func (m *AccountRateLimit) GetAccount() string {
 	if m != nil {
-		return m.PortId
+		return m.Account
 	}
 	return ""
 }
 
-func (m *PacketIdentifier) GetChannelId() string {
+func (m *AccountRateLimit) GetUsage() RateLimitUsage {
 	if m != nil {
-		return m.ChannelId
+		return m.Usage
 	}
-	return ""
+	return RateLimitUsage{}
 }
 
-func (m *PacketIdentifier) GetSeq() string {
-	if m != nil {
-		return m.Seq
+// ChannelRateLimit defines the linked packets sent on a channel in its last rate limit window.
+type ChannelRateLimit struct {
+	// channel is the channel the linked packets are sent on.
+	Channel ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	// usage is the number of linked packets sent in the window.
+	Usage RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *ChannelRateLimit) Reset()         { *m = ChannelRateLimit{} }
+func (m *ChannelRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ChannelRateLimit) ProtoMessage()    {}
+func (*ChannelRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{5}
+}
+func (m *ChannelRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *ChannelRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRateLimit.Merge(m, src)
+}
+func (m *ChannelRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRateLimit.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "srdtrk.linkedpackets.v1.Params")
-	proto.RegisterType((*Metadata)(nil), "srdtrk.linkedpackets.v1.Metadata")
-	proto.RegisterType((*Counter)(nil), "srdtrk.linkedpackets.v1.Counter")
-	proto.RegisterType((*GenesisState)(nil), "srdtrk.linkedpackets.v1.GenesisState")
-	proto.RegisterType((*PacketIdentifier)(nil), "srdtrk.linkedpackets.v1.PacketIdentifier")
+var xxx_messageInfo_ChannelRateLimit proto.InternalMessageInfo
+
+func (m *ChannelRateLimit) GetChannel() ChannelIdentifier {
+	if m != nil {
+		return m.Channel
+	}
+	return ChannelIdentifier{}
 }
 
-func init() {
-	proto.RegisterFile("srdtrk/linkedpackets/v1/types.proto", fileDescriptor_aff971497b0248c1)
+func (m *ChannelRateLimit) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return RateLimitUsage{}
 }
 
-var fileDescriptor_aff971497b0248c1 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0x63, 0x02, 0x49, 0xf3, 0xc2, 0x50, 0xac, 0x88, 0x86, 0x02, 0x97, 0x70, 0x2c, 0x55,
-	0x24, 0xee, 0xd4, 0xc0, 0x42, 0x37, 0xc2, 0x50, 0x65, 0x40, 0xaa, 0x52, 0x89, 0x01, 0x21, 0x45,
-	0xee, 0xd9, 0xa4, 0x56, 0x1a, 0xdb, 0xd8, 0xee, 0x49, 0x7c, 0x05, 0x26, 0x76, 0xbe, 0x00, 0x63,
-	0x07, 0x3e, 0x44, 0xc7, 0x8a, 0x89, 0x09, 0xa1, 0x64, 0xe8, 0xd7, 0x40, 0xe7, 0x67, 0x2a, 0x90,
-	0x92, 0xe5, 0xe4, 0xf7, 0xff, 0xff, 0xde, 0x3b, 0xbf, 0xff, 0x1d, 0x3c, 0x75, 0x96, 0x7b, 0x3b,
-	0xcf, 0xcf, 0xa4, 0x9a, 0x0b, 0x6e, 0x58, 0x31, 0x17, 0xde, 0xe5, 0xe5, 0x7e, 0xee, 0x3f, 0x19,
-	0xe1, 0x32, 0x63, 0xb5, 0xd7, 0x74, 0x07, 0xa1, 0xec, 0x3f, 0x28, 0x2b, 0xf7, 0x77, 0x1f, 0x14,
-	0xda, 0x2d, 0xb4, 0x9b, 0x06, 0x2c, 0xc7, 0x02, 0x7b, 0x76, 0x3b, 0x33, 0x3d, 0xd3, 0xa8, 0x57,
-	0xa7, 0xa8, 0xde, 0x63, 0x0b, 0xa9, 0x74, 0x1e, 0x9e, 0x28, 0xa5, 0x03, 0x68, 0x1c, 0x31, 0xcb,
-	0x16, 0xee, 0xa0, 0xff, 0xf9, 0xfa, 0x62, 0xf0, 0x70, 0xed, 0x85, 0x90, 0x48, 0x19, 0x6c, 0xbd,
-	0x11, 0x9e, 0x71, 0xe6, 0x19, 0x7d, 0x01, 0xf7, 0x91, 0x99, 0x46, 0x68, 0x5a, 0x0a, 0xeb, 0xa4,
-	0x56, 0x5d, 0xd2, 0x27, 0x7b, 0xad, 0x49, 0x07, 0xdd, 0x23, 0x34, 0xdf, 0xa2, 0x47, 0x7b, 0xd0,
-	0x66, 0xc6, 0xdc, 0xa0, 0xb7, 0x02, 0x0a, 0xcc, 0x98, 0x08, 0xa4, 0x25, 0x34, 0x5f, 0xeb, 0x73,
-	0xe5, 0x85, 0xa5, 0x1d, 0xb8, 0x53, 0x54, 0xc7, 0x30, 0xf0, 0xf6, 0x04, 0x0b, 0x3a, 0x84, 0x26,
-	0xe3, 0xdc, 0x0a, 0xe7, 0xb0, 0x7b, 0xd4, 0xfd, 0xf1, 0xfd, 0x59, 0x27, 0xee, 0xfe, 0x0a, 0x9d,
-	0x63, 0x6f, 0xa5, 0x9a, 0x4d, 0xfe, 0x82, 0x07, 0x4f, 0xaa, 0xcd, 0x1e, 0xad, 0xdd, 0x2c, 0xbe,
-	0x2c, 0xfd, 0x4a, 0xe0, 0xee, 0xa1, 0x50, 0xc2, 0x49, 0x77, 0xec, 0x99, 0x17, 0xf4, 0x10, 0xb6,
-	0x0a, 0xf4, 0x5c, 0x97, 0xf4, 0xeb, 0x7b, 0xed, 0x61, 0x3f, 0xdb, 0xf0, 0x1d, 0xb2, 0x38, 0x64,
-	0xd4, 0xba, 0xfc, 0xd5, 0xab, 0x7d, 0xbb, 0xbe, 0x18, 0x90, 0xc9, 0x4d, 0x33, 0x1d, 0x41, 0xc3,
-	0x84, 0xf8, 0xc2, 0x7d, 0xdb, 0xc3, 0xde, 0xc6, 0x31, 0x98, 0xf2, 0xbf, 0x53, 0x62, 0x67, 0xfa,
-	0x1e, 0xb6, 0x31, 0xc8, 0x31, 0x17, 0xca, 0xcb, 0x0f, 0x52, 0x58, 0xba, 0x03, 0x4d, 0xa3, 0xad,
-	0x9f, 0x4a, 0x1e, 0x13, 0x6f, 0x54, 0xe5, 0x98, 0xd3, 0xc7, 0x00, 0xc5, 0x29, 0x53, 0x4a, 0x9c,
-	0x55, 0x1e, 0x46, 0xdc, 0x8a, 0xca, 0x98, 0xd3, 0x6d, 0xa8, 0x3b, 0xf1, 0xb1, 0x5b, 0x0f, 0x7a,
-	0x75, 0x1c, 0xbd, 0xbc, 0x5c, 0x26, 0xe4, 0x6a, 0x99, 0x90, 0xdf, 0xcb, 0x84, 0x7c, 0x59, 0x25,
-	0xb5, 0xab, 0x55, 0x52, 0xfb, 0xb9, 0x4a, 0x6a, 0xef, 0x7a, 0x33, 0xe9, 0x4f, 0xcf, 0x4f, 0xb2,
-	0x42, 0x2f, 0xf2, 0x75, 0xf1, 0x9d, 0x34, 0xc2, 0x4f, 0xf4, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x47, 0x5e, 0x97, 0x76, 0xc8, 0x02, 0x00, 0x00,
+// RateLimitQuota defines the remaining quota of a rate limit in the current rate limit window.
+type RateLimitQuota struct {
+	// limit is the maximum number of links or linked packets per window. Zero means no limit.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// remaining is the number of links or linked packets which can still be counted in the current window.
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// window_end_height is the height of the first block of the next window, at which the quota is reset. It
+	// is zero if there is no limit.
+	WindowEndHeight uint64 `protobuf:"varint,3,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitQuota) Reset()         { *m = RateLimitQuota{} }
+func (m *RateLimitQuota) String() string { return proto.CompactTextString(m) }
+func (*RateLimitQuota) ProtoMessage()    {}
+func (*RateLimitQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{6}
+}
+func (m *RateLimitQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RateLimitQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitQuota.Merge(m, src)
+}
+func (m *RateLimitQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitQuota.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RateLimitQuota proto.InternalMessageInfo
+
+func (m *RateLimitQuota) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RateLimitQuota) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitQuota) GetWindowEndHeight() uint64 {
+	if m != nil {
+		return m.WindowEndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LinkSession defines a link being sent by its owner.
+type LinkSession struct {
+	// link_id is the identifier of the link being sent.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// prev_packet is the last packet sent as part of the link. It is empty if no packet was sent yet.
+	PrevPacket PacketIdentifier `protobuf:"bytes,2,opt,name=prev_packet,json=prevPacket,proto3" json:"prev_packet"`
+	// owner is the address sending the link.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// link_index is the link index of the next packet sent as part of the link.
+	LinkIndex uint64 `protobuf:"varint,4,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppVersion) > 0 {
-		i -= len(m.AppVersion)
-		copy(dAtA[i:], m.AppVersion)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppVersion)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LinkedPacketsVersion) > 0 {
-		i -= len(m.LinkedPacketsVersion)
-		copy(dAtA[i:], m.LinkedPacketsVersion)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkedPacketsVersion)))
-		i--
-		dAtA[i] = 0xa
+func (m *LinkSession) Reset()         { *m = LinkSession{} }
+func (m *LinkSession) String() string { return proto.CompactTextString(m) }
+func (*LinkSession) ProtoMessage()    {}
+func (*LinkSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{7}
+}
+func (m *LinkSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *LinkSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkSession.Merge(m, src)
+}
+func (m *LinkSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkSession.DiscardUnknown(m)
 }
 
-func (m *Counter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LinkSession proto.InternalMessageInfo
+
+func (m *LinkSession) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LinkSession) GetPrevPacket() PacketIdentifier {
+	if m != nil {
+		return m.PrevPacket
+	}
+	return PacketIdentifier{}
 }
 
-func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Count != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+func (m *LinkSession) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LinkSession) GetLinkIndex() uint64 {
+	if m != nil {
+		return m.LinkIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PacketLink defines the link a sent packet is a member of.
+type PacketLink struct {
+	// packet is the identifier of the packet.
+	Packet PacketIdentifier `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *PacketLink) Reset()         { *m = PacketLink{} }
+func (m *PacketLink) String() string { return proto.CompactTextString(m) }
+func (*PacketLink) ProtoMessage()    {}
+func (*PacketLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{8}
+}
+func (m *PacketLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Counters) > 0 {
-		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *PacketLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketLink.Merge(m, src)
+}
+func (m *PacketLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketLink proto.InternalMessageInfo
+
+func (m *PacketLink) GetPacket() PacketIdentifier {
+	if m != nil {
+		return m.Packet
 	}
-	return len(dAtA) - i, nil
+	return PacketIdentifier{}
 }
 
-func (m *PacketIdentifier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PacketLink) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PacketIdentifier) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReceivedLinkPacket defines the last packet of a link received on a channel, which the next packet of the link
+// received on the same channel must follow.
+type ReceivedLinkPacket struct {
+	// channel is the channel on which the packets of the link are received.
+	Channel ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// sequence is the sequence of the last packet of the link received on the channel.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *PacketIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Seq) > 0 {
-		i -= len(m.Seq)
-		copy(dAtA[i:], m.Seq)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Seq)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ReceivedLinkPacket) Reset()         { *m = ReceivedLinkPacket{} }
+func (m *ReceivedLinkPacket) String() string { return proto.CompactTextString(m) }
+func (*ReceivedLinkPacket) ProtoMessage()    {}
+func (*ReceivedLinkPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{9}
+}
+func (m *ReceivedLinkPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceivedLinkPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceivedLinkPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReceivedLinkPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceivedLinkPacket.Merge(m, src)
+}
+func (m *ReceivedLinkPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceivedLinkPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceivedLinkPacket.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ReceivedLinkPacket proto.InternalMessageInfo
+
+func (m *ReceivedLinkPacket) GetChannel() ChannelIdentifier {
+	if m != nil {
+		return m.Channel
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ChannelIdentifier{}
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ReceivedLinkPacket) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LinkedPacketsVersion)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.AppVersion)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *ReceivedLinkPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return n
+	return 0
 }
 
-func (m *Counter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovTypes(uint64(m.Count))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+// ForwardedLinkPacket defines the last hop sent to forward a packet of a link received on a channel, e.g. by
+// the packet forward middleware. The next hop of the link is linked to it.
+type ForwardedLinkPacket struct {
+	// channel is the channel on which the packets of the link are received.
+	Channel ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// last_hop is the last packet sent to forward a packet of the link.
+	LastHop PacketIdentifier `protobuf:"bytes,3,opt,name=last_hop,json=lastHop,proto3" json:"last_hop"`
+}
+
+func (m *ForwardedLinkPacket) Reset()         { *m = ForwardedLinkPacket{} }
+func (m *ForwardedLinkPacket) String() string { return proto.CompactTextString(m) }
+func (*ForwardedLinkPacket) ProtoMessage()    {}
+func (*ForwardedLinkPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{10}
+}
+func (m *ForwardedLinkPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedLinkPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedLinkPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ForwardedLinkPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedLinkPacket.Merge(m, src)
+}
+func (m *ForwardedLinkPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedLinkPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedLinkPacket.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ForwardedLinkPacket proto.InternalMessageInfo
+
+func (m *ForwardedLinkPacket) GetChannel() ChannelIdentifier {
+	if m != nil {
+		return m.Channel
 	}
-	var l int
-	_ = l
-	if len(m.Counters) > 0 {
-		for _, e := range m.Counters {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return ChannelIdentifier{}
+}
+
+func (m *ForwardedLinkPacket) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
+}
+
+func (m *ForwardedLinkPacket) GetLastHop() PacketIdentifier {
+	if m != nil {
+		return m.LastHop
+	}
+	return PacketIdentifier{}
+}
+
+// BufferedLinkPacket defines a received linked packet whose execution is deferred until the last packet of its
+// link is received, so that the packets of the link are executed atomically.
+type BufferedLinkPacket struct {
+	// packet is the protobuf encoded ibc.core.channel.v1.Packet received.
+	Packet []byte `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	// relayer is the address of the relayer of the packet.
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *BufferedLinkPacket) Reset()         { *m = BufferedLinkPacket{} }
+func (m *BufferedLinkPacket) String() string { return proto.CompactTextString(m) }
+func (*BufferedLinkPacket) ProtoMessage()    {}
+func (*BufferedLinkPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{11}
+}
+func (m *BufferedLinkPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BufferedLinkPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BufferedLinkPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+}
+func (m *BufferedLinkPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferedLinkPacket.Merge(m, src)
+}
+func (m *BufferedLinkPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *BufferedLinkPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferedLinkPacket.DiscardUnknown(m)
 }
 
-func (m *PacketIdentifier) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BufferedLinkPacket proto.InternalMessageInfo
+
+func (m *BufferedLinkPacket) GetPacket() []byte {
+	if m != nil {
+		return m.Packet
 	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *BufferedLinkPacket) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+// BufferedLinkPackets defines the buffered packets of a link received on a channel, in link order.
+type BufferedLinkPackets struct {
+	// packets are the buffered packets of the link.
+	Packets []BufferedLinkPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+}
+
+func (m *BufferedLinkPackets) Reset()         { *m = BufferedLinkPackets{} }
+func (m *BufferedLinkPackets) String() string { return proto.CompactTextString(m) }
+func (*BufferedLinkPackets) ProtoMessage()    {}
+func (*BufferedLinkPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{12}
+}
+func (m *BufferedLinkPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BufferedLinkPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BufferedLinkPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Seq)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *BufferedLinkPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferedLinkPackets.Merge(m, src)
+}
+func (m *BufferedLinkPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *BufferedLinkPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferedLinkPackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferedLinkPackets proto.InternalMessageInfo
+
+func (m *BufferedLinkPackets) GetPackets() []BufferedLinkPacket {
+	if m != nil {
+		return m.Packets
 	}
-	return n
+	return nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// BufferedLink defines the buffered packets of a link received on a channel.
+type BufferedLink struct {
+	// channel is the channel on which the packets of the link are received.
+	Channel ChannelIdentifier `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	// link_id is the identifier of the link.
+	LinkId string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// packets are the buffered packets of the link, in link order.
+	Packets []BufferedLinkPacket `protobuf:"bytes,3,rep,name=packets,proto3" json:"packets"`
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BufferedLink) Reset()         { *m = BufferedLink{} }
+func (m *BufferedLink) String() string { return proto.CompactTextString(m) }
+func (*BufferedLink) ProtoMessage()    {}
+func (*BufferedLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{13}
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+func (m *BufferedLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BufferedLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BufferedLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BufferedLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferedLink.Merge(m, src)
+}
+func (m *BufferedLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *BufferedLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferedLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferedLink proto.InternalMessageInfo
+
+func (m *BufferedLink) GetChannel() ChannelIdentifier {
+	if m != nil {
+		return m.Channel
+	}
+	return ChannelIdentifier{}
+}
+
+func (m *BufferedLink) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
+}
+
+func (m *BufferedLink) GetPackets() []BufferedLinkPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+// PacketIdentifier is the identifier for a packet.
+type PacketIdentifier struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Seq       string `protobuf:"bytes,3,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *PacketIdentifier) Reset()         { *m = PacketIdentifier{} }
+func (m *PacketIdentifier) String() string { return proto.CompactTextString(m) }
+func (*PacketIdentifier) ProtoMessage()    {}
+func (*PacketIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{14}
+}
+func (m *PacketIdentifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketIdentifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketIdentifier.Merge(m, src)
+}
+func (m *PacketIdentifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketIdentifier proto.InternalMessageInfo
+
+func (m *PacketIdentifier) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketIdentifier) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketIdentifier) GetSeq() string {
+	if m != nil {
+		return m.Seq
+	}
+	return ""
+}
+
+// ChannelIdentifier defines the identifier of a channel end.
+type ChannelIdentifier struct {
+	// port_id is the port identifier.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *ChannelIdentifier) Reset()         { *m = ChannelIdentifier{} }
+func (m *ChannelIdentifier) String() string { return proto.CompactTextString(m) }
+func (*ChannelIdentifier) ProtoMessage()    {}
+func (*ChannelIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{15}
+}
+func (m *ChannelIdentifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelIdentifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelIdentifier.Merge(m, src)
+}
+func (m *ChannelIdentifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelIdentifier proto.InternalMessageInfo
+
+func (m *ChannelIdentifier) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelIdentifier) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// Link defines the sending chain's record of a link and its packets.
+type Link struct {
+	// link_id is the link identifier.
+	LinkId string `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// owner is the address which opened the link.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// status is the current status of the link.
+	Status LinkStatus `protobuf:"varint,3,opt,name=status,proto3,enum=srdtrk.linkedpackets.v1.LinkStatus" json:"status,omitempty"`
+	// packets are the packets sent as part of the link, ordered by link index.
+	Packets []LinkPacket `protobuf:"bytes,4,rep,name=packets,proto3" json:"packets"`
+	// compensations are the compensating interchain account transactions of the link members.
+	Compensations []Compensation `protobuf:"bytes,5,rep,name=compensations,proto3" json:"compensations"`
+	// compensation_packets are the compensation packets sent after a member of the link failed,
+	// in the order they were sent.
+	CompensationPackets []CompensationPacket `protobuf:"bytes,6,rep,name=compensation_packets,json=compensationPackets,proto3" json:"compensation_packets"`
+	// open_time is the block time at which the link was opened.
+	OpenTime time.Time `protobuf:"bytes,7,opt,name=open_time,json=openTime,proto3,stdtime" json:"open_time"`
+	// open_height is the block height at which the link was opened.
+	OpenHeight uint64 `protobuf:"varint,8,opt,name=open_height,json=openHeight,proto3" json:"open_height,omitempty"`
+	// deposit is the deposit escrowed when the link was opened. It is cleared once the deposit is refunded or
+	// forfeited.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// relayer_reward is the reward escrowed when the link was opened and paid to the relayers of its packets
+	// once every packet is acknowledged. It is cleared once the reward is paid or refunded.
+	RelayerReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=relayer_reward,json=relayerReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_reward"`
+	// src_callback_address is the address of the contract called once the link is resolved, if any.
+	SrcCallbackAddress string `protobuf:"bytes,11,opt,name=src_callback_address,json=srcCallbackAddress,proto3" json:"src_callback_address,omitempty"`
+	// dest_callback_address is the address of the contract called on the receiving chain once the last
+	// packet of the link is received, if any.
+	DestCallbackAddress string `protobuf:"bytes,12,opt,name=dest_callback_address,json=destCallbackAddress,proto3" json:"dest_callback_address,omitempty"`
+	// atomic is true if the packets of the link are executed together by the interchain accounts host.
+	Atomic bool `protobuf:"varint,13,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// allowed_channels are the channels on which the packets of the link can be sent. Any link enabled channel
+	// can be used if empty.
+	AllowedChannels []ChannelIdentifier `protobuf:"bytes,14,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	// max_packets is the maximum number of packets sent as part of the link, if any.
+	MaxPackets uint64 `protobuf:"varint,15,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	// resolve_height is the block height at which the link was resolved. It is zero until the link is resolved.
+	ResolveHeight uint64 `protobuf:"varint,16,opt,name=resolve_height,json=resolveHeight,proto3" json:"resolve_height,omitempty"`
+}
+
+func (m *Link) Reset()         { *m = Link{} }
+func (m *Link) String() string { return proto.CompactTextString(m) }
+func (*Link) ProtoMessage()    {}
+func (*Link) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{16}
+}
+func (m *Link) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Link) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Link.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Link) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Link.Merge(m, src)
+}
+func (m *Link) XXX_Size() int {
+	return m.Size()
+}
+func (m *Link) XXX_DiscardUnknown() {
+	xxx_messageInfo_Link.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Link proto.InternalMessageInfo
+
+func (m *Link) GetLinkId() string {
+	if m != nil {
+		return m.LinkId
+	}
+	return ""
+}
+
+func (m *Link) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Link) GetStatus() LinkStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LinkStatusUnspecified
+}
+
+func (m *Link) GetPackets() []LinkPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *Link) GetCompensations() []Compensation {
+	if m != nil {
+		return m.Compensations
+	}
+	return nil
+}
+
+func (m *Link) GetCompensationPackets() []CompensationPacket {
+	if m != nil {
+		return m.CompensationPackets
+	}
+	return nil
+}
+
+func (m *Link) GetOpenTime() time.Time {
+	if m != nil {
+		return m.OpenTime
+	}
+	return time.Time{}
+}
+
+func (m *Link) GetOpenHeight() uint64 {
+	if m != nil {
+		return m.OpenHeight
+	}
+	return 0
+}
+
+func (m *Link) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Link) GetRelayerReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerReward
+	}
+	return nil
+}
+
+func (m *Link) GetSrcCallbackAddress() string {
+	if m != nil {
+		return m.SrcCallbackAddress
+	}
+	return ""
+}
+
+func (m *Link) GetDestCallbackAddress() string {
+	if m != nil {
+		return m.DestCallbackAddress
+	}
+	return ""
+}
+
+func (m *Link) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+func (m *Link) GetAllowedChannels() []ChannelIdentifier {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *Link) GetMaxPackets() uint64 {
+	if m != nil {
+		return m.MaxPackets
+	}
+	return 0
+}
+
+func (m *Link) GetResolveHeight() uint64 {
+	if m != nil {
+		return m.ResolveHeight
+	}
+	return 0
+}
+
+// LinkPacket defines a packet sent as part of a link and its outcome.
+type LinkPacket struct {
+	// packet is the identifier of the packet.
+	Packet PacketIdentifier `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// outcome is the outcome of the packet.
+	Outcome PacketOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=srdtrk.linkedpackets.v1.PacketOutcome" json:"outcome,omitempty"`
+	// relayer is the address of the relayer recorded in the acknowledgement of the packet. It may be encoded with
+	// the bech32 prefix of the receiving chain.
+	Relayer string `protobuf:"bytes,3,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *LinkPacket) Reset()         { *m = LinkPacket{} }
+func (m *LinkPacket) String() string { return proto.CompactTextString(m) }
+func (*LinkPacket) ProtoMessage()    {}
+func (*LinkPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{17}
+}
+func (m *LinkPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkPacket.Merge(m, src)
+}
+func (m *LinkPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkPacket proto.InternalMessageInfo
+
+func (m *LinkPacket) GetPacket() PacketIdentifier {
+	if m != nil {
+		return m.Packet
+	}
+	return PacketIdentifier{}
+}
+
+func (m *LinkPacket) GetOutcome() PacketOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return PacketOutcomePending
+}
+
+func (m *LinkPacket) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+// Compensation defines an interchain account transaction which undoes the effects of a link member.
+// It is sent on the channel of the member if the member succeeded and another member of the link failed.
+type Compensation struct {
+	// link_index is the index of the compensated member in the link.
+	LinkIndex uint64 `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// messages are the messages executed by the interchain account, as in an ICA CosmosTx.
+	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *Compensation) Reset()         { *m = Compensation{} }
+func (m *Compensation) String() string { return proto.CompactTextString(m) }
+func (*Compensation) ProtoMessage()    {}
+func (*Compensation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{18}
+}
+func (m *Compensation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Compensation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Compensation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Compensation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Compensation.Merge(m, src)
+}
+func (m *Compensation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Compensation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Compensation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Compensation proto.InternalMessageInfo
+
+func (m *Compensation) GetLinkIndex() uint64 {
+	if m != nil {
+		return m.LinkIndex
+	}
+	return 0
+}
+
+func (m *Compensation) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// CompensationPacket defines a compensation packet sent for a link member and its outcome.
+type CompensationPacket struct {
+	// link_index is the index of the compensated member in the link.
+	LinkIndex uint64 `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	// packet is the identifier of the compensation packet.
+	Packet PacketIdentifier `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// outcome is the outcome of the compensation packet.
+	Outcome PacketOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=srdtrk.linkedpackets.v1.PacketOutcome" json:"outcome,omitempty"`
+}
+
+func (m *CompensationPacket) Reset()         { *m = CompensationPacket{} }
+func (m *CompensationPacket) String() string { return proto.CompactTextString(m) }
+func (*CompensationPacket) ProtoMessage()    {}
+func (*CompensationPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{19}
+}
+func (m *CompensationPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompensationPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompensationPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompensationPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompensationPacket.Merge(m, src)
+}
+func (m *CompensationPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompensationPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompensationPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompensationPacket proto.InternalMessageInfo
+
+func (m *CompensationPacket) GetLinkIndex() uint64 {
+	if m != nil {
+		return m.LinkIndex
+	}
+	return 0
+}
+
+func (m *CompensationPacket) GetPacket() PacketIdentifier {
+	if m != nil {
+		return m.Packet
+	}
+	return PacketIdentifier{}
+}
+
+func (m *CompensationPacket) GetOutcome() PacketOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return PacketOutcomePending
+}
+
+// LinkAcknowledgement is the acknowledgement written by the middleware on link enabled channels.
+// It wraps the acknowledgement of the underlying application with the result of the link rules.
+type LinkAcknowledgement struct {
+	// app_acknowledgement is the acknowledgement of the underlying application.
+	AppAcknowledgement []byte `protobuf:"bytes,1,opt,name=app_acknowledgement,json=appAcknowledgement,proto3" json:"app_acknowledgement,omitempty"`
+	// app_success is true if the underlying application acknowledgement is a
+	// success acknowledgement.
+	AppSuccess bool `protobuf:"varint,2,opt,name=app_success,json=appSuccess,proto3" json:"app_success,omitempty"`
+	// code is the result of the link rules.
+	Code LinkAckCode `protobuf:"varint,3,opt,name=code,proto3,enum=srdtrk.linkedpackets.v1.LinkAckCode" json:"code,omitempty"`
+	// reason describes why the packet was rejected by the link rules, if it was.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// relayer is the address rewarded for relaying the packet. It is the forward relayer address registered
+	// with the fee middleware if there is one, otherwise the address of the relayer on the receiving chain.
+	Relayer string `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *LinkAcknowledgement) Reset()         { *m = LinkAcknowledgement{} }
+func (m *LinkAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*LinkAcknowledgement) ProtoMessage()    {}
+func (*LinkAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff971497b0248c1, []int{20}
+}
+func (m *LinkAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkAcknowledgement.Merge(m, src)
+}
+func (m *LinkAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkAcknowledgement proto.InternalMessageInfo
+
+func (m *LinkAcknowledgement) GetAppAcknowledgement() []byte {
+	if m != nil {
+		return m.AppAcknowledgement
+	}
+	return nil
+}
+
+func (m *LinkAcknowledgement) GetAppSuccess() bool {
+	if m != nil {
+		return m.AppSuccess
+	}
+	return false
+}
+
+func (m *LinkAcknowledgement) GetCode() LinkAckCode {
+	if m != nil {
+		return m.Code
+	}
+	return LinkAckCodeUnspecified
+}
+
+func (m *LinkAcknowledgement) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LinkAcknowledgement) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("srdtrk.linkedpackets.v1.ExpiredDepositAction", ExpiredDepositAction_name, ExpiredDepositAction_value)
+	proto.RegisterEnum("srdtrk.linkedpackets.v1.LinkStatus", LinkStatus_name, LinkStatus_value)
+	proto.RegisterEnum("srdtrk.linkedpackets.v1.PacketOutcome", PacketOutcome_name, PacketOutcome_value)
+	proto.RegisterEnum("srdtrk.linkedpackets.v1.LinkAckCode", LinkAckCode_name, LinkAckCode_value)
+	proto.RegisterType((*Params)(nil), "srdtrk.linkedpackets.v1.Params")
+	proto.RegisterType((*Metadata)(nil), "srdtrk.linkedpackets.v1.Metadata")
+	proto.RegisterType((*GenesisState)(nil), "srdtrk.linkedpackets.v1.GenesisState")
+	proto.RegisterType((*RateLimitUsage)(nil), "srdtrk.linkedpackets.v1.RateLimitUsage")
+	proto.RegisterType((*AccountRateLimit)(nil), "srdtrk.linkedpackets.v1.AccountRateLimit")
+	proto.RegisterType((*ChannelRateLimit)(nil), "srdtrk.linkedpackets.v1.ChannelRateLimit")
+	proto.RegisterType((*RateLimitQuota)(nil), "srdtrk.linkedpackets.v1.RateLimitQuota")
+	proto.RegisterType((*LinkSession)(nil), "srdtrk.linkedpackets.v1.LinkSession")
+	proto.RegisterType((*PacketLink)(nil), "srdtrk.linkedpackets.v1.PacketLink")
+	proto.RegisterType((*ReceivedLinkPacket)(nil), "srdtrk.linkedpackets.v1.ReceivedLinkPacket")
+	proto.RegisterType((*ForwardedLinkPacket)(nil), "srdtrk.linkedpackets.v1.ForwardedLinkPacket")
+	proto.RegisterType((*BufferedLinkPacket)(nil), "srdtrk.linkedpackets.v1.BufferedLinkPacket")
+	proto.RegisterType((*BufferedLinkPackets)(nil), "srdtrk.linkedpackets.v1.BufferedLinkPackets")
+	proto.RegisterType((*BufferedLink)(nil), "srdtrk.linkedpackets.v1.BufferedLink")
+	proto.RegisterType((*PacketIdentifier)(nil), "srdtrk.linkedpackets.v1.PacketIdentifier")
+	proto.RegisterType((*ChannelIdentifier)(nil), "srdtrk.linkedpackets.v1.ChannelIdentifier")
+	proto.RegisterType((*Link)(nil), "srdtrk.linkedpackets.v1.Link")
+	proto.RegisterType((*LinkPacket)(nil), "srdtrk.linkedpackets.v1.LinkPacket")
+	proto.RegisterType((*Compensation)(nil), "srdtrk.linkedpackets.v1.Compensation")
+	proto.RegisterType((*CompensationPacket)(nil), "srdtrk.linkedpackets.v1.CompensationPacket")
+	proto.RegisterType((*LinkAcknowledgement)(nil), "srdtrk.linkedpackets.v1.LinkAcknowledgement")
+}
+
+func init() {
+	proto.RegisterFile("srdtrk/linkedpackets/v1/types.proto", fileDescriptor_aff971497b0248c1)
+}
+
+var fileDescriptor_aff971497b0248c1 = []byte{
+	// 2483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x73, 0x23, 0x57,
+	0x15, 0x76, 0x4b, 0xf2, 0x43, 0x47, 0xb6, 0xa6, 0x7d, 0x2d, 0x7b, 0x34, 0x9a, 0x89, 0x2d, 0x14,
+	0x86, 0x38, 0x26, 0x91, 0x33, 0x26, 0x05, 0x49, 0x20, 0x80, 0x24, 0xb7, 0x33, 0x1a, 0x7b, 0x2c,
+	0xa5, 0x25, 0xe7, 0x01, 0x14, 0xcd, 0x75, 0xf7, 0x1d, 0x4d, 0x63, 0xa9, 0xbb, 0xd3, 0xb7, 0xe5,
+	0xb1, 0xd7, 0xb0, 0xa0, 0xb4, 0xca, 0x96, 0x54, 0x69, 0xc5, 0x86, 0x4a, 0x55, 0xaa, 0xb2, 0xa0,
+	0xf8, 0x03, 0x2c, 0x48, 0x51, 0x2c, 0x52, 0xb0, 0x61, 0x45, 0x20, 0x59, 0xcc, 0xdf, 0xa0, 0xee,
+	0xa3, 0xa5, 0xd6, 0x6b, 0x4c, 0x65, 0xa6, 0xb2, 0x99, 0x51, 0x9f, 0x73, 0xbe, 0x73, 0xce, 0x3d,
+	0xaf, 0xfb, 0x30, 0x3c, 0x4f, 0x7d, 0x2b, 0xf0, 0xcf, 0x76, 0xdb, 0xb6, 0x73, 0x46, 0x2c, 0x0f,
+	0x9b, 0x67, 0x24, 0xa0, 0xbb, 0xe7, 0x77, 0x76, 0x83, 0x4b, 0x8f, 0xd0, 0xa2, 0xe7, 0xbb, 0x81,
+	0x8b, 0xae, 0x0b, 0xa1, 0xe2, 0x88, 0x50, 0xf1, 0xfc, 0x4e, 0xee, 0x86, 0xe9, 0xd2, 0x8e, 0x4b,
+	0x0d, 0x2e, 0xb6, 0x2b, 0x3e, 0x04, 0x26, 0x97, 0x69, 0xb9, 0x2d, 0x57, 0xd0, 0xd9, 0x2f, 0x49,
+	0x5d, 0xc5, 0x1d, 0xdb, 0x71, 0x77, 0xf9, 0xbf, 0x92, 0xb4, 0x29, 0x60, 0xbb, 0xa7, 0x98, 0x92,
+	0xdd, 0xf3, 0x3b, 0xa7, 0x24, 0xc0, 0x77, 0x76, 0x4d, 0xd7, 0x76, 0x24, 0xff, 0x46, 0xcb, 0x75,
+	0x5b, 0x6d, 0xb2, 0xcb, 0xbf, 0x4e, 0xbb, 0x0f, 0x76, 0xb1, 0x73, 0x29, 0x59, 0x5b, 0xe3, 0xac,
+	0xc0, 0xee, 0x10, 0x1a, 0xe0, 0x8e, 0x27, 0x04, 0x0a, 0xfd, 0x05, 0x58, 0xa8, 0x63, 0x1f, 0x77,
+	0x28, 0x7a, 0x01, 0xae, 0x31, 0xf7, 0x6d, 0xa7, 0x65, 0x10, 0x07, 0x9f, 0xb6, 0x89, 0x95, 0x55,
+	0xf2, 0xca, 0xf6, 0x92, 0x9e, 0x96, 0x64, 0x4d, 0x50, 0xd1, 0x36, 0xa8, 0x1d, 0x7c, 0x61, 0x30,
+	0xaa, 0x21, 0x97, 0x9a, 0x8d, 0xe5, 0x95, 0xed, 0x84, 0x9e, 0xee, 0xe0, 0x8b, 0x23, 0xdb, 0x39,
+	0xab, 0x0b, 0x2a, 0x7a, 0x03, 0x72, 0x4c, 0xd2, 0xf5, 0x88, 0xc3, 0xc5, 0xa9, 0xe1, 0x11, 0xdf,
+	0xc0, 0xa6, 0xe9, 0x76, 0x9d, 0x20, 0x1b, 0xe7, 0x98, 0x8d, 0x0e, 0xbe, 0xa8, 0x79, 0xc4, 0x61,
+	0x38, 0x5a, 0x27, 0x7e, 0x49, 0x70, 0xd1, 0x4b, 0x80, 0xb8, 0x05, 0x72, 0xe1, 0xd9, 0xfe, 0xa5,
+	0x71, 0xda, 0x76, 0xcd, 0x33, 0x9a, 0x4d, 0x70, 0x8c, 0xca, 0x38, 0x1a, 0x67, 0x94, 0x39, 0x1d,
+	0xbd, 0x0e, 0x37, 0x70, 0xbb, 0xed, 0x3e, 0x22, 0x96, 0x74, 0xc9, 0xb0, 0x70, 0x80, 0x0d, 0x9e,
+	0xa3, 0xec, 0x7c, 0x3e, 0xbe, 0x9d, 0xd4, 0x37, 0xa4, 0x80, 0x70, 0x6e, 0x1f, 0x07, 0xb8, 0xc9,
+	0xb8, 0xe8, 0xb7, 0x0a, 0x2c, 0x73, 0x4b, 0x16, 0xf1, 0x5c, 0x6a, 0x07, 0xd9, 0x85, 0x7c, 0x7c,
+	0x3b, 0xb5, 0x77, 0xa3, 0x28, 0xb3, 0xc5, 0xc2, 0x5e, 0x94, 0x61, 0x2f, 0x56, 0x5c, 0xdb, 0x29,
+	0x1f, 0x7c, 0xf6, 0xef, 0xad, 0xb9, 0x8f, 0xbf, 0xd8, 0xda, 0x6e, 0xd9, 0xc1, 0xc3, 0xee, 0x69,
+	0xd1, 0x74, 0x3b, 0x32, 0xb5, 0xf2, 0xbf, 0x97, 0xa9, 0x75, 0x26, 0xeb, 0x83, 0x01, 0xe8, 0x47,
+	0x8f, 0x3f, 0xdd, 0x59, 0x6e, 0x93, 0x16, 0x36, 0x2f, 0x0d, 0x96, 0x38, 0xfa, 0xc7, 0xc7, 0x9f,
+	0xee, 0x28, 0x7a, 0x8a, 0x99, 0xdd, 0x17, 0x56, 0x91, 0x09, 0x1b, 0x7c, 0xa9, 0xc4, 0x0a, 0x1d,
+	0x31, 0xb0, 0x19, 0xd8, 0xae, 0x93, 0x5d, 0xcc, 0x2b, 0xdb, 0xe9, 0xbd, 0x97, 0x8b, 0x33, 0x6a,
+	0xac, 0xa8, 0x09, 0x98, 0x54, 0x54, 0xe2, 0x20, 0x3d, 0x43, 0xa6, 0x50, 0xd1, 0x0f, 0x20, 0xeb,
+	0xe3, 0x80, 0x18, 0x6d, 0xbb, 0x63, 0x07, 0xc6, 0x23, 0xdb, 0xb1, 0xdc, 0x47, 0x61, 0x68, 0x97,
+	0x78, 0x68, 0xd7, 0x19, 0xff, 0x88, 0xb1, 0xdf, 0xe5, 0x5c, 0x19, 0xdf, 0x1f, 0xc3, 0xad, 0x30,
+	0xe7, 0x23, 0x49, 0x94, 0x3a, 0xb2, 0x49, 0x0e, 0xce, 0xca, 0xfc, 0x47, 0xf2, 0x28, 0xb4, 0xa0,
+	0x1a, 0xdc, 0x0e, 0xf1, 0x83, 0x14, 0x09, 0x45, 0xe6, 0x43, 0xec, 0x38, 0xa4, 0x1d, 0x2a, 0x02,
+	0xae, 0x28, 0x2f, 0x15, 0x85, 0xd9, 0x62, 0x0a, 0x2b, 0x42, 0x50, 0x2a, 0xac, 0xc0, 0xa6, 0x4f,
+	0xa8, 0xdb, 0x3e, 0x27, 0x96, 0xa8, 0x44, 0x9f, 0x04, 0xc4, 0x61, 0x8b, 0x0c, 0xd7, 0x93, 0xe2,
+	0x9a, 0x6e, 0x86, 0x52, 0x4c, 0x9d, 0x1e, 0xca, 0x88, 0x55, 0xbd, 0x91, 0xef, 0x3d, 0xfe, 0x74,
+	0xe7, 0xe6, 0xd4, 0x06, 0x17, 0x4d, 0x51, 0xc0, 0xb0, 0x74, 0x9f, 0x04, 0x98, 0x15, 0x13, 0x7a,
+	0x15, 0x36, 0xc6, 0xfc, 0x3f, 0x27, 0x3e, 0x65, 0x19, 0x62, 0x7d, 0x92, 0xd4, 0x33, 0xed, 0xa8,
+	0xc7, 0xef, 0x08, 0x1e, 0xda, 0x82, 0x14, 0xf6, 0xbc, 0x81, 0x68, 0x8c, 0x8b, 0x02, 0xf6, 0x3c,
+	0x29, 0x50, 0xf8, 0xef, 0x12, 0x2c, 0xbf, 0x45, 0x1c, 0x42, 0x6d, 0xda, 0x08, 0x70, 0x40, 0x50,
+	0x19, 0x16, 0x3c, 0x6e, 0x9d, 0x0b, 0xa7, 0xf6, 0xb6, 0x66, 0x66, 0x5e, 0x38, 0x59, 0x4e, 0xb2,
+	0x7a, 0x14, 0x25, 0x25, 0x91, 0xc8, 0x86, 0x75, 0xd1, 0x3d, 0xa2, 0x67, 0xc3, 0x28, 0xd3, 0x6c,
+	0x9c, 0x17, 0xf7, 0xce, 0x4c, 0x95, 0x32, 0xca, 0x55, 0x8b, 0x05, 0xea, 0x81, 0x4d, 0xfc, 0xa8,
+	0xf6, 0x35, 0xde, 0x77, 0x42, 0xa5, 0x14, 0x64, 0xa5, 0x31, 0xcf, 0xc8, 0x54, 0xf6, 0xcd, 0x73,
+	0x33, 0x55, 0xb3, 0x0c, 0x44, 0xb5, 0x09, 0x18, 0x7a, 0x1b, 0x96, 0x65, 0xcb, 0x0a, 0x35, 0x8b,
+	0x5c, 0xcd, 0xf3, 0x4f, 0x58, 0x34, 0xfb, 0x39, 0xae, 0x2c, 0xe5, 0x0d, 0xc8, 0x14, 0x3d, 0x0f,
+	0x2b, 0x7c, 0xf5, 0x94, 0x7c, 0xd0, 0x25, 0x8e, 0x49, 0x64, 0x6d, 0xf3, 0x36, 0x6f, 0x48, 0x1a,
+	0x3a, 0x84, 0x25, 0x4a, 0x28, 0x4b, 0x01, 0xcd, 0x02, 0xb7, 0xf9, 0xed, 0x27, 0xba, 0xde, 0x10,
+	0xc2, 0x51, 0xa3, 0x03, 0x05, 0xa8, 0x03, 0x1b, 0x0f, 0x5c, 0xff, 0x11, 0xf6, 0xad, 0xb0, 0x1e,
+	0xc3, 0xc9, 0x98, 0xe2, 0xaa, 0x5f, 0x9a, 0xa9, 0xfa, 0x20, 0x84, 0x0d, 0x07, 0x67, 0xd4, 0x44,
+	0xe6, 0xc1, 0x24, 0x9f, 0xa2, 0x77, 0x21, 0x7d, 0xda, 0x7d, 0xf0, 0x80, 0xf8, 0xd2, 0x1a, 0xcd,
+	0x2e, 0x73, 0x33, 0xb7, 0x67, 0x9a, 0x29, 0x4b, 0xf1, 0xf1, 0xb8, 0xad, 0x9c, 0x46, 0x18, 0x14,
+	0xdd, 0x86, 0x70, 0xda, 0x1b, 0x1e, 0xee, 0x52, 0x62, 0x65, 0x57, 0xf8, 0x1e, 0xb0, 0x22, 0xa9,
+	0x75, 0x4e, 0x44, 0xbf, 0x84, 0x6b, 0x82, 0x3d, 0x2c, 0xac, 0xf4, 0xd3, 0x14, 0x56, 0x5a, 0x68,
+	0x1b, 0xd4, 0x94, 0x05, 0x6b, 0xe1, 0x80, 0x19, 0xce, 0x2b, 0x9a, 0xbd, 0xc6, 0x6d, 0xbc, 0x38,
+	0xd3, 0x86, 0x9c, 0x39, 0x7a, 0x38, 0xc2, 0xa2, 0x26, 0x56, 0xf1, 0x18, 0x93, 0x5b, 0x09, 0xa7,
+	0x4f, 0xd4, 0x8a, 0x7a, 0x85, 0x15, 0xe9, 0xe5, 0x74, 0x2b, 0xe6, 0x18, 0x93, 0xa2, 0x5f, 0xc3,
+	0xba, 0x4f, 0x4c, 0x62, 0x9f, 0x8f, 0x57, 0xc6, 0x2a, 0xb7, 0xf3, 0xdd, 0x99, 0x76, 0x74, 0x89,
+	0x9a, 0x5e, 0x18, 0x6b, 0xfe, 0x04, 0x9b, 0xde, 0x4b, 0x2c, 0x29, 0x6a, 0xec, 0x5e, 0x62, 0x29,
+	0xa1, 0xce, 0xdf, 0x4b, 0x2c, 0xcd, 0xab, 0x0b, 0xf7, 0x12, 0x4b, 0x49, 0x15, 0x0a, 0x3f, 0x82,
+	0xf4, 0xc0, 0xa3, 0x13, 0x8a, 0x5b, 0x04, 0x6d, 0xc0, 0x82, 0x9c, 0xb8, 0x0a, 0xef, 0x0d, 0xf9,
+	0x85, 0x10, 0x24, 0x78, 0xda, 0xc5, 0x86, 0xce, 0x7f, 0x17, 0x3e, 0x54, 0x40, 0x1d, 0x0f, 0x2d,
+	0xda, 0x83, 0xc5, 0x70, 0x23, 0xe7, 0xe3, 0xaf, 0x9c, 0xfd, 0xc7, 0x9f, 0x5e, 0xce, 0xc8, 0x3d,
+	0xb3, 0x64, 0x59, 0x3e, 0xa1, 0xb4, 0x11, 0xf8, 0xb6, 0xd3, 0xd2, 0x43, 0x41, 0x74, 0x17, 0xe6,
+	0xbb, 0xcc, 0xba, 0x1c, 0x6c, 0x2f, 0xcc, 0x5e, 0xfa, 0x88, 0xb3, 0x23, 0x43, 0x83, 0x2b, 0x28,
+	0x7c, 0xa2, 0x80, 0x3a, 0x9e, 0x07, 0x54, 0x83, 0x45, 0x19, 0x7e, 0xee, 0xd2, 0xd7, 0xae, 0xc6,
+	0x50, 0xcb, 0x33, 0xf4, 0xd7, 0x8b, 0x24, 0xe0, 0xed, 0xae, 0x1b, 0x60, 0x94, 0x61, 0x63, 0xb3,
+	0x63, 0x07, 0x32, 0xfe, 0xe2, 0x03, 0xdd, 0x82, 0xa4, 0x4f, 0x3a, 0xd8, 0x76, 0x6c, 0xa7, 0x25,
+	0x73, 0x30, 0x24, 0xa0, 0x1d, 0x58, 0x95, 0x7b, 0x36, 0x71, 0x2c, 0xe3, 0x21, 0xb1, 0x5b, 0x0f,
+	0xc3, 0x63, 0xd4, 0x35, 0xc1, 0xd0, 0x1c, 0xeb, 0x2e, 0x27, 0x17, 0xfe, 0xae, 0x40, 0x2a, 0x32,
+	0xb6, 0xd0, 0x75, 0x58, 0xe4, 0xd5, 0x67, 0x5b, 0x72, 0xbb, 0x5a, 0x60, 0x9f, 0x55, 0x0b, 0x9d,
+	0x40, 0xca, 0xf3, 0xc9, 0xb9, 0x2c, 0x4b, 0xb9, 0xd4, 0x17, 0xaf, 0x18, 0xbf, 0xd3, 0x03, 0x07,
+	0x4c, 0x91, 0x10, 0x40, 0x45, 0x98, 0x77, 0x1f, 0x39, 0xc4, 0xcf, 0xc6, 0xaf, 0xa8, 0x0e, 0x21,
+	0x86, 0x9e, 0x03, 0x10, 0xfe, 0x39, 0x16, 0xb9, 0x90, 0xe7, 0xbc, 0x24, 0x77, 0x91, 0x11, 0x0a,
+	0x14, 0x60, 0x38, 0xf8, 0xd1, 0x11, 0xdb, 0x22, 0xb9, 0xbb, 0xca, 0x53, 0xb8, 0x2b, 0x75, 0x44,
+	0x43, 0x13, 0x8b, 0x86, 0xa6, 0xf0, 0x91, 0x02, 0x68, 0xb2, 0x0b, 0x9f, 0x7d, 0x9d, 0xcd, 0x72,
+	0x00, 0xe5, 0xd8, 0x1e, 0x25, 0xf7, 0x30, 0x91, 0xe7, 0xc1, 0x77, 0xe1, 0x73, 0x05, 0xd6, 0xa6,
+	0x6c, 0x1e, 0xdf, 0xa0, 0x77, 0x35, 0x58, 0x6a, 0x63, 0x1a, 0x18, 0x0f, 0x5d, 0x2f, 0x1b, 0x7f,
+	0x8a, 0x3c, 0x2c, 0x32, 0x2d, 0x77, 0x5d, 0xaf, 0xf0, 0x2b, 0x40, 0xd1, 0x7d, 0x4a, 0x2e, 0x68,
+	0x63, 0x24, 0xd9, 0xcb, 0x83, 0xb4, 0xed, 0xc1, 0xa2, 0x4f, 0xda, 0xf8, 0x92, 0xf8, 0xd9, 0xd8,
+	0x15, 0x35, 0x16, 0x0a, 0x16, 0x5a, 0xb0, 0x36, 0x69, 0x81, 0xa2, 0x3a, 0x2c, 0x86, 0x53, 0x59,
+	0xb9, 0x62, 0x2a, 0x4f, 0xc2, 0x47, 0x96, 0x22, 0x25, 0x0b, 0x7f, 0x53, 0x60, 0x39, 0x2a, 0xfa,
+	0x0d, 0xa6, 0x25, 0xb2, 0x98, 0xf8, 0xb3, 0x59, 0xcc, 0x2f, 0x40, 0x1d, 0xcf, 0x1f, 0x33, 0xef,
+	0xb9, 0x7e, 0x10, 0x99, 0x27, 0xec, 0xb3, 0x6a, 0xb1, 0x46, 0x0e, 0x77, 0xd5, 0x81, 0x6b, 0x49,
+	0x33, 0x5c, 0x12, 0x52, 0x21, 0x4e, 0xc9, 0x07, 0x62, 0x2a, 0xe8, 0xec, 0x67, 0xe1, 0x10, 0x56,
+	0x27, 0x56, 0xfc, 0x75, 0xd5, 0x17, 0x7e, 0xbf, 0x04, 0x09, 0x1e, 0xef, 0x99, 0xf3, 0x6e, 0x30,
+	0x98, 0x62, 0xff, 0xdf, 0x60, 0xfa, 0x21, 0x2c, 0xd0, 0x00, 0x07, 0x5d, 0xca, 0x7d, 0x4e, 0x3f,
+	0xe1, 0x64, 0xca, 0xc7, 0x2d, 0x17, 0xd5, 0x25, 0x04, 0xdd, 0x1d, 0xe6, 0x22, 0x71, 0xc5, 0xb9,
+	0xf6, 0xc9, 0x39, 0x40, 0x6f, 0xc3, 0x8a, 0xe9, 0x76, 0x3c, 0xe2, 0x50, 0x1c, 0xf0, 0x33, 0xeb,
+	0xfc, 0x15, 0x27, 0xbe, 0x4a, 0x44, 0xba, 0x9c, 0x60, 0x1a, 0xf5, 0x51, 0x0d, 0xc8, 0x82, 0x4c,
+	0x94, 0x30, 0x38, 0x98, 0x2c, 0x5c, 0x51, 0x35, 0x51, 0xcd, 0xd2, 0x63, 0xa1, 0x7f, 0xcd, 0x9c,
+	0xe0, 0x50, 0x74, 0x00, 0x49, 0xfe, 0x00, 0xc0, 0x9e, 0x1e, 0xf8, 0x5d, 0x36, 0xb5, 0x97, 0x2b,
+	0x8a, 0x77, 0x89, 0x62, 0xf8, 0x2e, 0x51, 0x6c, 0x86, 0xef, 0x12, 0xe5, 0x15, 0xa6, 0xe9, 0xc3,
+	0x2f, 0xb6, 0x14, 0x79, 0xc4, 0x66, 0x58, 0xc6, 0x65, 0x17, 0x29, 0xae, 0x47, 0x6e, 0x7b, 0xe2,
+	0x48, 0x0f, 0x8c, 0x24, 0x76, 0x3c, 0x74, 0x0e, 0x8b, 0xe1, 0x15, 0x3e, 0x79, 0xd5, 0x15, 0xbe,
+	0xf4, 0xd4, 0x57, 0x78, 0x3d, 0x34, 0x86, 0x7e, 0xa3, 0x40, 0x5a, 0xce, 0x17, 0xc3, 0x27, 0x6c,
+	0x1e, 0x67, 0xe1, 0x1b, 0xb0, 0xbf, 0x22, 0x6d, 0xea, 0xdc, 0x24, 0x7a, 0x05, 0x32, 0xd4, 0x37,
+	0x0d, 0x13, 0xb7, 0xdb, 0xa7, 0xd8, 0x3c, 0x33, 0xb0, 0xa8, 0x65, 0x7e, 0x0d, 0x4e, 0xea, 0x88,
+	0xfa, 0x66, 0x45, 0xb2, 0x64, 0x95, 0xa3, 0x3d, 0x58, 0xb7, 0x08, 0x0d, 0x26, 0x21, 0xcb, 0x1c,
+	0xb2, 0xc6, 0x98, 0xe3, 0x98, 0x0d, 0x58, 0xc0, 0x81, 0xdb, 0xb1, 0x4d, 0x79, 0x2f, 0x90, 0x5f,
+	0xe8, 0xe7, 0xa0, 0x86, 0xef, 0x2f, 0x4f, 0x71, 0x23, 0x10, 0x55, 0x74, 0x4d, 0x6a, 0x1a, 0xdc,
+	0x06, 0xb6, 0x20, 0xc5, 0x1e, 0x0f, 0xc2, 0xf2, 0xbc, 0x26, 0x32, 0xdf, 0xc1, 0x17, 0x61, 0x89,
+	0xdd, 0x86, 0xb4, 0xbc, 0xe6, 0x87, 0xd5, 0xa1, 0x72, 0x99, 0x15, 0x49, 0x95, 0x47, 0xa2, 0x3f,
+	0x2b, 0x00, 0x91, 0x7d, 0xe5, 0xd9, 0x1e, 0x22, 0x7e, 0x0a, 0x8b, 0x6e, 0x37, 0x30, 0xdd, 0x8e,
+	0x38, 0x2d, 0xa6, 0xf7, 0xbe, 0x73, 0x85, 0xba, 0x9a, 0x90, 0xd6, 0x43, 0x18, 0xca, 0x0e, 0xf7,
+	0x33, 0x31, 0x1d, 0x07, 0xbb, 0x96, 0x01, 0xcb, 0xd1, 0x9e, 0x1b, 0x3b, 0x2b, 0x29, 0x63, 0x67,
+	0x25, 0xf4, 0x0a, 0x2c, 0x75, 0x08, 0x65, 0xe7, 0x4e, 0xf6, 0x84, 0xc0, 0x92, 0x90, 0x99, 0x68,
+	0xb8, 0x92, 0x73, 0xa9, 0x0f, 0xa4, 0x0a, 0x7f, 0x51, 0x00, 0x4d, 0x76, 0xf5, 0x55, 0x76, 0x86,
+	0x01, 0x8c, 0x3d, 0xdb, 0x00, 0xc6, 0xbf, 0x56, 0x00, 0x0b, 0xff, 0x54, 0x60, 0x8d, 0xe5, 0xb7,
+	0x64, 0x9e, 0x39, 0xee, 0xa3, 0x36, 0xb1, 0x5a, 0xa4, 0x43, 0x9c, 0x00, 0xed, 0xc2, 0x1a, 0x7b,
+	0x82, 0xc1, 0xa3, 0x64, 0x79, 0x9a, 0x40, 0xd8, 0xf3, 0xc6, 0x01, 0xf2, 0xcd, 0x86, 0x76, 0x4d,
+	0x93, 0xf5, 0x43, 0x8c, 0x97, 0x3a, 0x7b, 0xb3, 0x69, 0x08, 0x0a, 0x7a, 0x0d, 0x12, 0xa6, 0x6b,
+	0x85, 0x8e, 0x3e, 0xf9, 0xdd, 0xa0, 0x64, 0x9e, 0x55, 0x5c, 0x8b, 0xe8, 0x1c, 0xc1, 0x1a, 0xc8,
+	0x27, 0x98, 0xba, 0x0e, 0x3f, 0xe2, 0x26, 0x75, 0xf9, 0x15, 0x4d, 0xfe, 0xfc, 0x48, 0xf2, 0x77,
+	0x3e, 0x51, 0x20, 0x33, 0xed, 0x89, 0x0f, 0xbd, 0x09, 0x37, 0xb5, 0xf7, 0xea, 0x55, 0x5d, 0xdb,
+	0x37, 0xf6, 0xb5, 0x7a, 0xad, 0x51, 0x6d, 0x1a, 0xa5, 0x4a, 0xb3, 0x5a, 0x3b, 0x36, 0xca, 0x27,
+	0xfa, 0xb1, 0x3a, 0x97, 0xbb, 0xd5, 0xeb, 0xe7, 0xb3, 0xd3, 0xa0, 0xe5, 0xae, 0xef, 0xa0, 0x3a,
+	0xdc, 0x9e, 0x01, 0xaf, 0xd4, 0xee, 0xdf, 0x3f, 0x39, 0xae, 0x36, 0xdf, 0x37, 0xea, 0xb5, 0xda,
+	0x91, 0xaa, 0xe4, 0x6e, 0xf7, 0xfa, 0xf9, 0x6f, 0x4d, 0x53, 0x54, 0x71, 0x3b, 0x9d, 0xae, 0x63,
+	0x07, 0x97, 0x75, 0xd7, 0x6d, 0xe7, 0x12, 0xbf, 0xfb, 0xc3, 0xe6, 0xdc, 0xce, 0x5f, 0x63, 0x00,
+	0xc3, 0x9d, 0x10, 0x7d, 0x1f, 0xae, 0x1f, 0x55, 0x8f, 0x0f, 0x8d, 0x46, 0xb3, 0xd4, 0x3c, 0x69,
+	0x18, 0x27, 0xc7, 0x8d, 0xba, 0x56, 0xa9, 0x1e, 0x54, 0xb5, 0x7d, 0x75, 0x2e, 0x77, 0xa3, 0xd7,
+	0xcf, 0xaf, 0x0f, 0x85, 0x4f, 0x1c, 0xea, 0x11, 0x93, 0x55, 0x08, 0x7f, 0x65, 0x8e, 0xe2, 0x6a,
+	0x75, 0xed, 0x58, 0x55, 0x72, 0xa8, 0xd7, 0xcf, 0xa7, 0x87, 0x00, 0xf6, 0x70, 0x8c, 0x8a, 0xb0,
+	0x16, 0x95, 0xac, 0x6b, 0xc7, 0xfb, 0xd5, 0xe3, 0xb7, 0xd4, 0x58, 0x6e, 0xbd, 0xd7, 0xcf, 0xaf,
+	0x0e, 0x85, 0xeb, 0xc4, 0xb1, 0xd8, 0x2d, 0x6a, 0x0f, 0xd6, 0xa3, 0xf2, 0x8d, 0x93, 0x4a, 0x45,
+	0xd3, 0xf6, 0xb5, 0x7d, 0x35, 0x9e, 0xbb, 0xde, 0xeb, 0xe7, 0xd7, 0x86, 0x08, 0x9e, 0x6e, 0x62,
+	0x11, 0x8b, 0xbd, 0x46, 0x47, 0x31, 0x07, 0xa5, 0xea, 0x91, 0xb6, 0xaf, 0x26, 0x72, 0x99, 0x5e,
+	0x3f, 0xaf, 0x0e, 0x01, 0x07, 0xd8, 0x66, 0x2f, 0xe4, 0x3f, 0x81, 0x5b, 0x23, 0x1e, 0x95, 0xf4,
+	0x66, 0xb5, 0x74, 0x74, 0xf4, 0x7e, 0x88, 0x9b, 0xcf, 0x3d, 0xd7, 0xeb, 0xe7, 0x6f, 0x44, 0x5c,
+	0xc3, 0x7e, 0x60, 0xe3, 0x76, 0xfb, 0x52, 0x28, 0x90, 0x91, 0x7c, 0x1c, 0x83, 0x95, 0x91, 0x52,
+	0x47, 0xaf, 0x41, 0xae, 0x5e, 0xaa, 0x1c, 0x6a, 0x4d, 0xa3, 0x76, 0xd2, 0xac, 0xd4, 0xee, 0x6b,
+	0x63, 0xf1, 0xcc, 0xf6, 0xfa, 0xf9, 0xcc, 0x08, 0x24, 0x5c, 0xf4, 0xab, 0xb0, 0x31, 0x86, 0xe4,
+	0xeb, 0x6e, 0x34, 0x54, 0x65, 0x0a, 0x2a, 0xac, 0xf3, 0x57, 0x20, 0x33, 0x86, 0xd2, 0x74, 0xbd,
+	0xa6, 0xab, 0xb1, 0xdc, 0x46, 0xaf, 0x9f, 0x47, 0x23, 0x18, 0xcd, 0xf7, 0x5d, 0x7f, 0x8a, 0x9d,
+	0x66, 0xf5, 0xbe, 0x56, 0x3b, 0x69, 0xaa, 0xf1, 0x29, 0x76, 0xd8, 0xc6, 0xee, 0x76, 0x03, 0xf6,
+	0x7c, 0x3f, 0x86, 0xe2, 0xf1, 0x13, 0xc6, 0x12, 0xb9, 0x5c, 0xaf, 0x9f, 0xdf, 0x18, 0x01, 0xb2,
+	0xd0, 0x09, 0x83, 0x6f, 0xc2, 0xcd, 0x31, 0xe8, 0x41, 0x4d, 0xaf, 0x68, 0xc3, 0x50, 0xf3, 0x2e,
+	0x18, 0x01, 0x1f, 0xb8, 0xbe, 0x49, 0x46, 0x22, 0xfd, 0x71, 0x1c, 0x52, 0x91, 0x5e, 0x65, 0xfe,
+	0x70, 0x07, 0x4a, 0x95, 0x43, 0xa3, 0x52, 0xdb, 0x1f, 0x0f, 0x33, 0xf7, 0x27, 0x22, 0x1f, 0xad,
+	0xdb, 0x17, 0x40, 0x1d, 0x85, 0xd6, 0x0e, 0x55, 0x25, 0xb7, 0xda, 0xeb, 0xe7, 0x57, 0x22, 0x88,
+	0xda, 0x21, 0xfb, 0xe3, 0xc8, 0xa8, 0x60, 0x59, 0xaf, 0x1d, 0x6a, 0xc7, 0x46, 0xe5, 0x6e, 0xa9,
+	0x7a, 0xac, 0xc6, 0x26, 0x8c, 0x94, 0x7d, 0xf7, 0x8c, 0x38, 0x95, 0x87, 0xd8, 0x76, 0xd0, 0x1d,
+	0x58, 0x1f, 0xc5, 0xca, 0x4e, 0x56, 0xe3, 0x22, 0x31, 0x11, 0x98, 0x6c, 0x5b, 0x54, 0x86, 0xcd,
+	0x51, 0x48, 0xbd, 0x76, 0x54, 0xad, 0xbc, 0x6f, 0xbc, 0x53, 0xad, 0x1d, 0x95, 0x58, 0xdb, 0xab,
+	0x89, 0xdc, 0x66, 0xaf, 0x9f, 0xcf, 0x45, 0xb0, 0x75, 0xb7, 0x6d, 0x9b, 0x97, 0xef, 0xd8, 0x6e,
+	0x5b, 0xec, 0x3b, 0x13, 0x3a, 0xb4, 0xf7, 0xb4, 0xca, 0x09, 0x9f, 0x19, 0x83, 0x70, 0x8f, 0xeb,
+	0xd0, 0x2e, 0x88, 0xd9, 0x65, 0x68, 0xd9, 0x1b, 0x13, 0xcb, 0x16, 0xe9, 0xaa, 0x1c, 0xd5, 0x1a,
+	0xda, 0xbe, 0xba, 0x30, 0xb1, 0x6c, 0x9e, 0xac, 0x4a, 0xdb, 0xa5, 0x61, 0xb2, 0xca, 0xaf, 0x7f,
+	0xf6, 0xe5, 0xa6, 0xf2, 0xf9, 0x97, 0x9b, 0xca, 0x7f, 0xbe, 0xdc, 0x54, 0x3e, 0xfc, 0x6a, 0x73,
+	0xee, 0xf3, 0xaf, 0x36, 0xe7, 0xfe, 0xf5, 0xd5, 0xe6, 0xdc, 0xcf, 0xb6, 0x22, 0xa7, 0xa9, 0x69,
+	0xaf, 0xfa, 0xa7, 0x0b, 0x7c, 0xff, 0xfb, 0xde, 0xff, 0x06, 0x00, 0xd0, 0x16, 0xef, 0xad, 0xd5,
+	0x1b, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedLinkRetentionBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolvedLinkRetentionBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxLinkedPacketsPerChannelWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLinkedPacketsPerChannelWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxLinksPerAccountWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLinksPerAccountWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RateLimitWindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RateLimitWindowBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiredDepositAction != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiredDepositAction))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LinkDeposit) > 0 {
+		for iNdEx := len(m.LinkDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedPacketDataTypes) > 0 {
+		for iNdEx := len(m.AllowedPacketDataTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPacketDataTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedPacketDataTypes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedPacketDataTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LinkExpiryBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LinkExpiryBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxOpenLinksPerAccount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxOpenLinksPerAccount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxLinkPackets != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLinkPackets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LinkingEnabled {
+		i--
+		if m.LinkingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LinkedPacketsVersion) > 0 {
+		i -= len(m.LinkedPacketsVersion)
+		copy(dAtA[i:], m.LinkedPacketsVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkedPacketsVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceivedLinkPackets) > 0 {
+		for iNdEx := len(m.ReceivedLinkPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceivedLinkPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for iNdEx := len(m.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AccountRateLimits) > 0 {
+		for iNdEx := len(m.AccountRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.LinkingPaused {
+		i--
+		if m.LinkingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.BufferedLinks) > 0 {
+		for iNdEx := len(m.BufferedLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BufferedLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ForwardedLinkPackets) > 0 {
+		for iNdEx := len(m.ForwardedLinkPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedLinkPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LinkSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LinkSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PacketLinks) > 0 {
+		for iNdEx := len(m.PacketLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LinkEnabledChannels) > 0 {
+		for iNdEx := len(m.LinkEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkEnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Used != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinkIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LinkIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.PrevPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceivedLinkPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceivedLinkPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceivedLinkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardedLinkPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedLinkPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedLinkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastHop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BufferedLinkPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BufferedLinkPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BufferedLinkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packet) > 0 {
+		i -= len(m.Packet)
+		copy(dAtA[i:], m.Packet)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Packet)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BufferedLinkPackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BufferedLinkPackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BufferedLinkPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BufferedLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BufferedLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BufferedLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seq) > 0 {
+		i -= len(m.Seq)
+		copy(dAtA[i:], m.Seq)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Seq)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Link) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Link) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolveHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolveHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxPackets != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DestCallbackAddress) > 0 {
+		i -= len(m.DestCallbackAddress)
+		copy(dAtA[i:], m.DestCallbackAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestCallbackAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SrcCallbackAddress) > 0 {
+		i -= len(m.SrcCallbackAddress)
+		copy(dAtA[i:], m.SrcCallbackAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SrcCallbackAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RelayerReward) > 0 {
+		for iNdEx := len(m.RelayerReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.OpenHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpenHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.CompensationPackets) > 0 {
+		for iNdEx := len(m.CompensationPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompensationPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Compensations) > 0 {
+		for iNdEx := len(m.Compensations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Compensations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LinkId) > 0 {
+		i -= len(m.LinkId)
+		copy(dAtA[i:], m.LinkId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LinkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Compensation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Compensation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Compensation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LinkIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LinkIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompensationPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompensationPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompensationPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LinkIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LinkIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Code != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppSuccess {
+		i--
+		if m.AppSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AppAcknowledgement) > 0 {
+		i -= len(m.AppAcknowledgement)
+		copy(dAtA[i:], m.AppAcknowledgement)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppAcknowledgement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkingEnabled {
+		n += 2
+	}
+	if m.MaxLinkPackets != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLinkPackets))
+	}
+	if m.MaxOpenLinksPerAccount != 0 {
+		n += 1 + sovTypes(uint64(m.MaxOpenLinksPerAccount))
+	}
+	if m.LinkExpiryBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.LinkExpiryBlocks))
+	}
+	if len(m.AllowedPacketDataTypes) > 0 {
+		for _, s := range m.AllowedPacketDataTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.LinkDeposit) > 0 {
+		for _, e := range m.LinkDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpiredDepositAction != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiredDepositAction))
+	}
+	if m.RateLimitWindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.RateLimitWindowBlocks))
+	}
+	if m.MaxLinksPerAccountWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLinksPerAccountWindow))
+	}
+	if m.MaxLinkedPacketsPerChannelWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLinkedPacketsPerChannelWindow))
+	}
+	if m.ResolvedLinkRetentionBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.ResolvedLinkRetentionBlocks))
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkedPacketsVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.LinkEnabledChannels) > 0 {
+		for _, e := range m.LinkEnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PacketLinks) > 0 {
+		for _, e := range m.PacketLinks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LinkSequence != 0 {
+		n += 1 + sovTypes(uint64(m.LinkSequence))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ForwardedLinkPackets) > 0 {
+		for _, e := range m.ForwardedLinkPackets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BufferedLinks) > 0 {
+		for _, e := range m.BufferedLinks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LinkingPaused {
+		n += 2
+	}
+	if len(m.PausedChannels) > 0 {
+		for _, e := range m.PausedChannels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AccountRateLimits) > 0 {
+		for _, e := range m.AccountRateLimits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for _, e := range m.ChannelRateLimits {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ReceivedLinkPackets) > 0 {
+		for _, e := range m.ReceivedLinkPackets {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovTypes(uint64(m.Window))
+	}
+	if m.Used != 0 {
+		n += 1 + sovTypes(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *AccountRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ChannelRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *RateLimitQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovTypes(uint64(m.Limit))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovTypes(uint64(m.Remaining))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovTypes(uint64(m.WindowEndHeight))
+	}
+	return n
+}
+
+func (m *LinkSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.PrevPacket.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LinkIndex != 0 {
+		n += 1 + sovTypes(uint64(m.LinkIndex))
+	}
+	return n
+}
+
+func (m *PacketLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceivedLinkPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ForwardedLinkPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.LastHop.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *BufferedLinkPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Packet)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BufferedLinkPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BufferedLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketIdentifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Seq)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelIdentifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Link) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Compensations) > 0 {
+		for _, e := range m.Compensations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.CompensationPackets) > 0 {
+		for _, e := range m.CompensationPackets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.OpenHeight != 0 {
+		n += 1 + sovTypes(uint64(m.OpenHeight))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RelayerReward) > 0 {
+		for _, e := range m.RelayerReward {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.SrcCallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestCallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Atomic {
+		n += 2
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxPackets != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPackets))
+	}
+	if m.ResolveHeight != 0 {
+		n += 2 + sovTypes(uint64(m.ResolveHeight))
+	}
+	return n
+}
+
+func (m *LinkPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Outcome != 0 {
+		n += 1 + sovTypes(uint64(m.Outcome))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Compensation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkIndex != 0 {
+		n += 1 + sovTypes(uint64(m.LinkIndex))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompensationPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkIndex != 0 {
+		n += 1 + sovTypes(uint64(m.LinkIndex))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Outcome != 0 {
+		n += 1 + sovTypes(uint64(m.Outcome))
+	}
+	return n
+}
+
+func (m *LinkAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAcknowledgement)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AppSuccess {
+		n += 2
+	}
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LinkingEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLinkPackets", wireType)
+			}
+			m.MaxLinkPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLinkPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenLinksPerAccount", wireType)
+			}
+			m.MaxOpenLinksPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenLinksPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkExpiryBlocks", wireType)
+			}
+			m.LinkExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPacketDataTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPacketDataTypes = append(m.AllowedPacketDataTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkDeposit = append(m.LinkDeposit, types.Coin{})
+			if err := m.LinkDeposit[len(m.LinkDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredDepositAction", wireType)
+			}
+			m.ExpiredDepositAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredDepositAction |= ExpiredDepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindowBlocks", wireType)
+			}
+			m.RateLimitWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLinksPerAccountWindow", wireType)
+			}
+			m.MaxLinksPerAccountWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLinksPerAccountWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLinkedPacketsPerChannelWindow", wireType)
+			}
+			m.MaxLinkedPacketsPerChannelWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLinkedPacketsPerChannelWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedLinkRetentionBlocks", wireType)
+			}
+			m.ResolvedLinkRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedLinkRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedPacketsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkedPacketsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkEnabledChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkEnabledChannels = append(m.LinkEnabledChannels, ChannelIdentifier{})
+			if err := m.LinkEnabledChannels[len(m.LinkEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, Link{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketLinks = append(m.PacketLinks, PacketLink{})
+			if err := m.PacketLinks[len(m.PacketLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkSequence", wireType)
+			}
+			m.LinkSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, LinkSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedLinkPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedLinkPackets = append(m.ForwardedLinkPackets, ForwardedLinkPacket{})
+			if err := m.ForwardedLinkPackets[len(m.ForwardedLinkPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferedLinks = append(m.BufferedLinks, BufferedLink{})
+			if err := m.BufferedLinks[len(m.BufferedLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LinkingPaused = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, ChannelIdentifier{})
+			if err := m.PausedChannels[len(m.PausedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRateLimits = append(m.AccountRateLimits, AccountRateLimit{})
+			if err := m.AccountRateLimits[len(m.AccountRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelRateLimits = append(m.ChannelRateLimits, ChannelRateLimit{})
+			if err := m.ChannelRateLimits[len(m.ChannelRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedLinkPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceivedLinkPackets = append(m.ReceivedLinkPackets, ReceivedLinkPacket{})
+			if err := m.ReceivedLinkPackets[len(m.ReceivedLinkPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+			}
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
+			}
+			m.LinkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceivedLinkPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceivedLinkPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceivedLinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardedLinkPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedLinkPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedLinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastHop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BufferedLinkPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BufferedLinkPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BufferedLinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packet = append(m.Packet[:0], dAtA[iNdEx:postIndex]...)
+			if m.Packet == nil {
+				m.Packet = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BufferedLinkPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BufferedLinkPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BufferedLinkPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, BufferedLinkPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BufferedLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BufferedLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BufferedLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, BufferedLinkPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seq = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Link) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LinkStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, LinkPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compensations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compensations = append(m.Compensations, Compensation{})
+			if err := m.Compensations[len(m.Compensations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationPackets = append(m.CompensationPackets, CompensationPacket{})
+			if err := m.CompensationPackets[len(m.CompensationPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenHeight", wireType)
+			}
+			m.OpenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerReward = append(m.RelayerReward, types.Coin{})
+			if err := m.RelayerReward[len(m.RelayerReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcCallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SrcCallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestCallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestCallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, ChannelIdentifier{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveHeight", wireType)
+			}
+			m.ResolveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -667,7 +6737,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *LinkPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -690,17 +6760,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkedPacketsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -710,27 +6780,47 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkedPacketsVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= PacketOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -758,7 +6848,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -781,7 +6871,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *Compensation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,17 +6894,17 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Compensation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Compensation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
 			}
-			m.Count = 0
+			m.LinkIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -824,16 +6914,16 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				m.LinkIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -843,23 +6933,25 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -882,7 +6974,7 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *CompensationPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,17 +6997,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompensationPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompensationPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkIndex", wireType)
 			}
-			var msglen int
+			m.LinkIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -925,29 +7017,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LinkIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Counters = append(m.Counters, Counter{})
-			if err := m.Counters[len(m.Counters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -974,10 +7051,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= PacketOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -999,7 +7095,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketIdentifier) Unmarshal(dAtA []byte) error {
+func (m *LinkAcknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,17 +7118,17 @@ func (m *PacketIdentifier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketIdentifier: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkAcknowledgement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAcknowledgement", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1042,27 +7138,68 @@ func (m *PacketIdentifier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.AppAcknowledgement = append(m.AppAcknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAcknowledgement == nil {
+				m.AppAcknowledgement = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AppSuccess = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= LinkAckCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1090,11 +7227,11 @@ func (m *PacketIdentifier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1122,7 +7259,7 @@ func (m *PacketIdentifier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seq = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
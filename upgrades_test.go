@@ -0,0 +1,111 @@
+package linkedpackets_test
+
+import (
+	"cosmossdk.io/collections"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/srdtrk/linkedpackets"
+	"github.com/srdtrk/linkedpackets/keeper"
+	v2 "github.com/srdtrk/linkedpackets/migrations/v2"
+	"github.com/srdtrk/linkedpackets/simapp/upgrades"
+)
+
+func (s *LinkedPacketsTestSuite) TestLinkedPacketsV2Upgrade() {
+	s.SetupLinkedPacketsTransferTest()
+
+	s.ExecuteInitLink("mylinkid")
+	s.ExecuteTransfer("1")
+
+	app := GetSimApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	owner := s.chainA.SenderAccount.GetAddress().String()
+
+	session, err := app.LinkedPacketsKeeper.Sessions.Get(ctx, owner)
+	s.Require().NoError(err)
+
+	// rewrite the module store in the v1 store layout, which only holds the params, the link enabled channels and
+	// the link in progress
+	storeService := runtime.NewKVStoreService(app.GetKey(linkedpackets.StoreKey))
+	store := storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(nil, nil)
+	s.Require().NoError(err)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		if prefix := iter.Key()[0]; prefix != linkedpackets.ParamsKey[0] && prefix != linkedpackets.LinkEnabledKey[0] {
+			keys = append(keys, iter.Key())
+		}
+	}
+	s.Require().NoError(iter.Close())
+	for _, key := range keys {
+		s.Require().NoError(store.Delete(key))
+	}
+
+	cdc := app.AppCodec()
+	sb := collections.NewSchemaBuilder(storeService)
+	linking := collections.NewItem(sb, v2.LinkingKey, "linking", collections.BoolValue)
+	prevPacket := collections.NewItem(sb, v2.PrevPacketKey, "prev_packet", codec.CollValue[linkedpackets.PacketIdentifier](cdc))
+	linkID := collections.NewItem(sb, v2.LinkIdKey, "link_id", collections.StringValue)
+	linkIndex := collections.NewMap(sb, v2.LinkIndexKey, "link_index", collections.StringKey, collections.Uint64Value)
+	_, err = sb.Build()
+	s.Require().NoError(err)
+
+	s.Require().NoError(linking.Set(ctx, true))
+	s.Require().NoError(prevPacket.Set(ctx, session.PrevPacket))
+	s.Require().NoError(linkID.Set(ctx, session.LinkId))
+	s.Require().NoError(linkIndex.Set(ctx, session.LinkId, session.LinkIndex))
+
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().NoError(err)
+	vm[linkedpackets.ModuleName] = 1
+	s.Require().NoError(app.UpgradeKeeper.SetModuleVersionMap(ctx, vm))
+
+	plan := upgradetypes.Plan{Name: upgrades.LinkedPacketsV2, Height: ctx.BlockHeight()}
+	s.Require().NoError(app.UpgradeKeeper.ApplyUpgrade(ctx, plan))
+
+	vm, err = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), vm[linkedpackets.ModuleName])
+
+	isLinking, err := linking.Has(ctx)
+	s.Require().NoError(err)
+	s.Require().False(isLinking)
+
+	hasLinkIndex, err := linkIndex.Has(ctx, session.LinkId)
+	s.Require().NoError(err)
+	s.Require().False(hasLinkIndex)
+
+	// the link in progress is given to the module authority, as the v1 store did not record its owner
+	authority := app.LinkedPacketsKeeper.GetAuthority()
+	migrated, err := app.LinkedPacketsKeeper.Sessions.Get(ctx, authority)
+	s.Require().NoError(err)
+	s.Require().Equal(linkedpackets.LinkSession{
+		LinkId:     session.LinkId,
+		PrevPacket: session.PrevPacket,
+		Owner:      authority,
+		LinkIndex:  session.LinkIndex,
+	}, migrated)
+
+	link, err := app.LinkedPacketsKeeper.Links.Get(ctx, session.LinkId)
+	s.Require().NoError(err)
+	s.Require().Equal(authority, link.Owner)
+	s.Require().Equal(linkedpackets.LinkStatusOpen, link.Status)
+
+	openLinks, err := app.LinkedPacketsKeeper.OpenLinkCounts.Get(ctx, authority)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), openLinks)
+
+	// the migrated link is expired or closed by the module authority like any other link
+	s.Require().NoError(app.LinkedPacketsKeeper.ExpireLinks(ctx))
+	_, err = keeper.NewMsgServerImpl(app.LinkedPacketsKeeper).ForceCloseLink(ctx, &linkedpackets.MsgForceCloseLink{
+		Authority: authority,
+		LinkId:    session.LinkId,
+	})
+	s.Require().NoError(err)
+
+	hasSession, err := app.LinkedPacketsKeeper.Sessions.Has(ctx, authority)
+	s.Require().NoError(err)
+	s.Require().False(hasSession)
+}